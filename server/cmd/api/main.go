@@ -29,6 +29,10 @@ func main() {
 
 	container.ConfigController.SetupDefaultKeys(context.Background())
 
+	go container.ScrobblerController.RunScrobbleForwarder(context.Background())
+
+	go container.TrackUsecase.LinkMissingTrackGenres()
+
 	port := os.Getenv("PORT")
 
 	if port == "" {
@@ -4,15 +4,20 @@ import (
 	"github.com/gungun974/Melodink/server/internal/layers/data/processors"
 	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
 	"github.com/gungun974/Melodink/server/internal/layers/data/scanners"
+	"github.com/gungun974/Melodink/server/internal/layers/data/scrobblers"
 	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
 	album_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/album"
 	artist_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/artist"
 	config_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/config"
+	genre_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/genre"
+	listening_stats_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/listening_stats"
 	playlist_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/playlist"
+	scrobbler_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/scrobbler"
 	shared_played_track_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/shared_played_track"
 	sync_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/sync"
 	track_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/track"
 	user_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/user"
+	work_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/work"
 	"github.com/gungun974/Melodink/server/internal/layers/presentation/controllers"
 	"github.com/gungun974/Melodink/server/internal/layers/presentation/presenters"
 	"github.com/jmoiron/sqlx"
@@ -21,13 +26,19 @@ import (
 type Container struct {
 	ConfigRepository repositories.ConfigRepository
 
+	TrackUsecase track_usecase.TrackUsecase
+
 	ConfigController            controllers.ConfigController
 	UserController              controllers.UserController
 	TrackController             controllers.TrackController
 	PlaylistController          controllers.PlaylistController
 	AlbumController             controllers.AlbumController
 	ArtistController            controllers.ArtistController
+	GenreController             controllers.GenreController
+	WorkController              controllers.WorkController
 	SharedPlayedTrackController controllers.SharedPlayedTrackController
+	ListeningStatsController    controllers.ListeningStatsController
+	ScrobblerController         controllers.ScrobblerController
 	SyncController              controllers.SyncController
 }
 
@@ -43,7 +54,11 @@ func NewContainer(db *sqlx.DB) Container {
 	trackRepository := repositories.NewTrackRepository(db)
 	playlistRepository := repositories.NewPlaylistRepository(db, trackRepository)
 	artistRepository := repositories.NewArtistRepository(db, trackRepository, albumRepository)
+	genreRepository := repositories.NewGenreRepository(db, trackRepository, albumRepository)
+	workRepository := repositories.NewWorkRepository(db, trackRepository)
 	sharedPlayedTrackRepository := repositories.NewSharedPlayedTrackRepository(db)
+	listeningStatsRepository := repositories.NewListeningStatsRepository(db)
+	scrobblerRepository := repositories.NewScrobblerRepository(db)
 
 	//! Storage
 
@@ -55,10 +70,22 @@ func NewContainer(db *sqlx.DB) Container {
 
 	acoustIdScanner := scanners.NewAcoustIdScanner()
 	musicBrainzScanner := scanners.NewMusicBrainzScanner()
+	loudnessScanner := scanners.NewLoudnessScanner()
+	audioAnalysisScanner := scanners.NewAudioAnalysisScanner()
+	lyricsScanner := scanners.NewLyricsScanner()
+	spectrumScanner := scanners.NewSpectrumScanner()
+	coverArtArchiveScanner := scanners.NewCoverArtArchiveScanner()
+
+	//! Scrobbler
+
+	listenBrainzScrobbler := scrobblers.NewListenBrainzScrobbler()
+	lastFmScrobbler := scrobblers.NewLastFmScrobbler()
 
 	//! Processor
 
 	transcodeProcessor := processors.NewTranscodeProcessor()
+	tagWriterProcessor := processors.NewTagWriterProcessor()
+	waveformProcessor := processors.NewWaveformProcessor()
 
 	//! Presenter
 
@@ -67,7 +94,11 @@ func NewContainer(db *sqlx.DB) Container {
 	playlistPresenter := presenters.NewPlaylistPresenter()
 	albumPresenter := presenters.NewAlbumPresenter()
 	artistPresenter := presenters.NewArtistPresenter()
+	genrePresenter := presenters.NewGenrePresenter()
+	workPresenter := presenters.NewWorkPresenter()
 	sharedPlayedTrackPresenter := presenters.NewSharedPlayedTrackPresenter()
+	listeningStatsPresenter := presenters.NewListeningStatsPresenter()
+	scrobblerPresenter := presenters.NewScrobblerPresenter()
 	syncPresenter := presenters.NewSyncPresenter()
 
 	//! Usecase
@@ -86,12 +117,21 @@ func NewContainer(db *sqlx.DB) Container {
 		trackRepository,
 		albumRepository,
 		artistRepository,
+		genreRepository,
+		workRepository,
 		trackStorage,
 		coverStorage,
 		transcodeStorage,
 		acoustIdScanner,
 		musicBrainzScanner,
+		loudnessScanner,
+		audioAnalysisScanner,
+		lyricsScanner,
+		spectrumScanner,
+		coverArtArchiveScanner,
 		transcodeProcessor,
+		tagWriterProcessor,
+		waveformProcessor,
 		trackPresenter,
 	)
 
@@ -106,7 +146,10 @@ func NewContainer(db *sqlx.DB) Container {
 		albumRepository,
 		trackRepository,
 		artistRepository,
+		workRepository,
 		coverStorage,
+		loudnessScanner,
+		coverArtArchiveScanner,
 		albumPresenter,
 	)
 
@@ -116,21 +159,53 @@ func NewContainer(db *sqlx.DB) Container {
 		artistPresenter,
 	)
 
+	genreUsecase := genre_usecase.NewGenreUsecase(
+		genreRepository,
+		coverStorage,
+		genrePresenter,
+	)
+
+	workUsecase := work_usecase.NewWorkUsecase(
+		workRepository,
+		artistRepository,
+		coverStorage,
+		workPresenter,
+	)
+
 	sharedPlayedTrackUsecase := shared_played_track_usecase.NewSharedPlayedTrackUsecase(
 		sharedPlayedTrackRepository,
+		scrobblerRepository,
+		trackRepository,
 		sharedPlayedTrackPresenter,
 	)
 
+	listeningStatsUsecase := listening_stats_usecase.NewListeningStatsUsecase(
+		listeningStatsRepository,
+		listeningStatsPresenter,
+	)
+
+	scrobblerUsecase := scrobbler_usecase.NewScrobblerUsecase(
+		scrobblerRepository,
+		trackRepository,
+		listenBrainzScrobbler,
+		lastFmScrobbler,
+		scrobblerPresenter,
+	)
+
 	syncUsecase := sync_usecase.NewSyncUsecase(
 		trackRepository,
 		albumRepository,
 		artistRepository,
+		genreRepository,
+		workRepository,
 		playlistRepository,
 		sharedPlayedTrackRepository,
 		coverStorage,
 		syncPresenter,
 	)
 
+	container.TrackUsecase = trackUsecase
+
 	//! Controller
 
 	container.ConfigController = controllers.NewConfigController(configUsecase)
@@ -139,9 +214,15 @@ func NewContainer(db *sqlx.DB) Container {
 	container.PlaylistController = controllers.NewPlaylistController(playlistUsecase)
 	container.AlbumController = controllers.NewAlbumController(albumUsecase)
 	container.ArtistController = controllers.NewArtistController(artistUsecase)
+	container.GenreController = controllers.NewGenreController(genreUsecase)
+	container.WorkController = controllers.NewWorkController(workUsecase)
 	container.SharedPlayedTrackController = controllers.NewSharedPlayedTrackController(
 		sharedPlayedTrackUsecase,
 	)
+	container.ListeningStatsController = controllers.NewListeningStatsController(
+		listeningStatsUsecase,
+	)
+	container.ScrobblerController = controllers.NewScrobblerController(scrobblerUsecase)
 	container.SyncController = controllers.NewSyncController(syncUsecase)
 
 	return container
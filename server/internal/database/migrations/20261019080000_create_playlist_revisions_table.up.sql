@@ -0,0 +1,24 @@
+CREATE TABLE playlist_revisions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+
+    playlist_id INTEGER NOT NULL,
+    user_id INTEGER,
+
+    device_id TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+
+    track_ids JSON NOT NULL,
+
+    added_track_ids JSON NOT NULL,
+    removed_track_ids JSON NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_user_id_playlist_revisions FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_playlist_revisions_playlist_id ON playlist_revisions(playlist_id, created_at);
+CREATE INDEX idx_playlist_revisions_user_id_action ON playlist_revisions(user_id, action, created_at);
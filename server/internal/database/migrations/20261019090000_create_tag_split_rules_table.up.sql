@@ -0,0 +1,13 @@
+CREATE TABLE tag_split_rules (
+    user_id INTEGER PRIMARY KEY,
+
+    artist_separators JSON NOT NULL,
+    genre_separators JSON NOT NULL,
+
+    protected_names JSON NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+
+    CONSTRAINT fk_user_id_tag_split_rules FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
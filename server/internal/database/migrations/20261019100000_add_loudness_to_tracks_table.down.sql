@@ -0,0 +1,17 @@
+ALTER TABLE tracks
+DROP COLUMN loudness_integrated;
+
+ALTER TABLE tracks
+DROP COLUMN loudness_true_peak;
+
+ALTER TABLE tracks
+DROP COLUMN loudness_range;
+
+ALTER TABLE tracks
+DROP COLUMN album_loudness_integrated;
+
+ALTER TABLE tracks
+DROP COLUMN album_loudness_true_peak;
+
+ALTER TABLE tracks
+DROP COLUMN album_loudness_range;
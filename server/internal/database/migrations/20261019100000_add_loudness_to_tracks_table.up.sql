@@ -0,0 +1,17 @@
+ALTER TABLE tracks
+ADD COLUMN loudness_integrated REAL;
+
+ALTER TABLE tracks
+ADD COLUMN loudness_true_peak REAL;
+
+ALTER TABLE tracks
+ADD COLUMN loudness_range REAL;
+
+ALTER TABLE tracks
+ADD COLUMN album_loudness_integrated REAL;
+
+ALTER TABLE tracks
+ADD COLUMN album_loudness_true_peak REAL;
+
+ALTER TABLE tracks
+ADD COLUMN album_loudness_range REAL;
@@ -0,0 +1,18 @@
+DROP INDEX tracks_user_analysis_camelot_idx;
+
+DROP INDEX tracks_user_analysis_bpm_idx;
+
+ALTER TABLE tracks
+DROP COLUMN analysis_bpm;
+
+ALTER TABLE tracks
+DROP COLUMN analysis_key;
+
+ALTER TABLE tracks
+DROP COLUMN analysis_camelot;
+
+ALTER TABLE tracks
+DROP COLUMN analysis_energy;
+
+ALTER TABLE tracks
+DROP COLUMN analysis_danceability;
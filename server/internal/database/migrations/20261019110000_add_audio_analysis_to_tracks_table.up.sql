@@ -0,0 +1,18 @@
+ALTER TABLE tracks
+ADD COLUMN analysis_bpm REAL;
+
+ALTER TABLE tracks
+ADD COLUMN analysis_key TEXT;
+
+ALTER TABLE tracks
+ADD COLUMN analysis_camelot TEXT;
+
+ALTER TABLE tracks
+ADD COLUMN analysis_energy REAL;
+
+ALTER TABLE tracks
+ADD COLUMN analysis_danceability REAL;
+
+CREATE INDEX tracks_user_analysis_bpm_idx ON tracks(user_id, analysis_bpm);
+
+CREATE INDEX tracks_user_analysis_camelot_idx ON tracks(user_id, analysis_camelot);
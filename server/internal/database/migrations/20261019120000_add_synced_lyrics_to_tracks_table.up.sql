@@ -0,0 +1,2 @@
+ALTER TABLE tracks
+ADD COLUMN metadata_synced_lyrics TEXT NOT NULL DEFAULT "[]";
@@ -0,0 +1,11 @@
+CREATE TABLE lyrics_cache (
+    query_key TEXT PRIMARY KEY,
+
+    found BOOLEAN NOT NULL,
+    instrumental BOOLEAN NOT NULL DEFAULT 0,
+
+    plain_lyrics TEXT NOT NULL DEFAULT "",
+    synced_lyrics TEXT NOT NULL DEFAULT "",
+
+    fetched_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
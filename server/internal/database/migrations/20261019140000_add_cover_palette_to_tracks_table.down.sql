@@ -0,0 +1,11 @@
+ALTER TABLE tracks
+DROP COLUMN cover_dominant_color;
+
+ALTER TABLE tracks
+DROP COLUMN cover_vibrant_color;
+
+ALTER TABLE tracks
+DROP COLUMN cover_muted_color;
+
+ALTER TABLE tracks
+DROP COLUMN cover_blurhash;
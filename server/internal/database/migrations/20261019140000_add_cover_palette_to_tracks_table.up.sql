@@ -0,0 +1,11 @@
+ALTER TABLE tracks
+ADD COLUMN cover_dominant_color TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN cover_vibrant_color TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN cover_muted_color TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN cover_blurhash TEXT NOT NULL DEFAULT "";
@@ -0,0 +1,10 @@
+CREATE TABLE track_fingerprints (
+    track_id INTEGER PRIMARY KEY,
+
+    file_signature TEXT NOT NULL,
+    fingerprint BLOB NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE
+);
@@ -0,0 +1,16 @@
+DROP INDEX tracks_user_quality_verdict_idx;
+
+ALTER TABLE tracks
+DROP COLUMN quality_verdict;
+
+ALTER TABLE tracks
+DROP COLUMN quality_cutoff_frequency;
+
+ALTER TABLE tracks
+DROP COLUMN quality_sample_rate;
+
+ALTER TABLE tracks
+DROP COLUMN quality_bits_per_sample;
+
+ALTER TABLE tracks
+DROP COLUMN quality_bit_rate;
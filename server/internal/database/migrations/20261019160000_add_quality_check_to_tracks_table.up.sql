@@ -0,0 +1,16 @@
+ALTER TABLE tracks
+ADD COLUMN quality_verdict TEXT;
+
+ALTER TABLE tracks
+ADD COLUMN quality_cutoff_frequency INTEGER;
+
+ALTER TABLE tracks
+ADD COLUMN quality_sample_rate INTEGER;
+
+ALTER TABLE tracks
+ADD COLUMN quality_bits_per_sample INTEGER;
+
+ALTER TABLE tracks
+ADD COLUMN quality_bit_rate INTEGER;
+
+CREATE INDEX tracks_user_quality_verdict_idx ON tracks(user_id, quality_verdict);
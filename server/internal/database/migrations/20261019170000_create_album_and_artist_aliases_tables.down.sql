@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS album_aliases_album_idx;
+
+DROP TABLE IF EXISTS album_aliases;
+
+DROP INDEX IF EXISTS artist_aliases_artist_idx;
+
+DROP TABLE IF EXISTS artist_aliases;
@@ -0,0 +1,29 @@
+CREATE TABLE artist_aliases (
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+
+    artist_id INTEGER NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (artist_id) REFERENCES artists(id) ON DELETE CASCADE
+);
+
+CREATE INDEX artist_aliases_artist_idx ON artist_aliases(artist_id);
+
+CREATE TABLE album_aliases (
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+
+    album_id INTEGER NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, name, album_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (album_id) REFERENCES albums(id) ON DELETE CASCADE
+);
+
+CREATE INDEX album_aliases_album_idx ON album_aliases(album_id);
@@ -0,0 +1,21 @@
+DROP TABLE IF EXISTS music_brainz_artists_cache;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_music_brainz_album_artist_ids;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_music_brainz_artist_ids;
+
+DROP INDEX IF EXISTS artists_user_music_brainz_artist_id_idx;
+
+ALTER TABLE artists
+DROP COLUMN music_brainz_artist_id;
+
+ALTER TABLE artists
+DROP COLUMN aliases;
+
+ALTER TABLE artists
+DROP COLUMN disambiguation;
+
+ALTER TABLE artists
+DROP COLUMN sort_name;
@@ -0,0 +1,31 @@
+ALTER TABLE artists
+ADD COLUMN sort_name TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE artists
+ADD COLUMN disambiguation TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE artists
+ADD COLUMN aliases TEXT NOT NULL DEFAULT "[]";
+
+ALTER TABLE artists
+ADD COLUMN music_brainz_artist_id TEXT NOT NULL DEFAULT "";
+
+CREATE INDEX artists_user_music_brainz_artist_id_idx ON artists(user_id, music_brainz_artist_id);
+
+ALTER TABLE tracks
+ADD COLUMN metadata_music_brainz_artist_ids TEXT NOT NULL DEFAULT "[]";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_music_brainz_album_artist_ids TEXT NOT NULL DEFAULT "[]";
+
+CREATE TABLE music_brainz_artists_cache (
+    music_brainz_artist_id TEXT PRIMARY KEY,
+
+    name TEXT NOT NULL,
+    sort_name TEXT NOT NULL DEFAULT "",
+    disambiguation TEXT NOT NULL DEFAULT "",
+
+    aliases TEXT NOT NULL DEFAULT "[]",
+
+    fetched_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
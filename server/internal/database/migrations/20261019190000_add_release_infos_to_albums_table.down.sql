@@ -0,0 +1,44 @@
+ALTER TABLE tracks
+DROP COLUMN metadata_music_brainz_release_group_id;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_barcode;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_catalog_number;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_label;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_original_date;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_release_types;
+
+ALTER TABLE albums
+DROP COLUMN music_brainz_release_group_id;
+
+ALTER TABLE albums
+DROP COLUMN music_brainz_release_id;
+
+ALTER TABLE albums
+DROP COLUMN barcode;
+
+ALTER TABLE albums
+DROP COLUMN catalog_number;
+
+ALTER TABLE albums
+DROP COLUMN label;
+
+ALTER TABLE albums
+DROP COLUMN original_release_date;
+
+ALTER TABLE albums
+DROP COLUMN release_date;
+
+ALTER TABLE albums
+DROP COLUMN release_secondary_types;
+
+ALTER TABLE albums
+DROP COLUMN release_type;
@@ -0,0 +1,44 @@
+ALTER TABLE albums
+ADD COLUMN release_type TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE albums
+ADD COLUMN release_secondary_types TEXT NOT NULL DEFAULT "[]";
+
+ALTER TABLE albums
+ADD COLUMN release_date TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE albums
+ADD COLUMN original_release_date TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE albums
+ADD COLUMN label TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE albums
+ADD COLUMN catalog_number TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE albums
+ADD COLUMN barcode TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE albums
+ADD COLUMN music_brainz_release_id TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE albums
+ADD COLUMN music_brainz_release_group_id TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_release_types TEXT NOT NULL DEFAULT "[]";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_original_date TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_label TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_catalog_number TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_barcode TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_music_brainz_release_group_id TEXT NOT NULL DEFAULT "";
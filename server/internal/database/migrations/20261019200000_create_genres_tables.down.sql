@@ -0,0 +1,20 @@
+DROP TABLE IF EXISTS deleted_genres;
+
+DROP INDEX IF EXISTS album_genre_genre_album_idx;
+DROP INDEX IF EXISTS album_genre_album_genre_idx;
+
+DROP TABLE IF EXISTS album_genre;
+
+DROP INDEX IF EXISTS track_genre_genre_track_idx;
+DROP INDEX IF EXISTS track_genre_track_genre_idx;
+
+DROP TABLE IF EXISTS track_genre;
+
+DROP INDEX IF EXISTS genre_aliases_genre_idx;
+
+DROP TABLE IF EXISTS genre_aliases;
+
+DROP INDEX IF EXISTS genres_parent_idx;
+DROP INDEX IF EXISTS genres_user_idx;
+
+DROP TABLE IF EXISTS genres;
@@ -0,0 +1,60 @@
+CREATE TABLE genres (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+
+    parent_id INTEGER,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+
+    user_id INTEGER,
+    CONSTRAINT fk_user_id_genres FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT fk_parent_id_genres FOREIGN KEY (parent_id) REFERENCES genres(id) ON DELETE SET NULL
+);
+
+CREATE INDEX genres_user_idx ON genres(user_id);
+CREATE INDEX genres_parent_idx ON genres(parent_id);
+
+CREATE TABLE genre_aliases (
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+
+    genre_id INTEGER NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (genre_id) REFERENCES genres(id) ON DELETE CASCADE
+);
+
+CREATE INDEX genre_aliases_genre_idx ON genre_aliases(genre_id);
+
+CREATE TABLE track_genre (
+    track_id INTEGER NOT NULL,
+    genre_id INTEGER NOT NULL,
+    genre_pos INTEGER,
+    PRIMARY KEY (track_id, genre_id),
+    FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE,
+    FOREIGN KEY (genre_id) REFERENCES genres(id) ON DELETE CASCADE
+) WITHOUT ROWID;
+
+CREATE INDEX track_genre_track_genre_idx ON track_genre(track_id, genre_id);
+CREATE INDEX track_genre_genre_track_idx ON track_genre(genre_id, track_id);
+
+CREATE TABLE album_genre (
+    album_id INTEGER NOT NULL,
+    genre_id INTEGER NOT NULL,
+    genre_pos INTEGER,
+    PRIMARY KEY (album_id, genre_id),
+    FOREIGN KEY (album_id) REFERENCES albums(id) ON DELETE CASCADE,
+    FOREIGN KEY (genre_id) REFERENCES genres(id) ON DELETE CASCADE
+) WITHOUT ROWID;
+
+CREATE INDEX album_genre_album_genre_idx ON album_genre(album_id, genre_id);
+CREATE INDEX album_genre_genre_album_idx ON album_genre(genre_id, album_id);
+
+CREATE TABLE deleted_genres (
+    id INTEGER PRIMARY KEY,
+    deleted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
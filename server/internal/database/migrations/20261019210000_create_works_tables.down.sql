@@ -0,0 +1,29 @@
+ALTER TABLE tracks
+DROP COLUMN metadata_music_brainz_work_id;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_movement_total;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_movement_number;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_movement_name;
+
+ALTER TABLE tracks
+DROP COLUMN metadata_work;
+
+DROP TABLE IF EXISTS deleted_works;
+
+DROP INDEX IF EXISTS track_artist_role_artist_role_idx;
+
+DROP TABLE IF EXISTS track_artist_role;
+
+DROP INDEX IF EXISTS track_work_work_track_idx;
+
+DROP TABLE IF EXISTS track_work;
+
+DROP INDEX IF EXISTS works_music_brainz_work_idx;
+DROP INDEX IF EXISTS works_user_idx;
+
+DROP TABLE IF EXISTS works;
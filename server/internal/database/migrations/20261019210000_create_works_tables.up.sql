@@ -0,0 +1,57 @@
+CREATE TABLE works (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+
+    music_brainz_work_id TEXT NOT NULL DEFAULT "",
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+
+    user_id INTEGER,
+    CONSTRAINT fk_user_id_works FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX works_user_idx ON works(user_id);
+CREATE INDEX works_music_brainz_work_idx ON works(user_id, music_brainz_work_id);
+
+CREATE TABLE track_work (
+    track_id INTEGER NOT NULL,
+    work_id INTEGER NOT NULL,
+    PRIMARY KEY (track_id, work_id),
+    FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE,
+    FOREIGN KEY (work_id) REFERENCES works(id) ON DELETE CASCADE
+) WITHOUT ROWID;
+
+CREATE INDEX track_work_work_track_idx ON track_work(work_id, track_id);
+
+CREATE TABLE track_artist_role (
+    track_id INTEGER NOT NULL,
+    artist_id INTEGER NOT NULL,
+    role TEXT NOT NULL,
+    role_pos INTEGER,
+    PRIMARY KEY (track_id, artist_id, role),
+    FOREIGN KEY (track_id) REFERENCES tracks(id) ON DELETE CASCADE,
+    FOREIGN KEY (artist_id) REFERENCES artists(id) ON DELETE CASCADE
+) WITHOUT ROWID;
+
+CREATE INDEX track_artist_role_artist_role_idx ON track_artist_role(artist_id, role, track_id);
+
+CREATE TABLE deleted_works (
+    id INTEGER PRIMARY KEY,
+    deleted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE tracks
+ADD COLUMN metadata_work TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_movement_name TEXT NOT NULL DEFAULT "";
+
+ALTER TABLE tracks
+ADD COLUMN metadata_movement_number INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE tracks
+ADD COLUMN metadata_movement_total INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE tracks
+ADD COLUMN metadata_music_brainz_work_id TEXT NOT NULL DEFAULT "";
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS shared_played_tracks_user_start_idx;
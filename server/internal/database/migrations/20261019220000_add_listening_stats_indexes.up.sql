@@ -0,0 +1 @@
+CREATE INDEX shared_played_tracks_user_start_idx ON shared_played_tracks(user_id, julianday(start_at));
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS scrobble_queue_pending_idx;
+DROP INDEX IF EXISTS scrobble_queue_scrobbler_played_track_idx;
+
+DROP TABLE IF EXISTS scrobble_queue;
+
+DROP INDEX IF EXISTS scrobblers_user_idx;
+
+DROP TABLE IF EXISTS scrobblers;
@@ -0,0 +1,52 @@
+CREATE TABLE scrobblers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+
+    user_id INTEGER NOT NULL,
+
+    service TEXT NOT NULL,
+    name TEXT NOT NULL DEFAULT "",
+
+    base_url TEXT NOT NULL DEFAULT "",
+
+    token TEXT NOT NULL DEFAULT "",
+
+    api_key TEXT NOT NULL DEFAULT "",
+    api_secret TEXT NOT NULL DEFAULT "",
+    session_key TEXT NOT NULL DEFAULT "",
+
+    enabled INTEGER NOT NULL DEFAULT 1,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+
+    CONSTRAINT fk_user_id_scrobblers FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX scrobblers_user_idx ON scrobblers(user_id);
+
+CREATE TABLE scrobble_queue (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+
+    scrobbler_id INTEGER NOT NULL,
+
+    played_track_id INTEGER NOT NULL,
+    track_id INTEGER NOT NULL,
+
+    listened_at TIMESTAMP NOT NULL,
+
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    last_error TEXT NOT NULL DEFAULT "",
+
+    submitted_at TIMESTAMP,
+    failed_at TIMESTAMP,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_scrobbler_id_scrobble_queue FOREIGN KEY (scrobbler_id) REFERENCES scrobblers(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX scrobble_queue_scrobbler_played_track_idx ON scrobble_queue(scrobbler_id, played_track_id);
+
+CREATE INDEX scrobble_queue_pending_idx ON scrobble_queue(julianday(next_attempt_at))
+WHERE submitted_at IS NULL AND failed_at IS NULL;
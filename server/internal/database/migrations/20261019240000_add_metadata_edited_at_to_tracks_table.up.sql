@@ -0,0 +1,2 @@
+ALTER TABLE tracks
+ADD COLUMN metadata_edited_at TIMESTAMP;
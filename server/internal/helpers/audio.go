@@ -0,0 +1,22 @@
+package helpers
+
+import "slices"
+
+var supportedAudioMimeTypes = []string{
+	"audio/mpeg",
+	"video/mp4",
+	"audio/mp4",
+	"audio/ogg",
+	"audio/vorbis",
+	"audio/aac",
+	"audio/wav",
+	"audio/flac",
+	"audio/x-flac",
+	"audio/x-m4a",
+}
+
+// IsSupportedAudioMimeType tell if an uploaded file of this MIME type can be
+// imported as a track.
+func IsSupportedAudioMimeType(mimeType string) bool {
+	return slices.Contains(supportedAudioMimeTypes, mimeType)
+}
@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+func GetEnvString(key string, fallback string) string {
+	value := os.Getenv(key)
+
+	if IsEmptyOrWhitespace(value) {
+		return fallback
+	}
+
+	return value
+}
+
+func GetEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(strings.TrimSpace(os.Getenv(key)))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+func GetEnvBool(key string, fallback bool) bool {
+	value, err := strconv.ParseBool(strings.TrimSpace(os.Getenv(key)))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// SplitTagValue split a tag value on every separators while keeping the
+// protected names (like "Earth, Wind & Fire") untouched. Matching is case
+// insensitive.
+func SplitTagValue(value string, separators []string, protectedNames []string) []string {
+	parts := []string{}
+
+	start := 0
+	i := 0
+
+	for i < len(value) {
+		if protectedName := matchAt(value, i, protectedNames); protectedName != "" {
+			i += len(protectedName)
+			continue
+		}
+
+		if separator := matchAt(value, i, separators); separator != "" {
+			parts = append(parts, value[start:i])
+			i += len(separator)
+			start = i
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(value[i:])
+		i += size
+	}
+
+	parts = append(parts, value[start:])
+
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	return RemoveEmptyStrings(parts)
+}
+
+func matchAt(value string, index int, candidates []string) string {
+	longest := ""
+
+	for _, candidate := range candidates {
+		if candidate == "" || len(candidate) <= len(longest) {
+			continue
+		}
+
+		end := index + len(candidate)
+
+		if end > len(value) {
+			continue
+		}
+
+		if strings.EqualFold(value[index:end], candidate) {
+			longest = candidate
+		}
+	}
+
+	return longest
+}
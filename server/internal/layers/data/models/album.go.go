@@ -1,6 +1,7 @@
 package data_models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
@@ -23,6 +24,19 @@ type AlbumModel struct {
 
 	Name string `db:"name"`
 
+	ReleaseType           string `db:"release_type"`
+	ReleaseSecondaryTypes string `db:"release_secondary_types"`
+
+	ReleaseDate         string `db:"release_date"`
+	OriginalReleaseDate string `db:"original_release_date"`
+
+	Label         string `db:"label"`
+	CatalogNumber string `db:"catalog_number"`
+	Barcode       string `db:"barcode"`
+
+	MusicBrainzReleaseId      string `db:"music_brainz_release_id"`
+	MusicBrainzReleaseGroupId string `db:"music_brainz_release_group_id"`
+
 	UserId *int `db:"user_id"`
 
 	CreatedAt time.Time  `db:"created_at"`
@@ -30,11 +44,32 @@ type AlbumModel struct {
 }
 
 func (m *AlbumModel) ToAlbum() entities.Album {
+	var releaseSecondaryTypes []string
+
+	if err := json.Unmarshal([]byte(m.ReleaseSecondaryTypes), &releaseSecondaryTypes); err != nil {
+		releaseSecondaryTypes = []string{}
+	}
+
 	return entities.Album{
-		Id:      m.Id,
-		Name:    m.Name,
+		Id:   m.Id,
+		Name: m.Name,
+
+		ReleaseType:           m.ReleaseType,
+		ReleaseSecondaryTypes: releaseSecondaryTypes,
+
+		ReleaseDate:         m.ReleaseDate,
+		OriginalReleaseDate: m.OriginalReleaseDate,
+
+		Label:         m.Label,
+		CatalogNumber: m.CatalogNumber,
+		Barcode:       m.Barcode,
+
+		MusicBrainzReleaseId:      m.MusicBrainzReleaseId,
+		MusicBrainzReleaseGroupId: m.MusicBrainzReleaseGroupId,
+
 		UserId:  m.UserId,
 		Artists: []entities.Artist{},
+		Genres:  []entities.Genre{},
 		Tracks:  []entities.Track{},
 	}
 }
@@ -1,6 +1,7 @@
 package data_models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
@@ -23,6 +24,13 @@ type ArtistModel struct {
 
 	Name string `db:"name"`
 
+	SortName       string `db:"sort_name"`
+	Disambiguation string `db:"disambiguation"`
+
+	Aliases string `db:"aliases"`
+
+	MusicBrainzArtistId string `db:"music_brainz_artist_id"`
+
 	UserId *int `db:"user_id"`
 
 	CreatedAt time.Time  `db:"created_at"`
@@ -30,10 +38,23 @@ type ArtistModel struct {
 }
 
 func (m *ArtistModel) ToArtist() entities.Artist {
+	var aliases []string
+
+	if err := json.Unmarshal([]byte(m.Aliases), &aliases); err != nil {
+		aliases = []string{}
+	}
+
 	return entities.Artist{
 		Id:   m.Id,
 		Name: m.Name,
 
+		SortName:       m.SortName,
+		Disambiguation: m.Disambiguation,
+
+		Aliases: aliases,
+
+		MusicBrainzArtistId: m.MusicBrainzArtistId,
+
 		UserId: m.UserId,
 
 		Albums:        []entities.Album{},
@@ -0,0 +1,64 @@
+package data_models
+
+import (
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type GenreModels []GenreModel
+
+func (s GenreModels) ToGenres() []entities.Genre {
+	e := make([]entities.Genre, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToGenre())
+	}
+
+	return e
+}
+
+type GenreModel struct {
+	Id int `db:"id"`
+
+	Name string `db:"name"`
+
+	ParentId *int `db:"parent_id"`
+
+	UserId *int `db:"user_id"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt *time.Time `db:"updated_at"`
+}
+
+func (m *GenreModel) ToGenre() entities.Genre {
+	return entities.Genre{
+		Id:   m.Id,
+		Name: m.Name,
+
+		ParentId: m.ParentId,
+
+		Aliases: []string{},
+
+		UserId: m.UserId,
+
+		Albums: []entities.Album{},
+		Tracks: []entities.Track{},
+	}
+}
+
+type GenreAliasModel struct {
+	GenreId int    `db:"genre_id"`
+	Name    string `db:"name"`
+}
+
+type GenreCountModel struct {
+	GenreId int `db:"genre_id"`
+	Count   int `db:"count"`
+}
+
+type GenreCoverModel struct {
+	GenreId        int    `db:"genre_id"`
+	TrackId        int    `db:"track_id"`
+	CoverSignature string `db:"cover_signature"`
+}
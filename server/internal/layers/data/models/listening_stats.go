@@ -0,0 +1,145 @@
+package data_models
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type ListeningCountModels []ListeningCountModel
+
+func (s ListeningCountModels) ToListeningCounts() []entities.ListeningCount {
+	e := make([]entities.ListeningCount, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToListeningCount())
+	}
+
+	return e
+}
+
+type ListeningCountModel struct {
+	Id int `db:"id"`
+
+	PlayCount    int `db:"play_count"`
+	ListenedTime int `db:"listened_time"`
+}
+
+func (m *ListeningCountModel) ToListeningCount() entities.ListeningCount {
+	return entities.ListeningCount{
+		Id: m.Id,
+
+		PlayCount:    m.PlayCount,
+		ListenedTime: m.ListenedTime,
+	}
+}
+
+type ListeningTimeBucketModels []ListeningTimeBucketModel
+
+func (s ListeningTimeBucketModels) ToListeningTimeBuckets() []entities.ListeningTimeBucket {
+	e := make([]entities.ListeningTimeBucket, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToListeningTimeBucket())
+	}
+
+	return e
+}
+
+type ListeningTimeBucketModel struct {
+	Date string `db:"date"`
+
+	PlayCount    int `db:"play_count"`
+	ListenedTime int `db:"listened_time"`
+}
+
+func (m *ListeningTimeBucketModel) ToListeningTimeBucket() entities.ListeningTimeBucket {
+	return entities.ListeningTimeBucket{
+		Date: m.Date,
+
+		PlayCount:    m.PlayCount,
+		ListenedTime: m.ListenedTime,
+	}
+}
+
+type ListeningHeatmapCellModels []ListeningHeatmapCellModel
+
+func (s ListeningHeatmapCellModels) ToListeningHeatmapCells() []entities.ListeningHeatmapCell {
+	e := make([]entities.ListeningHeatmapCell, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToListeningHeatmapCell())
+	}
+
+	return e
+}
+
+type ListeningHeatmapCellModel struct {
+	Weekday int `db:"weekday"`
+	Hour    int `db:"hour"`
+
+	PlayCount    int `db:"play_count"`
+	ListenedTime int `db:"listened_time"`
+}
+
+func (m *ListeningHeatmapCellModel) ToListeningHeatmapCell() entities.ListeningHeatmapCell {
+	return entities.ListeningHeatmapCell{
+		Weekday: m.Weekday,
+		Hour:    m.Hour,
+
+		PlayCount:    m.PlayCount,
+		ListenedTime: m.ListenedTime,
+	}
+}
+
+type ListeningSummaryModel struct {
+	PlayCount    int `db:"play_count"`
+	ListenedTime int `db:"listened_time"`
+
+	TrackCount  int `db:"track_count"`
+	AlbumCount  int `db:"album_count"`
+	ArtistCount int `db:"artist_count"`
+
+	SkipCount      int `db:"skip_count"`
+	CompletedCount int `db:"completed_count"`
+}
+
+func (m *ListeningSummaryModel) ToListeningSummary() entities.ListeningSummary {
+	return entities.ListeningSummary{
+		PlayCount:    m.PlayCount,
+		ListenedTime: m.ListenedTime,
+
+		TrackCount:  m.TrackCount,
+		AlbumCount:  m.AlbumCount,
+		ArtistCount: m.ArtistCount,
+
+		SkipCount:      m.SkipCount,
+		CompletedCount: m.CompletedCount,
+	}
+}
+
+type ListeningStreakModels []ListeningStreakModel
+
+func (s ListeningStreakModels) ToListeningStreaks() []entities.ListeningStreak {
+	e := make([]entities.ListeningStreak, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToListeningStreak())
+	}
+
+	return e
+}
+
+type ListeningStreakModel struct {
+	From string `db:"from_date"`
+	To   string `db:"to_date"`
+
+	Days int `db:"days"`
+}
+
+func (m *ListeningStreakModel) ToListeningStreak() entities.ListeningStreak {
+	return entities.ListeningStreak{
+		From: m.From,
+		To:   m.To,
+
+		Days: m.Days,
+	}
+}
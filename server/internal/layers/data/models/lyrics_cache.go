@@ -0,0 +1,33 @@
+package data_models
+
+import (
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type LyricsCacheModel struct {
+	QueryKey string `db:"query_key"`
+
+	Found        bool `db:"found"`
+	Instrumental bool `db:"instrumental"`
+
+	PlainLyrics  string `db:"plain_lyrics"`
+	SyncedLyrics string `db:"synced_lyrics"`
+
+	FetchedAt time.Time `db:"fetched_at"`
+}
+
+func (m *LyricsCacheModel) ToLyricsCache() entities.LyricsCache {
+	return entities.LyricsCache{
+		Key: m.QueryKey,
+
+		Found:        m.Found,
+		Instrumental: m.Instrumental,
+
+		PlainLyrics:  m.PlainLyrics,
+		SyncedLyrics: m.SyncedLyrics,
+
+		FetchedAt: m.FetchedAt,
+	}
+}
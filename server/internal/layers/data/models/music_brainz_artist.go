@@ -0,0 +1,40 @@
+package data_models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type MusicBrainzArtistModel struct {
+	MusicBrainzArtistId string `db:"music_brainz_artist_id"`
+
+	Name           string `db:"name"`
+	SortName       string `db:"sort_name"`
+	Disambiguation string `db:"disambiguation"`
+
+	Aliases string `db:"aliases"`
+
+	FetchedAt time.Time `db:"fetched_at"`
+}
+
+func (m *MusicBrainzArtistModel) ToMusicBrainzArtist() entities.MusicBrainzArtist {
+	var aliases []string
+
+	if err := json.Unmarshal([]byte(m.Aliases), &aliases); err != nil {
+		aliases = []string{}
+	}
+
+	return entities.MusicBrainzArtist{
+		Id: m.MusicBrainzArtistId,
+
+		Name:           m.Name,
+		SortName:       m.SortName,
+		Disambiguation: m.Disambiguation,
+
+		Aliases: aliases,
+
+		FetchedAt: m.FetchedAt,
+	}
+}
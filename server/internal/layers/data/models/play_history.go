@@ -0,0 +1,99 @@
+package data_models
+
+import (
+	"encoding/json"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type PlayHistoryEntryModels []PlayHistoryEntryModel
+
+func (s PlayHistoryEntryModels) ToPlayHistoryEntries() []entities.PlayHistoryEntry {
+	e := make([]entities.PlayHistoryEntry, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToPlayHistoryEntry())
+	}
+
+	return e
+}
+
+// PlayHistoryEntryModel only has the track columns needed by the exports,
+// they are NULL when the track was deleted
+type PlayHistoryEntryModel struct {
+	SharedPlayedTrackModel
+
+	TrackTitle           *string `db:"track_title"`
+	TrackLibraryDuration *int    `db:"track_library_duration"`
+
+	TrackMetadataAlbum        *string `db:"track_metadata_album"`
+	TrackMetadataTrackNumber  *int    `db:"track_metadata_track_number"`
+	TrackMetadataArtists      *string `db:"track_metadata_artists"`
+	TrackMetadataAlbumArtists *string `db:"track_metadata_album_artists"`
+
+	TrackMetadataMusicBrainzReleaseId      *string `db:"track_metadata_music_brainz_release_id"`
+	TrackMetadataMusicBrainzReleaseGroupId *string `db:"track_metadata_music_brainz_release_group_id"`
+	TrackMetadataMusicBrainzTrackId        *string `db:"track_metadata_music_brainz_track_id"`
+	TrackMetadataMusicBrainzRecordingId    *string `db:"track_metadata_music_brainz_recording_id"`
+	TrackMetadataMusicBrainzArtistIds      *string `db:"track_metadata_music_brainz_artist_ids"`
+	TrackMetadataMusicBrainzWorkId         *string `db:"track_metadata_music_brainz_work_id"`
+}
+
+func getStringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func getStringsValue(value *string) []string {
+	var values []string
+
+	if err := json.Unmarshal([]byte(getStringValue(value)), &values); err != nil {
+		return []string{}
+	}
+
+	return values
+}
+
+func (m *PlayHistoryEntryModel) ToPlayHistoryEntry() entities.PlayHistoryEntry {
+	entry := entities.PlayHistoryEntry{
+		PlayedTrack: m.ToSharedPlayedTrack(),
+	}
+
+	if m.TrackTitle == nil {
+		return entry
+	}
+
+	track := entities.Track{
+		Id: m.TrackId,
+
+		Title: *m.TrackTitle,
+
+		Metadata: entities.TrackMetadata{
+			Album: getStringValue(m.TrackMetadataAlbum),
+
+			Artists:      getStringsValue(m.TrackMetadataArtists),
+			AlbumArtists: getStringsValue(m.TrackMetadataAlbumArtists),
+
+			MusicBrainzReleaseId:      getStringValue(m.TrackMetadataMusicBrainzReleaseId),
+			MusicBrainzReleaseGroupId: getStringValue(m.TrackMetadataMusicBrainzReleaseGroupId),
+			MusicBrainzTrackId:        getStringValue(m.TrackMetadataMusicBrainzTrackId),
+			MusicBrainzRecordingId:    getStringValue(m.TrackMetadataMusicBrainzRecordingId),
+			MusicBrainzArtistIds:      getStringsValue(m.TrackMetadataMusicBrainzArtistIds),
+			MusicBrainzWorkId:         getStringValue(m.TrackMetadataMusicBrainzWorkId),
+		},
+	}
+
+	if m.TrackLibraryDuration != nil {
+		track.Duration = *m.TrackLibraryDuration
+	}
+
+	if m.TrackMetadataTrackNumber != nil {
+		track.Metadata.TrackNumber = *m.TrackMetadataTrackNumber
+	}
+
+	entry.Track = &track
+
+	return entry
+}
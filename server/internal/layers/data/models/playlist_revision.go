@@ -0,0 +1,80 @@
+package data_models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type PlaylistRevisionModels []PlaylistRevisionModel
+
+func (s PlaylistRevisionModels) ToPlaylistRevisions() []entities.PlaylistRevision {
+	e := make([]entities.PlaylistRevision, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToPlaylistRevision())
+	}
+
+	return e
+}
+
+type PlaylistRevisionModel struct {
+	Id int `db:"id"`
+
+	PlaylistId int  `db:"playlist_id"`
+	UserId     *int `db:"user_id"`
+
+	DeviceId string `db:"device_id"`
+	Action   string `db:"action"`
+
+	Name        string `db:"name"`
+	Description string `db:"description"`
+
+	TrackIds string `db:"track_ids"`
+
+	AddedTrackIds   string `db:"added_track_ids"`
+	RemovedTrackIds string `db:"removed_track_ids"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (m *PlaylistRevisionModel) ToPlaylistRevision() entities.PlaylistRevision {
+	var trackIds []int
+
+	if err := json.Unmarshal([]byte(m.TrackIds), &trackIds); err != nil {
+		trackIds = []int{}
+	}
+
+	var addedTrackIds []int
+
+	if err := json.Unmarshal([]byte(m.AddedTrackIds), &addedTrackIds); err != nil {
+		addedTrackIds = []int{}
+	}
+
+	var removedTrackIds []int
+
+	if err := json.Unmarshal([]byte(m.RemovedTrackIds), &removedTrackIds); err != nil {
+		removedTrackIds = []int{}
+	}
+
+	return entities.PlaylistRevision{
+		Id: m.Id,
+
+		PlaylistId: m.PlaylistId,
+		UserId:     m.UserId,
+
+		DeviceId: m.DeviceId,
+		Action:   entities.PlaylistRevisionAction(m.Action),
+
+		Name:        m.Name,
+		Description: m.Description,
+
+		TrackIds: trackIds,
+
+		AddedTrackIds:   addedTrackIds,
+		RemovedTrackIds: removedTrackIds,
+
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -0,0 +1,121 @@
+package data_models
+
+import (
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type ScrobblerModels []ScrobblerModel
+
+func (s ScrobblerModels) ToScrobblers() []entities.Scrobbler {
+	e := make([]entities.Scrobbler, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToScrobbler())
+	}
+
+	return e
+}
+
+type ScrobblerModel struct {
+	Id int `db:"id"`
+
+	UserId int `db:"user_id"`
+
+	Service string `db:"service"`
+	Name    string `db:"name"`
+
+	BaseUrl string `db:"base_url"`
+
+	Token string `db:"token"`
+
+	ApiKey     string `db:"api_key"`
+	ApiSecret  string `db:"api_secret"`
+	SessionKey string `db:"session_key"`
+
+	Enabled bool `db:"enabled"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt *time.Time `db:"updated_at"`
+}
+
+func (m *ScrobblerModel) ToScrobbler() entities.Scrobbler {
+	return entities.Scrobbler{
+		Id: m.Id,
+
+		UserId: m.UserId,
+
+		Service: entities.ScrobblerService(m.Service),
+		Name:    m.Name,
+
+		BaseUrl: m.BaseUrl,
+
+		Token: m.Token,
+
+		ApiKey:     m.ApiKey,
+		ApiSecret:  m.ApiSecret,
+		SessionKey: m.SessionKey,
+
+		Enabled: m.Enabled,
+	}
+}
+
+type ScrobblerQueueCountModel struct {
+	ScrobblerId int `db:"scrobbler_id"`
+
+	PendingCount int `db:"pending_count"`
+	FailedCount  int `db:"failed_count"`
+}
+
+type ScrobbleModels []ScrobbleModel
+
+func (s ScrobbleModels) ToScrobbles() []entities.Scrobble {
+	e := make([]entities.Scrobble, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToScrobble())
+	}
+
+	return e
+}
+
+type ScrobbleModel struct {
+	Id int `db:"id"`
+
+	ScrobblerId int `db:"scrobbler_id"`
+
+	PlayedTrackId int `db:"played_track_id"`
+	TrackId       int `db:"track_id"`
+
+	ListenedAt time.Time `db:"listened_at"`
+
+	Attempts      int       `db:"attempts"`
+	NextAttemptAt time.Time `db:"next_attempt_at"`
+	LastError     string    `db:"last_error"`
+
+	SubmittedAt *time.Time `db:"submitted_at"`
+	FailedAt    *time.Time `db:"failed_at"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (m *ScrobbleModel) ToScrobble() entities.Scrobble {
+	return entities.Scrobble{
+		Id: m.Id,
+
+		ScrobblerId: m.ScrobblerId,
+
+		PlayedTrackId: m.PlayedTrackId,
+		TrackId:       m.TrackId,
+
+		ListenedAt: m.ListenedAt,
+
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+
+		SubmittedAt: m.SubmittedAt,
+		FailedAt:    m.FailedAt,
+	}
+}
@@ -0,0 +1,49 @@
+package data_models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type TagSplitRulesModel struct {
+	UserId int `db:"user_id"`
+
+	ArtistSeparators string `db:"artist_separators"`
+	GenreSeparators  string `db:"genre_separators"`
+
+	ProtectedNames string `db:"protected_names"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt *time.Time `db:"updated_at"`
+}
+
+func (m *TagSplitRulesModel) ToTagSplitRules() entities.TagSplitRules {
+	var artistSeparators []string
+
+	if err := json.Unmarshal([]byte(m.ArtistSeparators), &artistSeparators); err != nil {
+		artistSeparators = []string{}
+	}
+
+	var genreSeparators []string
+
+	if err := json.Unmarshal([]byte(m.GenreSeparators), &genreSeparators); err != nil {
+		genreSeparators = []string{}
+	}
+
+	var protectedNames []string
+
+	if err := json.Unmarshal([]byte(m.ProtectedNames), &protectedNames); err != nil {
+		protectedNames = []string{}
+	}
+
+	return entities.TagSplitRules{
+		UserId: m.UserId,
+
+		ArtistSeparators: artistSeparators,
+		GenreSeparators:  genreSeparators,
+
+		ProtectedNames: protectedNames,
+	}
+}
@@ -34,6 +34,11 @@ type TrackModel struct {
 	FileSignature  string `db:"file_signature"`
 	CoverSignature string `db:"cover_signature"`
 
+	CoverDominantColor string `db:"cover_dominant_color"`
+	CoverVibrantColor  string `db:"cover_vibrant_color"`
+	CoverMutedColor    string `db:"cover_muted_color"`
+	CoverBlurHash      string `db:"cover_blurhash"`
+
 	TranscodingLowSignature    string `db:"transcoding_low_signature"`
 	TranscodingMediumSignature string `db:"transcoding_medium_signature"`
 	TranscodingHighSignature   string `db:"transcoding_high_signature"`
@@ -53,25 +58,68 @@ type TrackModel struct {
 	MetadataLyrics  string `db:"metadata_lyrics"`
 	MetadataComment string `db:"metadata_comment"`
 
+	MetadataSyncedLyrics string `db:"metadata_synced_lyrics"`
+
 	MetadataAcoustID string `db:"metadata_acoust_id"`
 
 	MetadataMusicBrainzReleaseId   string `db:"metadata_music_brainz_release_id"`
 	MetadataMusicBrainzTrackId     string `db:"metadata_music_brainz_track_id"`
 	MetadataMusicBrainzRecordingId string `db:"metadata_music_brainz_recording_id"`
 
+	MetadataReleaseTypes  string `db:"metadata_release_types"`
+	MetadataOriginalDate  string `db:"metadata_original_date"`
+	MetadataLabel         string `db:"metadata_label"`
+	MetadataCatalogNumber string `db:"metadata_catalog_number"`
+	MetadataBarcode       string `db:"metadata_barcode"`
+
+	MetadataMusicBrainzReleaseGroupId string `db:"metadata_music_brainz_release_group_id"`
+
 	MetadataArtists      string `db:"metadata_artists"`
 	MetadataAlbumArtists string `db:"metadata_album_artists"`
 
+	MetadataMusicBrainzArtistIds      string `db:"metadata_music_brainz_artist_ids"`
+	MetadataMusicBrainzAlbumArtistIds string `db:"metadata_music_brainz_album_artist_ids"`
+
 	MetadataArtistsRoles string `db:"metadata_artists_roles"`
 
 	MetadataComposer string `db:"metadata_composer"`
 
+	MetadataWork         string `db:"metadata_work"`
+	MetadataMovementName string `db:"metadata_movement_name"`
+
+	MetadataMovementNumber int `db:"metadata_movement_number"`
+	MetadataMovementTotal  int `db:"metadata_movement_total"`
+
+	MetadataMusicBrainzWorkId string `db:"metadata_music_brainz_work_id"`
+
 	SampleRate       int  `db:"sample_rate"`
 	BitRate          *int `db:"bit_rate"`
 	BitsPerRawSample *int `db:"bits_per_raw_sample"`
 
+	LoudnessIntegrated *float64 `db:"loudness_integrated"`
+	LoudnessTruePeak   *float64 `db:"loudness_true_peak"`
+	LoudnessRange      *float64 `db:"loudness_range"`
+
+	AlbumLoudnessIntegrated *float64 `db:"album_loudness_integrated"`
+	AlbumLoudnessTruePeak   *float64 `db:"album_loudness_true_peak"`
+	AlbumLoudnessRange      *float64 `db:"album_loudness_range"`
+
+	AnalysisBpm          *float64 `db:"analysis_bpm"`
+	AnalysisKey          *string  `db:"analysis_key"`
+	AnalysisCamelot      *string  `db:"analysis_camelot"`
+	AnalysisEnergy       *float64 `db:"analysis_energy"`
+	AnalysisDanceability *float64 `db:"analysis_danceability"`
+
+	QualityVerdict         *string `db:"quality_verdict"`
+	QualityCutoffFrequency *int    `db:"quality_cutoff_frequency"`
+	QualitySampleRate      *int    `db:"quality_sample_rate"`
+	QualityBitsPerSample   *int    `db:"quality_bits_per_sample"`
+	QualityBitRate         *int    `db:"quality_bit_rate"`
+
 	PendingImport bool `db:"pending_import"`
 
+	MetadataEditedAt *time.Time `db:"metadata_edited_at"`
+
 	DateAdded time.Time `db:"date_added"`
 
 	CreatedAt time.Time  `db:"created_at"`
@@ -91,18 +139,49 @@ func (m *TrackModel) ToTrack() entities.Track {
 		albumArtists = []string{}
 	}
 
+	var releaseTypes []string
+
+	if err := json.Unmarshal([]byte(m.MetadataReleaseTypes), &releaseTypes); err != nil {
+		releaseTypes = []string{}
+	}
+
+	var musicBrainzArtistIds []string
+
+	if err := json.Unmarshal([]byte(m.MetadataMusicBrainzArtistIds), &musicBrainzArtistIds); err != nil {
+		musicBrainzArtistIds = []string{}
+	}
+
+	var musicBrainzAlbumArtistIds []string
+
+	if err := json.Unmarshal([]byte(m.MetadataMusicBrainzAlbumArtistIds), &musicBrainzAlbumArtistIds); err != nil {
+		musicBrainzAlbumArtistIds = []string{}
+	}
+
 	var genres []string
 
 	if err := json.Unmarshal([]byte(m.MetadataGenres), &genres); err != nil {
 		genres = []string{}
 	}
 
+	var syncedLyrics TrackLyricsLineModels
+
+	if err := json.Unmarshal([]byte(m.MetadataSyncedLyrics), &syncedLyrics); err != nil {
+		syncedLyrics = TrackLyricsLineModels{}
+	}
+
 	var artistsRoles TrackArtistRoleModels
 
 	if err := json.Unmarshal([]byte(m.MetadataArtistsRoles), &artistsRoles); err != nil {
 		artistsRoles = TrackArtistRoleModels{}
 	}
 
+	var qualityVerdict *entities.TrackQualityVerdict
+
+	if m.QualityVerdict != nil {
+		verdict := entities.TrackQualityVerdict(*m.QualityVerdict)
+		qualityVerdict = &verdict
+	}
+
 	return entities.Track{
 		Id: m.Id,
 
@@ -118,6 +197,14 @@ func (m *TrackModel) ToTrack() entities.Track {
 		FileSignature:  m.FileSignature,
 		CoverSignature: m.CoverSignature,
 
+		CoverPalette: entities.CoverPalette{
+			Dominant: m.CoverDominantColor,
+			Vibrant:  m.CoverVibrantColor,
+			Muted:    m.CoverMutedColor,
+
+			BlurHash: m.CoverBlurHash,
+		},
+
 		TranscodingLowSignature:    m.TranscodingLowSignature,
 		TranscodingMediumSignature: m.TranscodingMediumSignature,
 		TranscodingHighSignature:   m.TranscodingHighSignature,
@@ -140,25 +227,78 @@ func (m *TrackModel) ToTrack() entities.Track {
 			Lyrics:  m.MetadataLyrics,
 			Comment: m.MetadataComment,
 
+			SyncedLyrics: syncedLyrics.ToTrackLyricsLines(),
+
 			AcoustID: m.MetadataAcoustID,
 
 			MusicBrainzReleaseId:   m.MetadataMusicBrainzReleaseId,
 			MusicBrainzTrackId:     m.MetadataMusicBrainzTrackId,
 			MusicBrainzRecordingId: m.MetadataMusicBrainzRecordingId,
 
+			ReleaseTypes:  releaseTypes,
+			OriginalDate:  m.MetadataOriginalDate,
+			Label:         m.MetadataLabel,
+			CatalogNumber: m.MetadataCatalogNumber,
+			Barcode:       m.MetadataBarcode,
+
+			MusicBrainzReleaseGroupId: m.MetadataMusicBrainzReleaseGroupId,
+
 			Artists:      artists,
 			AlbumArtists: albumArtists,
 
+			MusicBrainzArtistIds:      musicBrainzArtistIds,
+			MusicBrainzAlbumArtistIds: musicBrainzAlbumArtistIds,
+
 			ArtistsRoles: artistsRoles.ToTrackArtistRoles(),
 
 			Composer: m.MetadataComposer,
+
+			Work:         m.MetadataWork,
+			MovementName: m.MetadataMovementName,
+
+			MovementNumber: m.MetadataMovementNumber,
+			MovementTotal:  m.MetadataMovementTotal,
+
+			MusicBrainzWorkId: m.MetadataMusicBrainzWorkId,
 		},
 
 		SampleRate:       m.SampleRate,
 		BitRate:          m.BitRate,
 		BitsPerRawSample: m.BitsPerRawSample,
 
+		Loudness: entities.TrackLoudness{
+			Integrated: m.LoudnessIntegrated,
+			TruePeak:   m.LoudnessTruePeak,
+			Range:      m.LoudnessRange,
+
+			AlbumIntegrated: m.AlbumLoudnessIntegrated,
+			AlbumTruePeak:   m.AlbumLoudnessTruePeak,
+			AlbumRange:      m.AlbumLoudnessRange,
+		},
+
+		Analysis: entities.TrackAnalysis{
+			Bpm: m.AnalysisBpm,
+
+			Key:     m.AnalysisKey,
+			Camelot: m.AnalysisCamelot,
+
+			Energy:       m.AnalysisEnergy,
+			Danceability: m.AnalysisDanceability,
+		},
+
+		QualityCheck: entities.TrackQualityCheck{
+			Verdict: qualityVerdict,
+
+			CutoffFrequency: m.QualityCutoffFrequency,
+
+			SampleRate:    m.QualitySampleRate,
+			BitsPerSample: m.QualityBitsPerSample,
+			BitRate:       m.QualityBitRate,
+		},
+
 		PendingImport: m.PendingImport,
+
+		MetadataEditedAt: m.MetadataEditedAt,
 	}
 }
 
@@ -189,6 +329,8 @@ func TrackArtistRoleModelsFromEntities(
 type TrackArtistRoleModel struct {
 	Type string `json:"type"`
 
+	Role string `json:"role,omitempty"`
+
 	Artist string `json:"artist"`
 
 	Attributes []string `json:"attributes"`
@@ -198,6 +340,8 @@ func (m *TrackArtistRoleModel) ToTrackArtistRole() entities.TrackArtistRole {
 	return entities.TrackArtistRole{
 		Type: m.Type,
 
+		Role: m.Role,
+
 		Artist: m.Artist,
 
 		Attributes: m.Attributes,
@@ -208,8 +352,54 @@ func TrackArtistRoleModelFromEntity(artistRole entities.TrackArtistRole) TrackAr
 	return TrackArtistRoleModel{
 		Type: artistRole.Type,
 
+		Role: artistRole.Role,
+
 		Artist: artistRole.Artist,
 
 		Attributes: artistRole.Attributes,
 	}
 }
+
+type TrackLyricsLineModels []TrackLyricsLineModel
+
+func (s TrackLyricsLineModels) ToTrackLyricsLines() []entities.TrackLyricsLine {
+	e := make([]entities.TrackLyricsLine, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToTrackLyricsLine())
+	}
+
+	return e
+}
+
+func TrackLyricsLineModelsFromEntities(
+	lines []entities.TrackLyricsLine,
+) TrackLyricsLineModels {
+	m := make(TrackLyricsLineModels, 0, len(lines))
+
+	for _, e := range lines {
+		m = append(m, TrackLyricsLineModelFromEntity(e))
+	}
+
+	return m
+}
+
+type TrackLyricsLineModel struct {
+	// Time is in milliseconds
+	Time int64  `json:"time"`
+	Text string `json:"text"`
+}
+
+func (m *TrackLyricsLineModel) ToTrackLyricsLine() entities.TrackLyricsLine {
+	return entities.TrackLyricsLine{
+		Time: time.Duration(m.Time) * time.Millisecond,
+		Text: m.Text,
+	}
+}
+
+func TrackLyricsLineModelFromEntity(line entities.TrackLyricsLine) TrackLyricsLineModel {
+	return TrackLyricsLineModel{
+		Time: line.Time.Milliseconds(),
+		Text: line.Text,
+	}
+}
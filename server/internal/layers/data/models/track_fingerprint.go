@@ -0,0 +1,34 @@
+package data_models
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/pkgs/fingerprintmatch"
+)
+
+type TrackFingerprintModel struct {
+	TrackId int `db:"track_id"`
+
+	FileSignature string `db:"file_signature"`
+	Fingerprint   []byte `db:"fingerprint"`
+}
+
+type TrackFingerprintModels []TrackFingerprintModel
+
+func (s TrackFingerprintModels) ToTrackFingerprints() []entities.TrackFingerprint {
+	e := make([]entities.TrackFingerprint, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToTrackFingerprint())
+	}
+
+	return e
+}
+
+func (m *TrackFingerprintModel) ToTrackFingerprint() entities.TrackFingerprint {
+	return entities.TrackFingerprint{
+		TrackId: m.TrackId,
+
+		FileSignature: m.FileSignature,
+		Fingerprint:   fingerprintmatch.Decode(m.Fingerprint),
+	}
+}
@@ -0,0 +1,63 @@
+package data_models
+
+import (
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type WorkModels []WorkModel
+
+func (s WorkModels) ToWorks() []entities.Work {
+	e := make([]entities.Work, 0, len(s))
+
+	for _, m := range s {
+		e = append(e, m.ToWork())
+	}
+
+	return e
+}
+
+type WorkModel struct {
+	Id int `db:"id"`
+
+	Name string `db:"name"`
+
+	MusicBrainzWorkId string `db:"music_brainz_work_id"`
+
+	UserId *int `db:"user_id"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt *time.Time `db:"updated_at"`
+}
+
+func (m *WorkModel) ToWork() entities.Work {
+	return entities.Work{
+		Id:   m.Id,
+		Name: m.Name,
+
+		MusicBrainzWorkId: m.MusicBrainzWorkId,
+
+		UserId: m.UserId,
+
+		Composers: []entities.Artist{},
+		Tracks:    []entities.Track{},
+	}
+}
+
+type WorkCountModel struct {
+	WorkId int `db:"work_id"`
+	Count  int `db:"count"`
+}
+
+type WorkComposerModel struct {
+	WorkId int `db:"work_id"`
+
+	ArtistModel
+}
+
+type TrackRoleArtistModel struct {
+	Role string `db:"role"`
+
+	ArtistModel
+}
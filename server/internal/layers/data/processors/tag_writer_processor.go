@@ -0,0 +1,437 @@
+package processors
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/pkgs/audiotags"
+	"github.com/gungun974/Melodink/server/pkgs/lrc"
+	"github.com/h2non/bimg"
+)
+
+func NewTagWriterProcessor() TagWriterProcessor {
+	return TagWriterProcessor{
+		Enabled: helpers.GetEnvBool("TAG_WRITER_ENABLED", false),
+	}
+}
+
+// TagWriterProcessor write the metadata stored in database back into the
+// audio file with FFmpeg. It's opt-in since it modify the user files.
+type TagWriterProcessor struct {
+	Enabled bool
+}
+
+var (
+	TagWriterDisabledError          = errors.New("Tag writer is disabled")
+	TagWriterUnsupportedFormatError = errors.New("Tag writer doesn't support this audio format")
+	TagWriterExitError              = errors.New("FFmpeg failed to write tags")
+	TagWriterMultiValueError        = errors.New("Failed to write multi-value tags")
+)
+
+type tagWriterFormat int
+
+const (
+	tagWriterFormatID3 tagWriterFormat = iota
+	tagWriterFormatFLAC
+	tagWriterFormatOgg
+	tagWriterFormatMP4
+)
+
+// Linux refuse a single argument bigger than 128KiB so embedded pictures in
+// Vorbis comments above this size are skipped.
+const maxMetadataBlockPictureSize = 120 * 1024
+
+func getTagWriterFormat(path string) (tagWriterFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".mp3":
+		return tagWriterFormatID3, nil
+	case ".flac":
+		return tagWriterFormatFLAC, nil
+	case ".ogg", ".oga", ".opus":
+		return tagWriterFormatOgg, nil
+	case ".m4a", ".m4b", ".mp4", ".alac":
+		return tagWriterFormatMP4, nil
+	}
+
+	return 0, TagWriterUnsupportedFormatError
+}
+
+func formatTagNumber(number int, total int) string {
+	if number <= 0 {
+		return ""
+	}
+
+	if total <= 0 {
+		return strconv.Itoa(number)
+	}
+
+	return fmt.Sprintf("%d/%d", number, total)
+}
+
+func formatTagTotal(total int) string {
+	if total <= 0 {
+		return ""
+	}
+
+	return strconv.Itoa(total)
+}
+
+func getTrackTags(track entities.Track, format tagWriterFormat) [][2]string {
+	date := track.Metadata.Date
+
+	if helpers.IsEmptyOrWhitespace(date) && track.Metadata.Year > 0 {
+		date = strconv.Itoa(track.Metadata.Year)
+	}
+
+	lyrics := track.Metadata.Lyrics
+
+	// Keep timings by writing synced lyrics in the LRC format
+	if len(track.Metadata.SyncedLyrics) != 0 {
+		lines := make([]lrc.Line, len(track.Metadata.SyncedLyrics))
+
+		for i, line := range track.Metadata.SyncedLyrics {
+			lines[i] = lrc.Line{
+				Time: line.Time,
+				Text: line.Text,
+			}
+		}
+
+		lyrics = lrc.Format(lines)
+	}
+
+	tags := [][2]string{
+		{"title", track.Title},
+		{"album", track.Metadata.Album},
+		{"date", date},
+		{"lyrics", lyrics},
+		{"comment", track.Metadata.Comment},
+		{"composer", track.Metadata.Composer},
+		{"publisher", track.Metadata.Label},
+	}
+
+	switch format {
+	case tagWriterFormatFLAC, tagWriterFormatOgg:
+		tags = append(tags,
+			[2]string{"TRACKNUMBER", formatTagNumber(track.Metadata.TrackNumber, 0)},
+			[2]string{"TRACKTOTAL", formatTagTotal(track.Metadata.TotalTracks)},
+			[2]string{"DISCNUMBER", formatTagNumber(track.Metadata.DiscNumber, 0)},
+			[2]string{"DISCTOTAL", formatTagTotal(track.Metadata.TotalDiscs)},
+			[2]string{"ACOUSTID_ID", track.Metadata.AcoustID},
+			[2]string{"MUSICBRAINZ_ALBUMID", track.Metadata.MusicBrainzReleaseId},
+			[2]string{"MUSICBRAINZ_TRACKID", track.Metadata.MusicBrainzTrackId},
+			[2]string{"MUSICBRAINZ_RECORDINGID", track.Metadata.MusicBrainzRecordingId},
+			[2]string{"MUSICBRAINZ_RELEASEGROUPID", track.Metadata.MusicBrainzReleaseGroupId},
+			[2]string{"ORIGINALDATE", track.Metadata.OriginalDate},
+			[2]string{"CATALOGNUMBER", track.Metadata.CatalogNumber},
+			[2]string{"BARCODE", track.Metadata.Barcode},
+			[2]string{"WORK", track.Metadata.Work},
+			[2]string{"MOVEMENTNAME", track.Metadata.MovementName},
+			[2]string{"MOVEMENT", formatTagNumber(track.Metadata.MovementNumber, 0)},
+			[2]string{"MOVEMENTTOTAL", formatTagTotal(track.Metadata.MovementTotal)},
+			[2]string{"MUSICBRAINZ_WORKID", track.Metadata.MusicBrainzWorkId},
+		)
+	case tagWriterFormatID3:
+		tags = append(tags,
+			[2]string{"track", formatTagNumber(track.Metadata.TrackNumber, track.Metadata.TotalTracks)},
+			[2]string{"disc", formatTagNumber(track.Metadata.DiscNumber, track.Metadata.TotalDiscs)},
+			[2]string{"Acoustid Id", track.Metadata.AcoustID},
+			[2]string{"MusicBrainz Album Id", track.Metadata.MusicBrainzReleaseId},
+			[2]string{"MusicBrainz Release Track Id", track.Metadata.MusicBrainzTrackId},
+			[2]string{"MusicBrainz Recording Id", track.Metadata.MusicBrainzRecordingId},
+			[2]string{"MusicBrainz Release Group Id", track.Metadata.MusicBrainzReleaseGroupId},
+			[2]string{"originaldate", track.Metadata.OriginalDate},
+			[2]string{"CATALOGNUMBER", track.Metadata.CatalogNumber},
+			[2]string{"BARCODE", track.Metadata.Barcode},
+			[2]string{"WORK", track.Metadata.Work},
+			[2]string{"MOVEMENTNAME", track.Metadata.MovementName},
+			[2]string{
+				"MOVEMENT",
+				formatTagNumber(track.Metadata.MovementNumber, track.Metadata.MovementTotal),
+			},
+			[2]string{"MusicBrainz Work Id", track.Metadata.MusicBrainzWorkId},
+		)
+	case tagWriterFormatMP4:
+		// MP4 atoms of FFmpeg only hold one value, the multi-value freeform
+		// iTunes atoms are written after by getTrackMultiValueTags
+		tags = append(tags,
+			[2]string{"artist", strings.Join(track.Metadata.Artists, "; ")},
+			[2]string{"album_artist", strings.Join(track.Metadata.AlbumArtists, "; ")},
+			[2]string{"genre", strings.Join(track.Metadata.Genres, "; ")},
+			[2]string{"track", formatTagNumber(track.Metadata.TrackNumber, track.Metadata.TotalTracks)},
+			[2]string{"disc", formatTagNumber(track.Metadata.DiscNumber, track.Metadata.TotalDiscs)},
+		)
+	}
+
+	return tags
+}
+
+// getTrackMultiValueTags return the tags FFmpeg would join in a single value,
+// they are written after with one frame or comment per value. The names are
+// the ones of MusicBrainz Picard and a tag without values is removed.
+func getTrackMultiValueTags(track entities.Track, format tagWriterFormat) []audiotags.MultiValueTag {
+	switch format {
+	case tagWriterFormatID3:
+		return []audiotags.MultiValueTag{
+			{Name: "TPE1", Values: track.Metadata.Artists},
+			{Name: "TPE2", Values: track.Metadata.AlbumArtists},
+			{Name: "TCON", Values: track.Metadata.Genres},
+			{Name: "MusicBrainz Album Type", Values: track.Metadata.ReleaseTypes},
+			{Name: "MusicBrainz Artist Id", Values: track.Metadata.MusicBrainzArtistIds},
+			{Name: "MusicBrainz Album Artist Id", Values: track.Metadata.MusicBrainzAlbumArtistIds},
+			// TPE1 and TPE2 already hold every artists, remove these ones so
+			// the old artists don't come back on the next scan
+			{Name: "ARTISTS"},
+			{Name: "ALBUMARTISTS"},
+		}
+	case tagWriterFormatFLAC, tagWriterFormatOgg:
+		return []audiotags.MultiValueTag{
+			{Name: "ARTIST", Values: track.Metadata.Artists},
+			{Name: "ALBUMARTIST", Values: track.Metadata.AlbumArtists},
+			{Name: "ALBUM ARTIST"},
+			{Name: "GENRE", Values: track.Metadata.Genres},
+			{Name: "RELEASETYPE", Values: track.Metadata.ReleaseTypes},
+			{Name: "MUSICBRAINZ_ARTISTID", Values: track.Metadata.MusicBrainzArtistIds},
+			{Name: "MUSICBRAINZ_ALBUMARTISTID", Values: track.Metadata.MusicBrainzAlbumArtistIds},
+			{Name: "ARTISTS"},
+			{Name: "ALBUMARTISTS"},
+		}
+	}
+
+	single := func(value string) []string {
+		return []string{value}
+	}
+
+	// FFmpeg mov muxer can't write freeform iTunes atoms
+	return []audiotags.MultiValueTag{
+		{Name: "Acoustid Id", Values: single(track.Metadata.AcoustID)},
+		{Name: "MusicBrainz Album Id", Values: single(track.Metadata.MusicBrainzReleaseId)},
+		{Name: "MusicBrainz Release Track Id", Values: single(track.Metadata.MusicBrainzTrackId)},
+		{Name: "MusicBrainz Track Id", Values: single(track.Metadata.MusicBrainzRecordingId)},
+		{
+			Name:   "MusicBrainz Release Group Id",
+			Values: single(track.Metadata.MusicBrainzReleaseGroupId),
+		},
+		{Name: "MusicBrainz Album Type", Values: track.Metadata.ReleaseTypes},
+		{Name: "CATALOGNUMBER", Values: single(track.Metadata.CatalogNumber)},
+		{Name: "BARCODE", Values: single(track.Metadata.Barcode)},
+		{Name: "MusicBrainz Work Id", Values: single(track.Metadata.MusicBrainzWorkId)},
+		{Name: "MusicBrainz Artist Id", Values: track.Metadata.MusicBrainzArtistIds},
+		{
+			Name:   "MusicBrainz Album Artist Id",
+			Values: track.Metadata.MusicBrainzAlbumArtistIds,
+		},
+		// ©ART and aART only hold one value
+		{Name: "ARTISTS", Values: track.Metadata.Artists},
+		{Name: "ALBUMARTISTS", Values: track.Metadata.AlbumArtists},
+	}
+}
+
+// convertCoverForTags make sure the cover is a JPEG or a PNG since it's the
+// only formats every tag container agree on.
+func convertCoverForTags(cover []byte) ([]byte, string, error) {
+	mtype := mimetype.Detect(cover)
+
+	if mtype.Is("image/jpeg") || mtype.Is("image/png") {
+		return cover, mtype.String(), nil
+	}
+
+	converted, err := bimg.NewImage(cover).Convert(bimg.JPEG)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return converted, "image/jpeg", nil
+}
+
+// encodeMetadataBlockPicture build a FLAC PICTURE block, Vorbis comments in
+// Ogg stores covers as base64 of this block.
+func encodeMetadataBlockPicture(cover []byte, mimeType string) string {
+	var block bytes.Buffer
+
+	writeUint32 := func(value int) {
+		_ = binary.Write(&block, binary.BigEndian, uint32(value))
+	}
+
+	width, height := 0, 0
+
+	if size, err := bimg.NewImage(cover).Size(); err == nil {
+		width, height = size.Width, size.Height
+	}
+
+	// Front cover
+	writeUint32(3)
+
+	writeUint32(len(mimeType))
+	block.WriteString(mimeType)
+
+	writeUint32(0)
+
+	writeUint32(width)
+	writeUint32(height)
+	writeUint32(24)
+	writeUint32(0)
+
+	writeUint32(len(cover))
+	block.Write(cover)
+
+	return base64.StdEncoding.EncodeToString(block.Bytes())
+}
+
+// WriteTrackTags replace the tags of the track audio file with the track
+// metadata. When cover is not nil it replace the embedded picture too.
+func (p *TagWriterProcessor) WriteTrackTags(
+	track entities.Track,
+	cover []byte,
+) error {
+	if !p.Enabled {
+		return TagWriterDisabledError
+	}
+
+	format, err := getTagWriterFormat(track.Path)
+	if err != nil {
+		return err
+	}
+
+	directory := filepath.Dir(track.Path)
+
+	tempPath := filepath.Join(
+		directory,
+		".melodink-tags-"+strconv.Itoa(track.Id)+filepath.Ext(track.Path),
+	)
+
+	args := []string{
+		"-v", "error",
+		"-y",
+		"-i", track.Path,
+	}
+
+	var coverPath string
+
+	if cover != nil {
+		convertedCover, mimeType, err := convertCoverForTags(cover)
+		if err != nil {
+			logger.TranscoderLogger.Warnf("Can't convert cover of track %d for tags : %v", track.Id, err)
+			cover = nil
+		} else if format == tagWriterFormatOgg {
+			encodedPicture := encodeMetadataBlockPicture(convertedCover, mimeType)
+
+			if len(encodedPicture) > maxMetadataBlockPictureSize {
+				logger.TranscoderLogger.Warnf("Cover of track %d is too big to be embedded in Vorbis comments", track.Id)
+			} else {
+				args = append(args, "-metadata:s:a:0", "METADATA_BLOCK_PICTURE="+encodedPicture)
+			}
+		} else {
+			coverFile, err := os.CreateTemp("", "melodink-cover-*")
+			if err != nil {
+				return err
+			}
+
+			coverPath = coverFile.Name()
+			defer os.Remove(coverPath)
+
+			_, err = coverFile.Write(convertedCover)
+			coverFile.Close()
+			if err != nil {
+				return err
+			}
+
+			args = append(args, "-i", coverPath)
+		}
+	}
+
+	args = append(args, "-map", "0:a")
+
+	if coverPath != "" {
+		args = append(args,
+			"-map", "1:0",
+			"-disposition:v:0", "attached_pic",
+		)
+	} else if format != tagWriterFormatOgg {
+		args = append(args, "-map", "0:v?")
+	}
+
+	args = append(args,
+		"-c", "copy",
+		"-map_metadata", "0",
+	)
+
+	metadataFlag := "-metadata"
+
+	switch format {
+	case tagWriterFormatID3:
+		args = append(args, "-id3v2_version", "4", "-write_id3v1", "0")
+	case tagWriterFormatOgg:
+		// Ogg keep Vorbis comments on the stream and not on the container
+		args = append(args, "-map_metadata:s:a:0", "0:s:a:0")
+		metadataFlag = "-metadata:s:a:0"
+	}
+
+	for _, tag := range getTrackTags(track, format) {
+		args = append(args, metadataFlag, tag[0]+"="+tag[1])
+	}
+
+	args = append(args, tempPath)
+
+	logger.TranscoderLogger.Infof("Start writing tags of file %s", track.Path)
+
+	cmd := exec.Command("ffmpeg", args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		_ = os.Remove(tempPath)
+
+		logger.TranscoderLogger.Errorf(
+			"Failed writing tags of file %s : %v %s",
+			track.Path,
+			err,
+			stderr.String(),
+		)
+
+		return fmt.Errorf("%w: %w", TagWriterExitError, err)
+	}
+
+	multiValueTags := getTrackMultiValueTags(track, format)
+
+	switch format {
+	case tagWriterFormatID3:
+		err = audiotags.WriteID3v2Tags(tempPath, multiValueTags)
+	case tagWriterFormatFLAC, tagWriterFormatOgg:
+		err = audiotags.WriteVorbisComments(tempPath, multiValueTags)
+	case tagWriterFormatMP4:
+		err = audiotags.WriteMP4FreeformTags(tempPath, multiValueTags)
+	}
+	if err != nil {
+		_ = os.Remove(tempPath)
+
+		logger.TranscoderLogger.Errorf(
+			"Failed writing multi-value tags of file %s : %v",
+			track.Path,
+			err,
+		)
+
+		return fmt.Errorf("%w: %w", TagWriterMultiValueError, err)
+	}
+
+	if err := os.Rename(tempPath, track.Path); err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+
+	logger.TranscoderLogger.Infof("Finish writing tags of file %s", track.Path)
+
+	return nil
+}
@@ -3,16 +3,38 @@ package processors
 import (
 	"errors"
 	"fmt"
+	"math"
 	"os/exec"
+	"strconv"
 
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	"github.com/gungun974/Melodink/server/internal/logger"
 )
 
 func NewTranscodeProcessor() TranscodeProcessor {
-	return TranscodeProcessor{}
+	return TranscodeProcessor{
+		Normalisation: TranscodeNormalisation(
+			helpers.GetEnvString("TRANSCODE_NORMALISATION", string(TranscodeNormalisationNone)),
+		),
+	}
+}
+
+type TranscodeProcessor struct {
+	Normalisation TranscodeNormalisation
 }
 
-type TranscodeProcessor struct{}
+type TranscodeNormalisation string
+
+const (
+	TranscodeNormalisationNone  TranscodeNormalisation = "none"
+	TranscodeNormalisationTrack TranscodeNormalisation = "track"
+	TranscodeNormalisationAlbum TranscodeNormalisation = "album"
+)
+
+// Keep one dB of headroom when a gain is applied since lossy encoders can
+// overshoot the source peaks.
+const transcodeNormalisationMaxPeak = -1.0
 
 var (
 	TranscoderKilledError = errors.New("FFmpeg was killed early")
@@ -20,46 +42,78 @@ var (
 	TranscoderScanError   = errors.New("Failed to find input format")
 )
 
+// GetTrackGain return the gain in dB applied to the transcoded files of the
+// track, it's 0 when normalisation is disabled or the loudness is unknown.
+func (p *TranscodeProcessor) GetTrackGain(track entities.Track) float64 {
+	var gain, peak *float64
+
+	switch p.Normalisation {
+	case TranscodeNormalisationAlbum:
+		gain, peak = track.Loudness.AlbumGain(), track.Loudness.AlbumTruePeak
+		if gain == nil {
+			gain, peak = track.Loudness.TrackGain(), track.Loudness.TruePeak
+		}
+	case TranscodeNormalisationTrack:
+		gain, peak = track.Loudness.TrackGain(), track.Loudness.TruePeak
+	}
+
+	if gain == nil {
+		return 0
+	}
+
+	value := *gain
+
+	if peak != nil {
+		value = min(value, transcodeNormalisationMaxPeak-*peak)
+	}
+
+	return math.Round(value*100) / 100
+}
+
 func (p *TranscodeProcessor) TranscodeLow(
 	sourcePath string,
 	destinationPath string,
+	gain float64,
 ) error {
 	return p.transcode([]string{
 		"-b:a", "96k",
 		"-c:a", "libopus",
 		"-vbr", "on",
 		"-f", "opus",
-	}, sourcePath, destinationPath)
+	}, sourcePath, destinationPath, gain)
 }
 
 func (p *TranscodeProcessor) TranscodeMedium(
 	sourcePath string,
 	destinationPath string,
+	gain float64,
 ) error {
 	return p.transcode([]string{
 		"-b:a", "128k",
 		"-c:a", "libopus",
 		"-vbr", "on",
 		"-f", "opus",
-	}, sourcePath, destinationPath)
+	}, sourcePath, destinationPath, gain)
 }
 
 func (p *TranscodeProcessor) TranscodeHigh(
 	sourcePath string,
 	destinationPath string,
+	gain float64,
 ) error {
 	return p.transcode([]string{
 		"-b:a", "320k",
 		"-c:a", "libopus",
 		"-vbr", "on",
 		"-f", "opus",
-	}, sourcePath, destinationPath)
+	}, sourcePath, destinationPath, gain)
 }
 
 func (*TranscodeProcessor) transcode(
 	audioArguments []string,
 	sourcePath string,
 	destinationPath string,
+	gain float64,
 ) error {
 	args := []string{}
 
@@ -71,6 +125,12 @@ func (*TranscodeProcessor) transcode(
 		"-vn",
 	)
 
+	if gain != 0 {
+		args = append(args,
+			"-af", "volume="+strconv.FormatFloat(gain, 'f', 2, 64)+"dB",
+		)
+	}
+
 	args = append(args,
 		audioArguments...,
 	)
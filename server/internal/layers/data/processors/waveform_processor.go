@@ -0,0 +1,139 @@
+package processors
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+func NewWaveformProcessor() WaveformProcessor {
+	return WaveformProcessor{}
+}
+
+type WaveformProcessor struct{}
+
+var WaveformExitError = errors.New("FFmpeg failed to decode audio for waveform")
+
+const (
+	waveformSampleRate = 8000
+
+	// One peak every 10ms is the finest level every resolution is reduced from
+	waveformWindowSize = waveformSampleRate / 100
+)
+
+// GenerateWaveform decode the audio file once and compute the peaks of every
+// resolutions in entities.TrackWaveformResolutions.
+func (p *WaveformProcessor) GenerateWaveform(sourcePath string) (map[int][]int, error) {
+	cmd := exec.Command("ffmpeg",
+		"-v", "error",
+		"-i", sourcePath,
+		"-map", "0:a:0",
+		"-ac", "1",
+		"-ar", fmt.Sprint(waveformSampleRate),
+		"-f", "s16le",
+		"-c:a", "pcm_s16le",
+		"pipe:1",
+	)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	logger.TranscoderLogger.Infof("Start generating waveform of file %s", sourcePath)
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	windows := []int{}
+
+	reader := bufio.NewReader(stdout)
+	sample := make([]byte, 2)
+
+	peak := 0
+	count := 0
+
+	for {
+		if _, err := io.ReadFull(reader, sample); err != nil {
+			break
+		}
+
+		value := int(int16(binary.LittleEndian.Uint16(sample)))
+		if value < 0 {
+			value = -value
+		}
+
+		peak = max(peak, value)
+		count++
+
+		if count == waveformWindowSize {
+			windows = append(windows, peak)
+			peak = 0
+			count = 0
+		}
+	}
+
+	if count > 0 {
+		windows = append(windows, peak)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		logger.TranscoderLogger.Errorf(
+			"Failed generating waveform of file %s : %v %s",
+			sourcePath,
+			err,
+			stderr.String(),
+		)
+		return nil, fmt.Errorf("%w: %w", WaveformExitError, err)
+	}
+
+	peaks := make(map[int][]int, len(entities.TrackWaveformResolutions))
+
+	for _, resolution := range entities.TrackWaveformResolutions {
+		peaks[resolution] = reduceWaveformPeaks(windows, resolution)
+	}
+
+	logger.TranscoderLogger.Infof("Finish generating waveform of file %s", sourcePath)
+
+	return peaks, nil
+}
+
+// reduceWaveformPeaks group the windows into resolution buckets keeping the
+// highest peak of each one scaled between 0 and 255.
+func reduceWaveformPeaks(windows []int, resolution int) []int {
+	peaks := make([]int, resolution)
+
+	if len(windows) == 0 {
+		return peaks
+	}
+
+	for i := range peaks {
+		start := i * len(windows) / resolution
+		end := (i + 1) * len(windows) / resolution
+
+		if end <= start {
+			end = start + 1
+		}
+
+		peak := 0
+
+		for _, window := range windows[start:min(end, len(windows))] {
+			peak = max(peak, window)
+		}
+
+		peaks[i] = min(255, peak*255/32767)
+	}
+
+	return peaks
+}
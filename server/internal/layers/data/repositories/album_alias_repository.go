@@ -0,0 +1,225 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/jmoiron/sqlx"
+)
+
+// GetAlbumByAlias return the album which absorbed a merged album of this name.
+// The merged album artists may have been moved too, so the album only need to
+// include albumArtists.
+func (r *AlbumRepository) GetAlbumByAlias(
+	name string,
+	albumArtists []entities.Artist,
+	userId int,
+) (*entities.Album, error) {
+	m := data_models.AlbumModel{}
+
+	artistIds := make([]int, len(albumArtists))
+
+	for i, artist := range albumArtists {
+		artistIds[i] = artist.Id
+	}
+
+	var err error
+
+	if len(artistIds) == 0 {
+		err = r.Database.Get(&m, `
+		SELECT albums.*
+		FROM albums
+		JOIN album_aliases ON album_aliases.album_id = albums.id
+		WHERE album_aliases.name = ? AND album_aliases.user_id = ?
+		ORDER BY albums.id
+		LIMIT 1
+	`, strings.ToLower(name), userId)
+	} else {
+		var reqQuery string
+		var reqArgs []any
+
+		reqQuery, reqArgs, err = sqlx.In(`
+		SELECT albums.*
+		FROM albums
+		JOIN album_aliases ON album_aliases.album_id = albums.id
+		JOIN album_artist ON album_artist.album_id = albums.id
+		WHERE album_aliases.name = ? AND album_aliases.user_id = ?
+		GROUP BY albums.id
+		HAVING COUNT(DISTINCT CASE WHEN album_artist.artist_id IN (?) THEN album_artist.artist_id END) = ?
+		ORDER BY albums.id
+		LIMIT 1
+	`, strings.ToLower(name), userId, artistIds, len(artistIds))
+		if err != nil {
+			return nil, err
+		}
+		reqQuery = r.Database.Rebind(reqQuery)
+
+		err = r.Database.Get(&m, reqQuery, reqArgs...)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, AlbumNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	album := m.ToAlbum()
+
+	err = r.LoadTracksInAlbum(&album)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = r.LoadArtistsInAlbum(&album)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadGenresInAlbum(&album)
+	if err != nil {
+		return nil, err
+	}
+
+	return &album, nil
+}
+
+// MergeAlbums move the track and artist links of every source to destination
+// in one transaction, source artists are added after the destination ones in
+// their order. Sources are deleted and their names kept as aliases of
+// destination.
+func (r *AlbumRepository) MergeAlbums(
+	sources []entities.Album,
+	destination *entities.Album,
+) error {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	for i := range sources {
+		if err := r.mergeAlbum(tx, &sources[i], destination); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	_, err = tx.Exec(
+		"UPDATE albums SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE id = ?",
+		destination.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// mergeAlbum move the links of source to destination, delete source and keep
+// its name as an alias of destination.
+func (r *AlbumRepository) mergeAlbum(
+	tx *sqlx.Tx,
+	source *entities.Album,
+	destination *entities.Album,
+) error {
+	_, err := tx.Exec(`
+    UPDATE tracks
+    SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id IN (SELECT track_id FROM track_album WHERE album_id = ?)
+  `, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO track_album (album_id, track_id, album_pos)
+    SELECT ?, track_id, album_pos FROM track_album WHERE album_id = ?
+  `, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO album_artist (album_id, artist_id, artist_pos)
+    SELECT
+      ?,
+      artist_id,
+      COALESCE(artist_pos, 0) + (
+        SELECT COALESCE(MAX(artist_pos), -1) + 1 FROM album_artist WHERE album_id = ?
+      )
+    FROM album_artist
+    WHERE album_id = ?
+  `, destination.Id, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM track_album WHERE album_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM album_artist WHERE album_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM album_genre WHERE album_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	// Aliases of source now point to destination
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO album_aliases (user_id, name, album_id, created_at)
+    SELECT user_id, name, ?, created_at FROM album_aliases WHERE album_id = ?
+  `, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM album_aliases WHERE album_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	if !strings.EqualFold(source.Name, destination.Name) {
+		_, err = tx.Exec(`
+      INSERT OR IGNORE INTO album_aliases (user_id, name, album_id)
+      VALUES (?, ?, ?)
+    `, source.UserId, strings.ToLower(source.Name), destination.Id)
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			return err
+		}
+	}
+
+	_, err = tx.Exec("DELETE FROM albums WHERE id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO deleted_albums (id) VALUES (?)", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	return nil
+}
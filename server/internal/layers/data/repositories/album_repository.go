@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"sync"
 	"time"
@@ -48,6 +49,11 @@ func (r *AlbumRepository) GetAllAlbumsFromUser(userId int) ([]entities.Album, er
 		return nil, err
 	}
 
+	err = r.LoadGenresInAlbums(albums)
+	if err != nil {
+		return nil, err
+	}
+
 	return albums, nil
 }
 
@@ -72,6 +78,11 @@ func (r *AlbumRepository) GetAllAlbumsFromUserSince(
 		return nil, err
 	}
 
+	err = r.LoadGenresInAlbums(albums)
+	if err != nil {
+		return nil, err
+	}
+
 	return albums, nil
 }
 
@@ -103,6 +114,11 @@ func (r *AlbumRepository) GetAlbumById(id int) (*entities.Album, error) {
 		return nil, err
 	}
 
+	err = r.LoadGenresInAlbum(&album)
+	if err != nil {
+		return nil, err
+	}
+
 	return &album, nil
 }
 
@@ -175,6 +191,11 @@ func (r *AlbumRepository) GetAlbumByName(
 		return nil, err
 	}
 
+	err = r.LoadGenresInAlbum(&album)
+	if err != nil {
+		return nil, err
+	}
+
 	return &album, nil
 }
 
@@ -239,9 +260,94 @@ func (r *AlbumRepository) LoadArtistsInAlbums(albums []entities.Album) error {
 	return nil
 }
 
+func (r *AlbumRepository) LoadGenresInAlbum(album *entities.Album) error {
+	m := data_models.GenreModels{}
+
+	err := r.Database.Select(&m, `
+		SELECT genres.*
+		FROM genres
+		JOIN album_genre ON genres.id = album_genre.genre_id
+		WHERE album_genre.album_id = ?
+		ORDER BY album_genre.genre_pos ASC
+  `, album.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	album.Genres = m.ToGenres()
+
+	return nil
+}
+
+func (r *AlbumRepository) LoadGenresInAlbums(albums []entities.Album) error {
+	for i := range albums {
+		err := r.LoadGenresInAlbum(&albums[i])
+		if err != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// RefreshAlbumGenres link the album to the genres of its tracks, the most
+// common first.
+func (r *AlbumRepository) RefreshAlbumGenres(album *entities.Album) error {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM album_genre WHERE album_id = ?", album.Id)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO album_genre (album_id, genre_id, genre_pos)
+		SELECT
+			track_album.album_id,
+			track_genre.genre_id,
+			ROW_NUMBER() OVER (ORDER BY COUNT(*) DESC, MIN(track_genre.genre_pos) ASC) - 1
+		FROM track_album
+		JOIN track_genre ON track_genre.track_id = track_album.track_id
+		JOIN tracks ON tracks.id = track_album.track_id
+		WHERE track_album.album_id = ? AND tracks.pending_import = 0
+		GROUP BY track_album.album_id, track_genre.genre_id
+	`, album.Id)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(
+		"UPDATE albums SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE id = ?",
+		album.Id,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return r.LoadGenresInAlbum(album)
+}
+
 func (r *AlbumRepository) CreateAlbum(album *entities.Album) error {
 	m := data_models.AlbumModel{}
 
+	releaseSecondaryTypes := "[]"
+
+	if jsonData, err := json.Marshal(album.ReleaseSecondaryTypes); err == nil &&
+		album.ReleaseSecondaryTypes != nil {
+		releaseSecondaryTypes = string(jsonData)
+	}
+
 	err := r.Database.Get(
 		&m,
 		`
@@ -250,10 +356,32 @@ func (r *AlbumRepository) CreateAlbum(album *entities.Album) error {
         user_id,
 
         name,
+
+        release_type,
+        release_secondary_types,
+
+        release_date,
+        original_release_date,
+
+        label,
+        catalog_number,
+        barcode,
+
+        music_brainz_release_id,
+        music_brainz_release_group_id,
 				created_at
       )
     VALUES
       (
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
         ?,
         ?,
 				STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
@@ -263,6 +391,19 @@ func (r *AlbumRepository) CreateAlbum(album *entities.Album) error {
 		album.UserId,
 
 		album.Name,
+
+		album.ReleaseType,
+		releaseSecondaryTypes,
+
+		album.ReleaseDate,
+		album.OriginalReleaseDate,
+
+		album.Label,
+		album.CatalogNumber,
+		album.Barcode,
+
+		album.MusicBrainzReleaseId,
+		album.MusicBrainzReleaseGroupId,
 	)
 	if err != nil {
 		logger.DatabaseLogger.Error(err)
@@ -284,6 +425,10 @@ func (r AlbumRepository) GetAlbumByNameOrCreate(
 
 	album, err := r.GetAlbumByName(name, albumArtists, userId)
 
+	if err != nil && errors.Is(err, AlbumNotFoundError) {
+		album, err = r.GetAlbumByAlias(name, albumArtists, userId)
+	}
+
 	if err != nil && errors.Is(err, AlbumNotFoundError) {
 		album = &entities.Album{
 			UserId: &userId,
@@ -312,6 +457,13 @@ func (r AlbumRepository) GetAlbumByNameOrCreate(
 func (r *AlbumRepository) UpdateAlbum(album *entities.Album) error {
 	m := data_models.AlbumModel{}
 
+	releaseSecondaryTypes := "[]"
+
+	if jsonData, err := json.Marshal(album.ReleaseSecondaryTypes); err == nil &&
+		album.ReleaseSecondaryTypes != nil {
+		releaseSecondaryTypes = string(jsonData)
+	}
+
 	err := r.Database.Get(
 		&m,
 		`
@@ -320,6 +472,19 @@ func (r *AlbumRepository) UpdateAlbum(album *entities.Album) error {
         user_id = ?,
 
         name = ?,
+
+        release_type = ?,
+        release_secondary_types = ?,
+
+        release_date = ?,
+        original_release_date = ?,
+
+        label = ?,
+        catalog_number = ?,
+        barcode = ?,
+
+        music_brainz_release_id = ?,
+        music_brainz_release_group_id = ?,
       	updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
     WHERE
       id = ?
@@ -328,6 +493,19 @@ func (r *AlbumRepository) UpdateAlbum(album *entities.Album) error {
 		album.UserId,
 
 		album.Name,
+
+		album.ReleaseType,
+		releaseSecondaryTypes,
+
+		album.ReleaseDate,
+		album.OriginalReleaseDate,
+
+		album.Label,
+		album.CatalogNumber,
+		album.Barcode,
+
+		album.MusicBrainzReleaseId,
+		album.MusicBrainzReleaseGroupId,
 		album.Id,
 	)
 	if err != nil {
@@ -0,0 +1,214 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/jmoiron/sqlx"
+)
+
+// GetArtistByAlias return the artist which absorbed a merged artist of this
+// name.
+func (r ArtistRepository) GetArtistByAlias(name string, userId int) (*entities.Artist, error) {
+	m := data_models.ArtistModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT artists.*
+    FROM artists
+    JOIN artist_aliases ON artist_aliases.artist_id = artists.id
+    WHERE artist_aliases.name = ? AND artist_aliases.user_id = ?
+  `, strings.ToLower(name), userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ArtistNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	artist := m.ToArtist()
+
+	err = r.loadArtistSubData(&artist)
+	if err != nil {
+		return nil, err
+	}
+
+	return &artist, nil
+}
+
+// MergeArtists move the track and album links of every source to destination
+// with their order in one transaction, delete sources and keep their names as
+// aliases of destination. The MusicBrainz fields of destination are saved with
+// the merge.
+func (r *ArtistRepository) MergeArtists(
+	sources []entities.Artist,
+	destination *entities.Artist,
+) error {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	for i := range sources {
+		if err := r.mergeArtist(tx, &sources[i], destination); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`
+    UPDATE artists
+    SET
+      sort_name = ?,
+      disambiguation = ?,
+      music_brainz_artist_id = ?,
+      updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id = ?
+  `,
+		destination.SortName,
+		destination.Disambiguation,
+		destination.MusicBrainzArtistId,
+		destination.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// mergeArtist move the links of source to destination, delete source and keep
+// its name as an alias of destination.
+func (r *ArtistRepository) mergeArtist(
+	tx *sqlx.Tx,
+	source *entities.Artist,
+	destination *entities.Artist,
+) error {
+	_, err := tx.Exec(`
+    UPDATE tracks
+    SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id IN (SELECT track_id FROM track_artist WHERE artist_id = ?)
+  `, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO track_artist (artist_id, track_id, artist_pos)
+    SELECT ?, track_id, artist_pos FROM track_artist WHERE artist_id = ?
+  `, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    UPDATE tracks
+    SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id IN (SELECT track_id FROM track_artist_role WHERE artist_id = ?)
+  `, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO track_artist_role (track_id, artist_id, role, role_pos)
+    SELECT track_id, ?, role, role_pos FROM track_artist_role WHERE artist_id = ?
+  `, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    UPDATE albums
+    SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id IN (SELECT album_id FROM album_artist WHERE artist_id = ?)
+  `, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO album_artist (album_id, artist_id, artist_pos)
+    SELECT album_id, ?, artist_pos FROM album_artist WHERE artist_id = ?
+  `, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM track_artist WHERE artist_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM album_artist WHERE artist_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM track_artist_role WHERE artist_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	// Aliases of source now point to destination
+
+	_, err = tx.Exec(
+		"UPDATE artist_aliases SET artist_id = ? WHERE artist_id = ?",
+		destination.Id,
+		source.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	if !strings.EqualFold(source.Name, destination.Name) {
+		_, err = tx.Exec(`
+      INSERT OR REPLACE INTO artist_aliases (user_id, name, artist_id)
+      VALUES (?, ?, ?)
+    `, source.UserId, strings.ToLower(source.Name), destination.Id)
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			return err
+		}
+	}
+
+	_, err = tx.Exec(
+		"DELETE FROM artist_aliases WHERE artist_id = ? AND name = ?",
+		destination.Id,
+		strings.ToLower(destination.Name),
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM artists WHERE id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO deleted_artists (id) VALUES (?)", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	return nil
+}
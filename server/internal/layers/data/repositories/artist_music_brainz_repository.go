@@ -0,0 +1,201 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+var MusicBrainzArtistNotFoundError = errors.New("MusicBrainz artist is not found")
+
+func (r ArtistRepository) GetArtistByMusicBrainzId(
+	musicBrainzArtistId string,
+	userId int,
+) (*entities.Artist, error) {
+	m := data_models.ArtistModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM artists
+    WHERE music_brainz_artist_id = ? AND user_id = ?
+  `, musicBrainzArtistId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ArtistNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	artist := m.ToArtist()
+
+	err = r.loadArtistSubData(&artist)
+	if err != nil {
+		return nil, err
+	}
+
+	return &artist, nil
+}
+
+// getUnidentifiedArtistByName only match artists without a MusicBrainz id so
+// homonymous artists with different ids stay apart.
+func (r ArtistRepository) getUnidentifiedArtistByName(
+	name string,
+	userId int,
+) (*entities.Artist, error) {
+	m := data_models.ArtistModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM artists
+    WHERE LOWER(name) = LOWER(?) AND user_id = ? AND music_brainz_artist_id = ''
+  `, name, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ArtistNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	artist := m.ToArtist()
+
+	err = r.loadArtistSubData(&artist)
+	if err != nil {
+		return nil, err
+	}
+
+	return &artist, nil
+}
+
+// GetArtistByMusicBrainzIdOrCreate match the artist by its MusicBrainz id
+// first, an artist of the same name without id get this one.
+func (r ArtistRepository) GetArtistByMusicBrainzIdOrCreate(
+	name string,
+	musicBrainzArtistId string,
+	userId int,
+) (*entities.Artist, error) {
+	r.getArtistOrCreateMutex.Lock()
+	defer r.getArtistOrCreateMutex.Unlock()
+
+	artist, err := r.GetArtistByMusicBrainzId(musicBrainzArtistId, userId)
+
+	if err != nil && errors.Is(err, ArtistNotFoundError) {
+		artist, err = r.getUnidentifiedArtistByName(name, userId)
+
+		if err != nil && errors.Is(err, ArtistNotFoundError) {
+			artist, err = r.GetArtistByAlias(name, userId)
+
+			if err == nil && artist.MusicBrainzArtistId != "" {
+				err = ArtistNotFoundError
+			}
+		}
+
+		if err == nil {
+			artist.MusicBrainzArtistId = musicBrainzArtistId
+
+			err = r.UpdateArtist(artist)
+		}
+	}
+
+	if err != nil && errors.Is(err, ArtistNotFoundError) {
+		artist = &entities.Artist{
+			UserId: &userId,
+			Name:   name,
+
+			MusicBrainzArtistId: musicBrainzArtistId,
+		}
+
+		err = r.CreateArtist(artist)
+	}
+
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, entities.NewInternalError(err)
+	}
+
+	return artist, nil
+}
+
+func (r *ArtistRepository) GetMusicBrainzArtist(
+	musicBrainzArtistId string,
+) (entities.MusicBrainzArtist, error) {
+	m := data_models.MusicBrainzArtistModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM music_brainz_artists_cache
+    WHERE music_brainz_artist_id = ?
+  `, musicBrainzArtistId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.MusicBrainzArtist{}, MusicBrainzArtistNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return entities.MusicBrainzArtist{}, err
+	}
+
+	return m.ToMusicBrainzArtist(), nil
+}
+
+func (r *ArtistRepository) SetMusicBrainzArtist(artist *entities.MusicBrainzArtist) error {
+	m := data_models.MusicBrainzArtistModel{}
+
+	aliases := "[]"
+
+	if jsonData, err := json.Marshal(artist.Aliases); err == nil && artist.Aliases != nil {
+		aliases = string(jsonData)
+	}
+
+	err := r.Database.Get(
+		&m,
+		`
+    INSERT INTO music_brainz_artists_cache
+      (
+        music_brainz_artist_id,
+
+        name,
+        sort_name,
+        disambiguation,
+
+        aliases
+      )
+    VALUES
+      (
+        ?,
+
+        ?,
+        ?,
+        ?,
+
+        ?
+      )
+    ON CONFLICT (music_brainz_artist_id) DO UPDATE SET
+      name = excluded.name,
+      sort_name = excluded.sort_name,
+      disambiguation = excluded.disambiguation,
+      aliases = excluded.aliases,
+      fetched_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    RETURNING *
+  `,
+		artist.Id,
+
+		artist.Name,
+		artist.SortName,
+		artist.Disambiguation,
+
+		aliases,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	*artist = m.ToMusicBrainzArtist()
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"sync"
 	"time"
@@ -177,12 +178,81 @@ func (r *ArtistRepository) loadArtistSubData(artist *entities.Artist) error {
 		}
 	}
 
+	m3 := data_models.TracksModels{}
+
+	err = r.Database.Select(&m3, `
+		SELECT DISTINCT tracks.*
+		FROM tracks
+		JOIN track_artist_role ON tracks.id = track_artist_role.track_id
+		WHERE track_artist_role.artist_id = ?
+		ORDER BY tracks.id ASC
+  `, artist.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	artist.AllHasRoleTracks = m3.ToTracks()
+
+	err = r.trackRepository.LoadAlbumsInTracks(artist.AllHasRoleTracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.trackRepository.LoadRoleArtistsInTracks(artist.AllHasRoleTracks)
+	if err != nil {
+		return err
+	}
+
+	artist.HasRoleAlbums = make([]entities.Album, 0)
+
+	for _, track := range artist.AllHasRoleTracks {
+	skipRole:
+		for _, album := range track.Albums {
+			for _, addedAlbum := range artist.HasRoleAlbums {
+				if album.Id == addedAlbum.Id {
+					continue skipRole
+				}
+			}
+			artist.HasRoleAlbums = append(artist.HasRoleAlbums, album)
+		}
+	}
+
 	return nil
 }
 
+// GetAllArtistsWithRoleFromUser return the artists linked with the role like
+// composer to at least one track.
+func (r *ArtistRepository) GetAllArtistsWithRoleFromUser(
+	userId int,
+	role string,
+) ([]entities.Artist, error) {
+	m := data_models.ArtistModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT DISTINCT artists.*
+    FROM artists
+    JOIN track_artist_role ON artists.id = track_artist_role.artist_id
+    WHERE artists.user_id = ? AND track_artist_role.role = ?
+    ORDER BY COALESCE(NULLIF(artists.sort_name, ''), artists.name) COLLATE NOCASE
+  `, userId, role)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToArtists(), nil
+}
+
 func (r *ArtistRepository) CreateArtist(artist *entities.Artist) error {
 	m := data_models.ArtistModel{}
 
+	aliases := "[]"
+
+	if jsonData, err := json.Marshal(artist.Aliases); err == nil && artist.Aliases != nil {
+		aliases = string(jsonData)
+	}
+
 	err := r.Database.Get(
 		&m,
 		`
@@ -190,10 +260,21 @@ func (r *ArtistRepository) CreateArtist(artist *entities.Artist) error {
       (
         user_id,
 
-        name
+        name,
+
+        sort_name,
+        disambiguation,
+
+        aliases,
+
+        music_brainz_artist_id
       )
     VALUES
       (
+        ?,
+        ?,
+        ?,
+        ?,
         ?,
         ?
       )
@@ -202,6 +283,13 @@ func (r *ArtistRepository) CreateArtist(artist *entities.Artist) error {
 		artist.UserId,
 
 		artist.Name,
+
+		artist.SortName,
+		artist.Disambiguation,
+
+		aliases,
+
+		artist.MusicBrainzArtistId,
 	)
 	if err != nil {
 		logger.DatabaseLogger.Error(err)
@@ -222,6 +310,10 @@ func (r ArtistRepository) GetArtistByNameOrCreate(
 
 	artist, err := r.GetArtistByName(name, userId)
 
+	if err != nil && errors.Is(err, ArtistNotFoundError) {
+		artist, err = r.GetArtistByAlias(name, userId)
+	}
+
 	if err != nil && errors.Is(err, ArtistNotFoundError) {
 		artist = &entities.Artist{
 			UserId: &userId,
@@ -242,6 +334,12 @@ func (r ArtistRepository) GetArtistByNameOrCreate(
 func (r *ArtistRepository) UpdateArtist(artist *entities.Artist) error {
 	m := data_models.ArtistModel{}
 
+	aliases := "[]"
+
+	if jsonData, err := json.Marshal(artist.Aliases); err == nil && artist.Aliases != nil {
+		aliases = string(jsonData)
+	}
+
 	err := r.Database.Get(
 		&m,
 		`
@@ -250,6 +348,13 @@ func (r *ArtistRepository) UpdateArtist(artist *entities.Artist) error {
         user_id = ?,
 
         name = ?,
+
+        sort_name = ?,
+        disambiguation = ?,
+
+        aliases = ?,
+
+        music_brainz_artist_id = ?,
       	updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
     WHERE
       id = ?
@@ -258,6 +363,13 @@ func (r *ArtistRepository) UpdateArtist(artist *entities.Artist) error {
 		artist.UserId,
 
 		artist.Name,
+
+		artist.SortName,
+		artist.Disambiguation,
+
+		aliases,
+
+		artist.MusicBrainzArtistId,
 		artist.Id,
 	)
 	if err != nil {
@@ -0,0 +1,937 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/jmoiron/sqlx"
+)
+
+var GenreNotFoundError = errors.New("Genre is not found")
+
+func NewGenreRepository(
+	db *sqlx.DB,
+	trackRepository TrackRepository,
+	albumRepository AlbumRepository,
+) GenreRepository {
+	return GenreRepository{
+		Database:        db,
+		trackRepository: trackRepository,
+		albumRepository: albumRepository,
+
+		getGenreOrCreateMutex: &sync.Mutex{},
+	}
+}
+
+type GenreRepository struct {
+	Database        *sqlx.DB
+	trackRepository TrackRepository
+	albumRepository AlbumRepository
+
+	getGenreOrCreateMutex *sync.Mutex
+}
+
+func (r *GenreRepository) GetAllGenresFromUser(userId int) ([]entities.Genre, error) {
+	m := data_models.GenreModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT * FROM genres WHERE user_id = ? ORDER BY name COLLATE NOCASE
+  `, userId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	genres := m.ToGenres()
+
+	err = r.loadAliasesInGenres(userId, genres)
+	if err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
+
+func (r *GenreRepository) GetAllGenresFromUserSince(
+	userId int,
+	since time.Time,
+) ([]entities.Genre, error) {
+	m := data_models.GenreModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT * FROM genres WHERE user_id = ? AND (COALESCE(updated_at, created_at) >= ?)
+  `, userId, since.UTC())
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	genres := m.ToGenres()
+
+	err = r.loadAliasesInGenres(userId, genres)
+	if err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
+
+func (r *GenreRepository) GetGenreById(id int) (*entities.Genre, error) {
+	m := data_models.GenreModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM genres
+    WHERE id = ?
+  `, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, GenreNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	genre := m.ToGenre()
+
+	err = r.loadAliasesInGenre(&genre)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genre, nil
+}
+
+func (r *GenreRepository) GetGenreByName(name string, userId int) (*entities.Genre, error) {
+	m := data_models.GenreModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM genres
+    WHERE LOWER(name) = LOWER(?) AND user_id = ?
+  `, name, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, GenreNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	genre := m.ToGenre()
+
+	err = r.loadAliasesInGenre(&genre)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genre, nil
+}
+
+// GetGenreByAlias return the genre to which this other name is mapped.
+func (r *GenreRepository) GetGenreByAlias(name string, userId int) (*entities.Genre, error) {
+	m := data_models.GenreModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT genres.*
+    FROM genres
+    JOIN genre_aliases ON genre_aliases.genre_id = genres.id
+    WHERE genre_aliases.name = ? AND genre_aliases.user_id = ?
+  `, strings.ToLower(name), userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, GenreNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	genre := m.ToGenre()
+
+	err = r.loadAliasesInGenre(&genre)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genre, nil
+}
+
+func (r GenreRepository) GetGenreByNameOrCreate(
+	name string,
+	userId int,
+) (*entities.Genre, error) {
+	r.getGenreOrCreateMutex.Lock()
+	defer r.getGenreOrCreateMutex.Unlock()
+
+	genre, err := r.GetGenreByName(name, userId)
+
+	if err != nil && errors.Is(err, GenreNotFoundError) {
+		genre, err = r.GetGenreByAlias(name, userId)
+	}
+
+	if err != nil && errors.Is(err, GenreNotFoundError) {
+		genre = &entities.Genre{
+			UserId:   &userId,
+			Name:     name,
+			ParentId: r.guessGenreParentId(name, userId),
+		}
+
+		err = r.CreateGenre(genre)
+	}
+
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, entities.NewInternalError(err)
+	}
+
+	return genre, nil
+}
+
+// guessGenreParentId look for an existing genre matching the end of the name
+// so "Progressive Metal" is placed under "Metal". The longest match wins.
+func (r *GenreRepository) guessGenreParentId(name string, userId int) *int {
+	words := strings.Fields(name)
+
+	for i := 1; i < len(words); i++ {
+		suffix := strings.Join(words[i:], " ")
+
+		genre, err := r.GetGenreByName(suffix, userId)
+
+		if err != nil && errors.Is(err, GenreNotFoundError) {
+			genre, err = r.GetGenreByAlias(suffix, userId)
+		}
+
+		if err == nil {
+			return &genre.Id
+		}
+	}
+
+	return nil
+}
+
+// GetGenreDescendantIds return the id of the genre followed by the ones of
+// all its sub genres.
+func (r *GenreRepository) GetGenreDescendantIds(genreId int) ([]int, error) {
+	ids := []int{}
+
+	err := r.Database.Select(&ids, `
+    WITH RECURSIVE genre_tree(genre_id) AS (
+      SELECT ?
+      UNION
+      SELECT genres.id FROM genres JOIN genre_tree ON genres.parent_id = genre_tree.genre_id
+    )
+    SELECT genre_id FROM genre_tree
+  `, genreId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *GenreRepository) loadAliasesInGenre(genre *entities.Genre) error {
+	genre.Aliases = []string{}
+
+	err := r.Database.Select(&genre.Aliases, `
+    SELECT name FROM genre_aliases WHERE genre_id = ? ORDER BY name
+  `, genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *GenreRepository) loadAliasesInGenres(userId int, genres []entities.Genre) error {
+	m := []data_models.GenreAliasModel{}
+
+	err := r.Database.Select(&m, `
+    SELECT genre_id, name FROM genre_aliases WHERE user_id = ? ORDER BY name
+  `, userId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	aliases := map[int][]string{}
+
+	for _, alias := range m {
+		aliases[alias.GenreId] = append(aliases[alias.GenreId], alias.Name)
+	}
+
+	for i := range genres {
+		if genreAliases, ok := aliases[genres[i].Id]; ok {
+			genres[i].Aliases = genreAliases
+		}
+	}
+
+	return nil
+}
+
+// LoadStatsInGenre count the tracks and albums of the genre and its sub
+// genres and pick the cover of its first track having one.
+func (r *GenreRepository) LoadStatsInGenre(genre *entities.Genre) error {
+	genres := []entities.Genre{*genre}
+
+	err := r.loadStatsInGenres("id = ?", []any{genre.Id}, genres)
+	if err != nil {
+		return err
+	}
+
+	*genre = genres[0]
+
+	return nil
+}
+
+func (r *GenreRepository) LoadStatsInGenres(userId int, genres []entities.Genre) error {
+	return r.loadStatsInGenres("user_id = ?", []any{userId}, genres)
+}
+
+func (r *GenreRepository) loadStatsInGenres(
+	rootCondition string,
+	args []any,
+	genres []entities.Genre,
+) error {
+	genreTree := `
+    WITH RECURSIVE genre_tree(root_id, genre_id) AS (
+      SELECT id, id FROM genres WHERE ` + rootCondition + `
+      UNION
+      SELECT genre_tree.root_id, genres.id
+      FROM genres
+      JOIN genre_tree ON genres.parent_id = genre_tree.genre_id
+    )
+  `
+
+	trackCounts := []data_models.GenreCountModel{}
+
+	err := r.Database.Select(&trackCounts, genreTree+`
+    SELECT genre_tree.root_id AS genre_id, COUNT(DISTINCT tracks.id) AS count
+    FROM genre_tree
+    JOIN track_genre ON track_genre.genre_id = genre_tree.genre_id
+    JOIN tracks ON tracks.id = track_genre.track_id
+    WHERE tracks.pending_import = 0
+    GROUP BY genre_tree.root_id
+  `, args...)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	albumCounts := []data_models.GenreCountModel{}
+
+	err = r.Database.Select(&albumCounts, genreTree+`
+    SELECT genre_tree.root_id AS genre_id, COUNT(DISTINCT albums.id) AS count
+    FROM genre_tree
+    JOIN album_genre ON album_genre.genre_id = genre_tree.genre_id
+    JOIN albums ON albums.id = album_genre.album_id
+    GROUP BY genre_tree.root_id
+  `, args...)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	// SQLite take the bare cover_signature column from the row of MIN()
+
+	covers := []data_models.GenreCoverModel{}
+
+	err = r.Database.Select(&covers, genreTree+`
+    SELECT genre_tree.root_id AS genre_id, MIN(tracks.id) AS track_id, tracks.cover_signature
+    FROM genre_tree
+    JOIN track_genre ON track_genre.genre_id = genre_tree.genre_id
+    JOIN tracks ON tracks.id = track_genre.track_id
+    WHERE tracks.pending_import = 0 AND tracks.cover_signature != ''
+    GROUP BY genre_tree.root_id
+  `, args...)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	tracksByGenre := map[int]int{}
+
+	for _, count := range trackCounts {
+		tracksByGenre[count.GenreId] = count.Count
+	}
+
+	albumsByGenre := map[int]int{}
+
+	for _, count := range albumCounts {
+		albumsByGenre[count.GenreId] = count.Count
+	}
+
+	coversByGenre := map[int]string{}
+
+	for _, cover := range covers {
+		coversByGenre[cover.GenreId] = cover.CoverSignature
+	}
+
+	for i := range genres {
+		genres[i].TrackCount = tracksByGenre[genres[i].Id]
+		genres[i].AlbumCount = albumsByGenre[genres[i].Id]
+		genres[i].CoverSignature = coversByGenre[genres[i].Id]
+	}
+
+	return nil
+}
+
+// GetGenreCoverTrack return the track whose cover is used for the genre.
+func (r *GenreRepository) GetGenreCoverTrack(genre *entities.Genre) (*entities.Track, error) {
+	m := data_models.TrackModel{}
+
+	err := r.Database.Get(&m, `
+    WITH RECURSIVE genre_tree(genre_id) AS (
+      SELECT ?
+      UNION
+      SELECT genres.id FROM genres JOIN genre_tree ON genres.parent_id = genre_tree.genre_id
+    )
+    SELECT tracks.*
+    FROM tracks
+    JOIN track_genre ON track_genre.track_id = tracks.id
+    WHERE track_genre.genre_id IN (SELECT genre_id FROM genre_tree)
+      AND tracks.pending_import = 0 AND tracks.cover_signature != ''
+    ORDER BY tracks.id
+    LIMIT 1
+  `, genre.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, TrackNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	track := m.ToTrack()
+
+	return &track, nil
+}
+
+// LoadAlbumsInGenre load the albums of the genre and of its sub genres.
+func (r *GenreRepository) LoadAlbumsInGenre(genre *entities.Genre) error {
+	m := data_models.AlbumModels{}
+
+	err := r.Database.Select(&m, `
+    WITH RECURSIVE genre_tree(genre_id) AS (
+      SELECT ?
+      UNION
+      SELECT genres.id FROM genres JOIN genre_tree ON genres.parent_id = genre_tree.genre_id
+    )
+    SELECT DISTINCT albums.*
+    FROM albums
+    JOIN album_genre ON album_genre.album_id = albums.id
+    WHERE album_genre.genre_id IN (SELECT genre_id FROM genre_tree)
+    ORDER BY albums.name COLLATE NOCASE
+  `, genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	genre.Albums = m.ToAlbums()
+
+	err = r.albumRepository.LoadTracksInAlbums(genre.Albums)
+	if err != nil {
+		return err
+	}
+
+	err = r.albumRepository.LoadArtistsInAlbums(genre.Albums)
+	if err != nil {
+		return err
+	}
+
+	return r.albumRepository.LoadGenresInAlbums(genre.Albums)
+}
+
+// LoadTracksInGenre load the tracks of the genre and of its sub genres.
+func (r *GenreRepository) LoadTracksInGenre(genre *entities.Genre) error {
+	m := data_models.TracksModels{}
+
+	err := r.Database.Select(&m, `
+    WITH RECURSIVE genre_tree(genre_id) AS (
+      SELECT ?
+      UNION
+      SELECT genres.id FROM genres JOIN genre_tree ON genres.parent_id = genre_tree.genre_id
+    )
+    SELECT DISTINCT tracks.*
+    FROM tracks
+    JOIN track_genre ON track_genre.track_id = tracks.id
+    WHERE track_genre.genre_id IN (SELECT genre_id FROM genre_tree)
+      AND tracks.pending_import = 0
+    ORDER BY tracks.id
+  `, genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	genre.Tracks = m.ToTracks()
+
+	err = r.trackRepository.LoadAlbumsInTracks(genre.Tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.trackRepository.LoadArtistsInTracks(genre.Tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.trackRepository.LoadGenresInTracks(genre.Tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.trackRepository.LoadWorkInTracks(genre.Tracks)
+	if err != nil {
+		return err
+	}
+
+	return r.trackRepository.LoadRoleArtistsInTracks(genre.Tracks)
+}
+
+func (r *GenreRepository) CreateGenre(genre *entities.Genre) error {
+	m := data_models.GenreModel{}
+
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Get(
+		&m,
+		`
+    INSERT INTO genres
+      (
+        user_id,
+
+        name,
+
+        parent_id
+      )
+    VALUES
+      (
+        ?,
+        ?,
+        ?
+      )
+    RETURNING *
+  `,
+		genre.UserId,
+
+		genre.Name,
+
+		genre.ParentId,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	aliases := genre.Aliases
+
+	*genre = m.ToGenre()
+
+	genre.Aliases, err = setGenreAliases(tx, genre, aliases)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *GenreRepository) UpdateGenre(genre *entities.Genre) error {
+	m := data_models.GenreModel{}
+
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Get(
+		&m,
+		`
+    UPDATE genres
+    SET
+        user_id = ?,
+
+        name = ?,
+
+        parent_id = ?,
+      	updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE
+      id = ?
+    RETURNING *
+  `,
+		genre.UserId,
+
+		genre.Name,
+
+		genre.ParentId,
+		genre.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	aliases := genre.Aliases
+
+	*genre = m.ToGenre()
+
+	genre.Aliases, err = setGenreAliases(tx, genre, aliases)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// setGenreAliases replace the aliases of the genre. An alias already mapped
+// to another genre is moved to this one.
+func setGenreAliases(tx *sqlx.Tx, genre *entities.Genre, aliases []string) ([]string, error) {
+	_, err := tx.Exec("DELETE FROM genre_aliases WHERE genre_id = ?", genre.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	savedAliases := []string{}
+
+	for _, alias := range aliases {
+		alias = strings.ToLower(strings.TrimSpace(alias))
+
+		if alias == "" || alias == strings.ToLower(genre.Name) {
+			continue
+		}
+
+		_, err = tx.Exec(`
+      INSERT OR REPLACE INTO genre_aliases (user_id, name, genre_id)
+      VALUES (?, ?, ?)
+    `, genre.UserId, alias, genre.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		savedAliases = append(savedAliases, alias)
+	}
+
+	return savedAliases, nil
+}
+
+// DeleteGenre remove the genre, its links and its name from the genres tag of
+// the tracks, its sub genres are moved to its parent.
+func (r *GenreRepository) DeleteGenre(genre *entities.Genre) error {
+	m := data_models.GenreModel{}
+
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+    UPDATE tracks
+    SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id IN (SELECT track_id FROM track_genre WHERE genre_id = ?)
+  `, genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(`
+    UPDATE albums
+    SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id IN (SELECT album_id FROM album_genre WHERE genre_id = ?)
+  `, genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM track_genre WHERE genre_id = ?", genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM album_genre WHERE genre_id = ?", genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	// Remove the genre from the genres tag too or tracks without genre would
+	// be linked again to a new genre of this name
+	_, err = tx.Exec(`
+    UPDATE tracks
+    SET
+      metadata_genres = (
+        SELECT json_group_array(value)
+        FROM json_each(tracks.metadata_genres)
+        WHERE LOWER(value) != LOWER(?)
+          AND LOWER(value) NOT IN (SELECT name FROM genre_aliases WHERE genre_id = ?)
+      ),
+      updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE user_id IS ? AND EXISTS (
+      SELECT 1
+      FROM json_each(tracks.metadata_genres)
+      WHERE LOWER(value) = LOWER(?)
+        OR LOWER(value) IN (SELECT name FROM genre_aliases WHERE genre_id = ?)
+    )
+  `, genre.Name, genre.Id, genre.UserId, genre.Name, genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM genre_aliases WHERE genre_id = ?", genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(`
+    UPDATE genres
+    SET parent_id = ?, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE parent_id = ?
+  `, genre.ParentId, genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Get(&m, `
+    DELETE FROM
+      genres
+    WHERE
+      id = ?
+    RETURNING *
+  `,
+		genre.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO deleted_genres (id) VALUES (?)", genre.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	*genre = m.ToGenre()
+
+	return nil
+}
+
+// MergeGenres move the track and album links and the sub genres of source to
+// destination, delete source and keep its name as an alias of destination.
+func (r *GenreRepository) MergeGenres(
+	source *entities.Genre,
+	destination *entities.Genre,
+) error {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+    UPDATE tracks
+    SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id IN (SELECT track_id FROM track_genre WHERE genre_id = ?)
+  `, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO track_genre (track_id, genre_id, genre_pos)
+    SELECT track_id, ?, genre_pos FROM track_genre WHERE genre_id = ?
+  `, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(`
+    UPDATE albums
+    SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id IN (SELECT album_id FROM album_genre WHERE genre_id = ?)
+  `, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO album_genre (album_id, genre_id, genre_pos)
+    SELECT album_id, ?, genre_pos FROM album_genre WHERE genre_id = ?
+  `, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM track_genre WHERE genre_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM album_genre WHERE genre_id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	// Sub genres of source now belong to destination, which first leave the
+	// tree of source when it was inside to not create a cycle
+
+	_, err = tx.Exec(`
+    UPDATE genres
+    SET parent_id = ?, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE id = ? AND id IN (
+      WITH RECURSIVE genre_tree(genre_id) AS (
+        SELECT ?
+        UNION
+        SELECT genres.id FROM genres JOIN genre_tree ON genres.parent_id = genre_tree.genre_id
+      )
+      SELECT genre_id FROM genre_tree
+    )
+  `, source.ParentId, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(`
+    UPDATE genres
+    SET parent_id = ?, updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE parent_id = ?
+  `, destination.Id, source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	// Aliases of source now point to destination
+
+	_, err = tx.Exec(
+		"UPDATE genre_aliases SET genre_id = ? WHERE genre_id = ?",
+		destination.Id,
+		source.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	if !strings.EqualFold(source.Name, destination.Name) {
+		_, err = tx.Exec(`
+      INSERT OR REPLACE INTO genre_aliases (user_id, name, genre_id)
+      VALUES (?, ?, ?)
+    `, source.UserId, strings.ToLower(source.Name), destination.Id)
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	_, err = tx.Exec(
+		"DELETE FROM genre_aliases WHERE genre_id = ? AND name = ?",
+		destination.Id,
+		strings.ToLower(destination.Name),
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM genres WHERE id = ?", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO deleted_genres (id) VALUES (?)", source.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec(
+		"UPDATE genres SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE id = ?",
+		destination.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *GenreRepository) GetAllDeletedGenresSince(since time.Time) ([]int, error) {
+	rows, err := r.Database.Query(`
+    SELECT id FROM deleted_genres WHERE deleted_at >= datetime(?)
+  `, since.UTC())
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []int{}
+
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,262 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/jmoiron/sqlx"
+)
+
+var UnknownListeningCategoryError = errors.New("Listening category is unknown")
+
+func NewListeningStatsRepository(db *sqlx.DB) ListeningStatsRepository {
+	return ListeningStatsRepository{
+		Database: db,
+	}
+}
+
+// ListeningStatsRepository computes statistics from the shared_played_tracks
+// table. Every query filters on julianday(start_at) so it can use the
+// shared_played_tracks_user_start_idx index whatever the stored time zone is.
+type ListeningStatsRepository struct {
+	Database *sqlx.DB
+}
+
+var listeningCountSources = map[entities.ListeningCategory]struct {
+	Id    string
+	Joins string
+}{
+	entities.ListeningCategoryTracks: {
+		Id: "spt.track_id",
+		Joins: `
+    JOIN tracks ON tracks.id = spt.track_id`,
+	},
+	entities.ListeningCategoryAlbums: {
+		Id: "track_album.album_id",
+		Joins: `
+    JOIN track_album ON track_album.track_id = spt.track_id
+    JOIN albums ON albums.id = track_album.album_id`,
+	},
+	entities.ListeningCategoryArtists: {
+		Id: "track_artist.artist_id",
+		Joins: `
+    JOIN track_artist ON track_artist.track_id = spt.track_id
+    JOIN artists ON artists.id = track_artist.artist_id`,
+	},
+	entities.ListeningCategoryGenres: {
+		Id: "track_genre.genre_id",
+		Joins: `
+    JOIN track_genre ON track_genre.track_id = spt.track_id
+    JOIN genres ON genres.id = track_genre.genre_id`,
+	},
+}
+
+var listeningIntervalFormats = map[entities.ListeningInterval]string{
+	entities.ListeningIntervalDay:   "%Y-%m-%d",
+	entities.ListeningIntervalMonth: "%Y-%m",
+}
+
+func getListeningOffsetModifier(period entities.ListeningPeriod) string {
+	return fmt.Sprintf("%+d minutes", period.UtcOffset)
+}
+
+func (r *ListeningStatsRepository) GetTopListened(
+	userId int,
+	category entities.ListeningCategory,
+	period entities.ListeningPeriod,
+	limit int,
+) ([]entities.ListeningCount, error) {
+	source, ok := listeningCountSources[category]
+	if !ok {
+		return nil, UnknownListeningCategoryError
+	}
+
+	m := data_models.ListeningCountModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT
+      `+source.Id+` AS id,
+      COUNT(*) AS play_count,
+      SUM(MAX(spt.ended_at - spt.begin_at, 0)) AS listened_time
+    FROM shared_played_tracks spt`+source.Joins+`
+    WHERE spt.user_id = ?
+      AND julianday(spt.start_at) >= julianday(?)
+      AND julianday(spt.start_at) < julianday(?)
+    GROUP BY `+source.Id+`
+    ORDER BY play_count DESC, listened_time DESC, `+source.Id+`
+    LIMIT ?
+  `,
+		userId,
+		period.From,
+		period.To,
+		limit,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToListeningCounts(), nil
+}
+
+func (r *ListeningStatsRepository) GetListeningTimeline(
+	userId int,
+	period entities.ListeningPeriod,
+	interval entities.ListeningInterval,
+) ([]entities.ListeningTimeBucket, error) {
+	format, ok := listeningIntervalFormats[interval]
+	if !ok {
+		format = listeningIntervalFormats[entities.ListeningIntervalDay]
+	}
+
+	m := data_models.ListeningTimeBucketModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT
+      strftime(?, spt.start_at, ?) AS date,
+      COUNT(*) AS play_count,
+      SUM(MAX(spt.ended_at - spt.begin_at, 0)) AS listened_time
+    FROM shared_played_tracks spt
+    WHERE spt.user_id = ?
+      AND julianday(spt.start_at) >= julianday(?)
+      AND julianday(spt.start_at) < julianday(?)
+    GROUP BY date
+    ORDER BY date
+  `,
+		format,
+		getListeningOffsetModifier(period),
+		userId,
+		period.From,
+		period.To,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToListeningTimeBuckets(), nil
+}
+
+func (r *ListeningStatsRepository) GetListeningHeatmap(
+	userId int,
+	period entities.ListeningPeriod,
+) ([]entities.ListeningHeatmapCell, error) {
+	m := data_models.ListeningHeatmapCellModels{}
+
+	modifier := getListeningOffsetModifier(period)
+
+	err := r.Database.Select(&m, `
+    SELECT
+      CAST(strftime('%w', spt.start_at, ?) AS INTEGER) AS weekday,
+      CAST(strftime('%H', spt.start_at, ?) AS INTEGER) AS hour,
+      COUNT(*) AS play_count,
+      SUM(MAX(spt.ended_at - spt.begin_at, 0)) AS listened_time
+    FROM shared_played_tracks spt
+    WHERE spt.user_id = ?
+      AND julianday(spt.start_at) >= julianday(?)
+      AND julianday(spt.start_at) < julianday(?)
+    GROUP BY weekday, hour
+    ORDER BY weekday, hour
+  `,
+		modifier,
+		modifier,
+		userId,
+		period.From,
+		period.To,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToListeningHeatmapCells(), nil
+}
+
+func (r *ListeningStatsRepository) GetListeningSummary(
+	userId int,
+	period entities.ListeningPeriod,
+) (entities.ListeningSummary, error) {
+	m := data_models.ListeningSummaryModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT
+      COUNT(*) AS play_count,
+      COALESCE(SUM(MAX(spt.ended_at - spt.begin_at, 0)), 0) AS listened_time,
+      COUNT(DISTINCT spt.track_id) AS track_count,
+      (
+        SELECT COUNT(DISTINCT track_album.album_id)
+        FROM shared_played_tracks spt
+        JOIN track_album ON track_album.track_id = spt.track_id
+        WHERE spt.user_id = ?
+          AND julianday(spt.start_at) >= julianday(?)
+          AND julianday(spt.start_at) < julianday(?)
+      ) AS album_count,
+      (
+        SELECT COUNT(DISTINCT track_artist.artist_id)
+        FROM shared_played_tracks spt
+        JOIN track_artist ON track_artist.track_id = spt.track_id
+        WHERE spt.user_id = ?
+          AND julianday(spt.start_at) >= julianday(?)
+          AND julianday(spt.start_at) < julianday(?)
+      ) AS artist_count,
+      COALESCE(SUM(
+        CASE WHEN spt.track_ended = 0 AND spt.ended_at - spt.begin_at < ? THEN 1 ELSE 0 END
+      ), 0) AS skip_count,
+      COALESCE(SUM(spt.track_ended), 0) AS completed_count
+    FROM shared_played_tracks spt
+    WHERE spt.user_id = ?
+      AND julianday(spt.start_at) >= julianday(?)
+      AND julianday(spt.start_at) < julianday(?)
+  `,
+		userId, period.From, period.To,
+		userId, period.From, period.To,
+		entities.ListeningSkipThreshold,
+		userId, period.From, period.To,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return entities.ListeningSummary{}, err
+	}
+
+	return m.ToListeningSummary(), nil
+}
+
+// GetListeningStreaks returns every run of consecutive listening days, the
+// most recent first
+func (r *ListeningStatsRepository) GetListeningStreaks(
+	userId int,
+	period entities.ListeningPeriod,
+) ([]entities.ListeningStreak, error) {
+	m := data_models.ListeningStreakModels{}
+
+	err := r.Database.Select(&m, `
+    WITH days AS (
+      SELECT DISTINCT date(spt.start_at, ?) AS day
+      FROM shared_played_tracks spt
+      WHERE spt.user_id = ?
+        AND julianday(spt.start_at) >= julianday(?)
+        AND julianday(spt.start_at) < julianday(?)
+    ), islands AS (
+      SELECT day, julianday(day) - ROW_NUMBER() OVER (ORDER BY day) AS island
+      FROM days
+    )
+    SELECT MIN(day) AS from_date, MAX(day) AS to_date, COUNT(*) AS days
+    FROM islands
+    GROUP BY island
+    ORDER BY to_date DESC
+  `,
+		getListeningOffsetModifier(period),
+		userId,
+		period.From,
+		period.To,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToListeningStreaks(), nil
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+var LyricsCacheNotFoundError = errors.New("Lyrics cache is not found")
+
+func (r *TrackRepository) GetLyricsCache(key string) (entities.LyricsCache, error) {
+	m := data_models.LyricsCacheModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM lyrics_cache
+    WHERE query_key = ?
+  `, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.LyricsCache{}, LyricsCacheNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return entities.LyricsCache{}, err
+	}
+
+	return m.ToLyricsCache(), nil
+}
+
+func (r *TrackRepository) SetLyricsCache(cache *entities.LyricsCache) error {
+	m := data_models.LyricsCacheModel{}
+
+	err := r.Database.Get(
+		&m,
+		`
+    INSERT INTO lyrics_cache
+      (
+        query_key,
+
+        found,
+        instrumental,
+
+        plain_lyrics,
+        synced_lyrics
+      )
+    VALUES
+      (
+        ?,
+
+        ?,
+        ?,
+
+        ?,
+        ?
+      )
+    ON CONFLICT (query_key) DO UPDATE SET
+      found = excluded.found,
+      instrumental = excluded.instrumental,
+      plain_lyrics = excluded.plain_lyrics,
+      synced_lyrics = excluded.synced_lyrics,
+      fetched_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    RETURNING *
+  `,
+		cache.Key,
+
+		cache.Found,
+		cache.Instrumental,
+
+		cache.PlainLyrics,
+		cache.SyncedLyrics,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	*cache = m.ToLyricsCache()
+
+	return nil
+}
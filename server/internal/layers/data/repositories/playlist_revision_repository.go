@@ -0,0 +1,291 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+var PlaylistRevisionNotFoundError = errors.New("Playlist revision is not found")
+
+func (r *PlaylistRepository) GetPlaylistRevisions(
+	playlistId int,
+	since time.Time,
+) ([]entities.PlaylistRevision, error) {
+	m := data_models.PlaylistRevisionModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT *
+    FROM playlist_revisions
+    WHERE playlist_id = ? AND created_at >= datetime(?)
+    ORDER BY created_at DESC, id DESC
+  `, playlistId, since.UTC())
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToPlaylistRevisions(), nil
+}
+
+func (r *PlaylistRepository) GetPlaylistRevision(
+	id int,
+) (*entities.PlaylistRevision, error) {
+	m := data_models.PlaylistRevisionModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM playlist_revisions
+    WHERE id = ?
+  `, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, PlaylistRevisionNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	revision := m.ToPlaylistRevision()
+
+	return &revision, nil
+}
+
+func (r *PlaylistRepository) GetLatestPlaylistRevision(
+	playlistId int,
+	since time.Time,
+) (*entities.PlaylistRevision, error) {
+	m := data_models.PlaylistRevisionModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM playlist_revisions
+    WHERE playlist_id = ? AND created_at >= datetime(?)
+    ORDER BY created_at DESC, id DESC
+    LIMIT 1
+  `, playlistId, since.UTC())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, PlaylistRevisionNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	revision := m.ToPlaylistRevision()
+
+	return &revision, nil
+}
+
+func (r *PlaylistRepository) GetDeletedPlaylistRevisionsFromUser(
+	userId int,
+	since time.Time,
+) ([]entities.PlaylistRevision, error) {
+	m := data_models.PlaylistRevisionModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT pr.*
+    FROM playlist_revisions pr
+    WHERE pr.user_id = ?
+      AND pr.action = ?
+      AND pr.created_at >= datetime(?)
+      AND pr.playlist_id NOT IN (SELECT id FROM playlists)
+      AND pr.id = (
+        SELECT latest.id
+        FROM playlist_revisions latest
+        WHERE latest.playlist_id = pr.playlist_id AND latest.action = pr.action
+        ORDER BY latest.created_at DESC, latest.id DESC
+        LIMIT 1
+      )
+    ORDER BY pr.created_at DESC
+  `, userId, entities.PlaylistRevisionDelete, since.UTC())
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToPlaylistRevisions(), nil
+}
+
+func (r *PlaylistRepository) CreatePlaylistRevision(revision *entities.PlaylistRevision) error {
+	m := data_models.PlaylistRevisionModel{}
+
+	encodedTrackIds, err := json.Marshal(revision.TrackIds)
+	if err != nil {
+		logger.DatabaseLogger.Errorf("Failed to encode tracksIds JSON into the database : %v", err)
+		return err
+	}
+
+	encodedAddedTrackIds, err := json.Marshal(revision.AddedTrackIds)
+	if err != nil {
+		logger.DatabaseLogger.Errorf("Failed to encode addedTrackIds JSON into the database : %v", err)
+		return err
+	}
+
+	encodedRemovedTrackIds, err := json.Marshal(revision.RemovedTrackIds)
+	if err != nil {
+		logger.DatabaseLogger.Errorf("Failed to encode removedTrackIds JSON into the database : %v", err)
+		return err
+	}
+
+	err = r.Database.Get(
+		&m,
+		`
+    INSERT INTO playlist_revisions
+      (
+        playlist_id,
+        user_id,
+
+        device_id,
+        action,
+
+        name,
+        description,
+
+        track_ids,
+
+        added_track_ids,
+        removed_track_ids,
+
+        created_at
+      )
+    VALUES
+      (
+        ?,
+        ?,
+
+        ?,
+        ?,
+
+        ?,
+        ?,
+
+        ?,
+
+        ?,
+        ?,
+
+        STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+      )
+    RETURNING *
+  `,
+		revision.PlaylistId,
+		revision.UserId,
+
+		revision.DeviceId,
+		revision.Action,
+
+		revision.Name,
+		revision.Description,
+
+		encodedTrackIds,
+
+		encodedAddedTrackIds,
+		encodedRemovedTrackIds,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	*revision = m.ToPlaylistRevision()
+
+	return nil
+}
+
+func (r *PlaylistRepository) DeleteExpiredPlaylistRevisions(before time.Time) error {
+	_, err := r.Database.Exec(`
+    DELETE FROM playlist_revisions WHERE created_at < datetime(?)
+  `, before.UTC())
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *PlaylistRepository) RestoreDeletedPlaylist(
+	playlist *entities.Playlist,
+	trackIds []int,
+) error {
+	m := data_models.PlaylistModel{}
+
+	encodedTrackIds, err := json.Marshal(trackIds)
+	if err != nil {
+		logger.DatabaseLogger.Errorf("Failed to encode tracksIds JSON into the database : %v", err)
+		return err
+	}
+
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Get(
+		&m,
+		`
+    INSERT INTO playlists
+      (
+        id,
+        user_id,
+
+        name,
+        description,
+
+        track_ids,
+        created_at
+      )
+    VALUES
+      (
+        ?,
+        ?,
+
+        ?,
+        ?,
+
+        ?,
+        STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+      )
+    RETURNING *
+  `,
+		playlist.Id,
+		playlist.UserId,
+
+		playlist.Name,
+		playlist.Description,
+
+		encodedTrackIds,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM deleted_playlists WHERE id = ?", playlist.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	*playlist = m.ToPlaylist()
+
+	err = r.loadPlaylistTracks(playlist, m)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	return nil
+}
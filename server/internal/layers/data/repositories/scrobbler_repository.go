@@ -0,0 +1,398 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/jmoiron/sqlx"
+)
+
+var ScrobblerNotFoundError = errors.New("Scrobbler is not found")
+
+func NewScrobblerRepository(db *sqlx.DB) ScrobblerRepository {
+	return ScrobblerRepository{
+		Database: db,
+	}
+}
+
+type ScrobblerRepository struct {
+	Database *sqlx.DB
+}
+
+func (r *ScrobblerRepository) GetAllScrobblersFromUser(userId int) ([]entities.Scrobbler, error) {
+	m := data_models.ScrobblerModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT *
+    FROM scrobblers
+    WHERE user_id = ?
+    ORDER BY id
+  `, userId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	scrobblers := m.ToScrobblers()
+
+	err = r.LoadQueueCountsInScrobblers(scrobblers)
+	if err != nil {
+		return nil, err
+	}
+
+	return scrobblers, nil
+}
+
+func (r *ScrobblerRepository) GetScrobblerById(id int) (*entities.Scrobbler, error) {
+	m := data_models.ScrobblerModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM scrobblers
+    WHERE id = ?
+  `, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ScrobblerNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	scrobbler := m.ToScrobbler()
+
+	err = r.LoadQueueCountsInScrobbler(&scrobbler)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scrobbler, nil
+}
+
+func (r *ScrobblerRepository) LoadQueueCountsInScrobbler(scrobbler *entities.Scrobbler) error {
+	scrobblers := []entities.Scrobbler{*scrobbler}
+
+	err := r.LoadQueueCountsInScrobblers(scrobblers)
+	if err != nil {
+		return err
+	}
+
+	*scrobbler = scrobblers[0]
+
+	return nil
+}
+
+func (r *ScrobblerRepository) LoadQueueCountsInScrobblers(scrobblers []entities.Scrobbler) error {
+	if len(scrobblers) == 0 {
+		return nil
+	}
+
+	scrobblerIds := make([]int, len(scrobblers))
+
+	for i, scrobbler := range scrobblers {
+		scrobblerIds[i] = scrobbler.Id
+	}
+
+	query, args, err := sqlx.In(`
+    SELECT
+      scrobbler_id,
+      SUM(submitted_at IS NULL AND failed_at IS NULL) AS pending_count,
+      SUM(failed_at IS NOT NULL) AS failed_count
+    FROM scrobble_queue
+    WHERE scrobbler_id IN (?)
+    GROUP BY scrobbler_id
+  `, scrobblerIds)
+	if err != nil {
+		return err
+	}
+	query = r.Database.Rebind(query)
+
+	counts := []data_models.ScrobblerQueueCountModel{}
+
+	err = r.Database.Select(&counts, query, args...)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	countsByScrobbler := make(map[int]data_models.ScrobblerQueueCountModel, len(counts))
+
+	for _, count := range counts {
+		countsByScrobbler[count.ScrobblerId] = count
+	}
+
+	for i := range scrobblers {
+		count := countsByScrobbler[scrobblers[i].Id]
+
+		scrobblers[i].PendingCount = count.PendingCount
+		scrobblers[i].FailedCount = count.FailedCount
+	}
+
+	return nil
+}
+
+func (r *ScrobblerRepository) CreateScrobbler(scrobbler *entities.Scrobbler) error {
+	m := data_models.ScrobblerModel{}
+
+	err := r.Database.Get(
+		&m,
+		`
+    INSERT INTO scrobblers
+      (
+        user_id,
+
+        service,
+        name,
+
+        base_url,
+
+        token,
+
+        api_key,
+        api_secret,
+        session_key,
+
+        enabled
+      )
+    VALUES
+      (
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?
+      )
+    RETURNING *
+  `,
+		scrobbler.UserId,
+
+		scrobbler.Service,
+		scrobbler.Name,
+
+		scrobbler.BaseUrl,
+
+		scrobbler.Token,
+
+		scrobbler.ApiKey,
+		scrobbler.ApiSecret,
+		scrobbler.SessionKey,
+
+		scrobbler.Enabled,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	*scrobbler = m.ToScrobbler()
+
+	return nil
+}
+
+func (r *ScrobblerRepository) UpdateScrobbler(scrobbler *entities.Scrobbler) error {
+	m := data_models.ScrobblerModel{}
+
+	err := r.Database.Get(
+		&m,
+		`
+    UPDATE scrobblers
+    SET
+      name = ?,
+
+      base_url = ?,
+
+      token = ?,
+
+      api_key = ?,
+      api_secret = ?,
+      session_key = ?,
+
+      enabled = ?,
+
+      updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE
+      id = ?
+    RETURNING *
+  `,
+		scrobbler.Name,
+
+		scrobbler.BaseUrl,
+
+		scrobbler.Token,
+
+		scrobbler.ApiKey,
+		scrobbler.ApiSecret,
+		scrobbler.SessionKey,
+
+		scrobbler.Enabled,
+
+		scrobbler.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	*scrobbler = m.ToScrobbler()
+
+	return r.LoadQueueCountsInScrobbler(scrobbler)
+}
+
+func (r *ScrobblerRepository) DeleteScrobbler(scrobbler *entities.Scrobbler) error {
+	m := data_models.ScrobblerModel{}
+
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+    DELETE FROM scrobble_queue
+    WHERE scrobbler_id = ?
+  `, scrobbler.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Get(&m, `
+    DELETE FROM scrobblers
+    WHERE id = ?
+    RETURNING *
+  `, scrobbler.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	*scrobbler = m.ToScrobbler()
+
+	return nil
+}
+
+// QueuePlayedTrack adds the played track to the queue of every enabled
+// scrobbler of its user, a played track uploaded again is never queued twice
+func (r *ScrobblerRepository) QueuePlayedTrack(playedTrack entities.SharedPlayedTrack) error {
+	_, err := r.Database.Exec(`
+    INSERT OR IGNORE INTO scrobble_queue
+      (
+        scrobbler_id,
+
+        played_track_id,
+        track_id,
+
+        listened_at,
+
+        next_attempt_at
+      )
+    SELECT id, ?, ?, ?, STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    FROM scrobblers
+    WHERE user_id = ? AND enabled = 1
+  `,
+		playedTrack.Id,
+		playedTrack.TrackId,
+
+		playedTrack.StartAt,
+
+		playedTrack.UserId,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// GetDueScrobbles returns the oldest pending scrobbles of enabled scrobblers
+// that can be attempted now
+func (r *ScrobblerRepository) GetDueScrobbles(limit int) ([]entities.Scrobble, error) {
+	m := data_models.ScrobbleModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT scrobble_queue.*
+    FROM scrobble_queue
+    JOIN scrobblers ON scrobblers.id = scrobble_queue.scrobbler_id
+    WHERE scrobble_queue.submitted_at IS NULL
+      AND scrobble_queue.failed_at IS NULL
+      AND julianday(scrobble_queue.next_attempt_at) <= julianday('now')
+      AND scrobblers.enabled = 1
+    ORDER BY julianday(scrobble_queue.next_attempt_at), scrobble_queue.id
+    LIMIT ?
+  `, limit)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToScrobbles(), nil
+}
+
+func (r *ScrobblerRepository) UpdateScrobble(scrobble *entities.Scrobble) error {
+	m := data_models.ScrobbleModel{}
+
+	err := r.Database.Get(
+		&m,
+		`
+    UPDATE scrobble_queue
+    SET
+      attempts = ?,
+      next_attempt_at = ?,
+      last_error = ?,
+
+      submitted_at = ?,
+      failed_at = ?
+    WHERE
+      id = ?
+    RETURNING *
+  `,
+		scrobble.Attempts,
+		scrobble.NextAttemptAt.UTC(),
+		scrobble.LastError,
+
+		scrobble.SubmittedAt,
+		scrobble.FailedAt,
+
+		scrobble.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	*scrobble = m.ToScrobble()
+
+	return nil
+}
+
+// RetryFailedScrobbles puts back the scrobbles that ran out of attempts in the
+// queue of the scrobbler
+func (r *ScrobblerRepository) RetryFailedScrobbles(scrobbler *entities.Scrobbler) error {
+	_, err := r.Database.Exec(`
+    UPDATE scrobble_queue
+    SET
+      attempts = 0,
+      next_attempt_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW'),
+      failed_at = NULL
+    WHERE scrobbler_id = ? AND failed_at IS NOT NULL
+  `, scrobbler.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	return r.LoadQueueCountsInScrobbler(scrobbler)
+}
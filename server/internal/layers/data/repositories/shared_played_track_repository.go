@@ -206,6 +206,174 @@ func (r *SharedPlayedTrackRepository) AddSharedPlayedTrack(
 	return nil
 }
 
+// ImportSharedPlayedTracks inserts the played tracks of an external history
+// and returns how many were inserted, a played track is ignored when the
+// same track was already played by the user around the same time
+func (r *SharedPlayedTrackRepository) ImportSharedPlayedTracks(
+	playedTracks []entities.SharedPlayedTrack,
+) (int, error) {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return 0, err
+	}
+
+	stmt, err := tx.Preparex(`
+    INSERT OR IGNORE INTO shared_played_tracks
+      (
+        internal_device_id,
+        user_id,
+        device_id,
+
+        track_id,
+
+        start_at,
+        finish_at,
+
+        begin_at,
+        ended_at,
+
+        shuffle,
+        track_ended,
+        track_duration,
+        updated_at
+      )
+    SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE NOT EXISTS (
+      SELECT 1
+      FROM shared_played_tracks
+      WHERE user_id = ?
+        AND julianday(start_at) BETWEEN julianday(?) - ? / 86400.0 AND julianday(?) + ? / 86400.0
+        AND track_id = ?
+    )
+  `)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return 0, err
+	}
+	defer stmt.Close()
+
+	importedCount := 0
+
+	for _, playedTrack := range playedTracks {
+		result, err := stmt.Exec(
+			playedTrack.InternalDeviceId,
+
+			playedTrack.UserId,
+			playedTrack.DeviceId,
+
+			playedTrack.TrackId,
+
+			playedTrack.StartAt,
+			playedTrack.FinishAt,
+
+			playedTrack.BeginAt,
+			playedTrack.EndedAt,
+
+			playedTrack.Shuffle,
+			playedTrack.TrackEnded,
+			playedTrack.TrackDuration,
+
+			playedTrack.UserId,
+			playedTrack.StartAt,
+			entities.ListenHistoryDuplicateWindow,
+			playedTrack.StartAt,
+			entities.ListenHistoryDuplicateWindow,
+			playedTrack.TrackId,
+		)
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			_ = tx.Rollback()
+			return 0, err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			_ = tx.Rollback()
+			return 0, err
+		}
+
+		importedCount += int(affected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return importedCount, nil
+}
+
+// GetPlayHistoryPage returns the next plays of the user in chronological
+// order after the given played track, the exports read the history page by
+// page to not keep the database locked while the client download it
+func (r *SharedPlayedTrackRepository) GetPlayHistoryPage(
+	userId int,
+	filter entities.PlayHistoryFilter,
+	after *entities.SharedPlayedTrack,
+	limit int,
+) ([]entities.PlayHistoryEntry, error) {
+	m := data_models.PlayHistoryEntryModels{}
+
+	var afterStartAt *time.Time
+	afterId := 0
+
+	if after != nil {
+		afterStartAt = &after.StartAt
+		afterId = after.Id
+	}
+
+	err := r.Database.Select(&m, `
+    SELECT
+      shared_played_tracks.*,
+
+      tracks.title AS track_title,
+      tracks.duration AS track_library_duration,
+
+      tracks.metadata_album AS track_metadata_album,
+      tracks.metadata_track_number AS track_metadata_track_number,
+      tracks.metadata_artists AS track_metadata_artists,
+      tracks.metadata_album_artists AS track_metadata_album_artists,
+
+      tracks.metadata_music_brainz_release_id AS track_metadata_music_brainz_release_id,
+      tracks.metadata_music_brainz_release_group_id AS track_metadata_music_brainz_release_group_id,
+      tracks.metadata_music_brainz_track_id AS track_metadata_music_brainz_track_id,
+      tracks.metadata_music_brainz_recording_id AS track_metadata_music_brainz_recording_id,
+      tracks.metadata_music_brainz_artist_ids AS track_metadata_music_brainz_artist_ids,
+      tracks.metadata_music_brainz_work_id AS track_metadata_music_brainz_work_id
+    FROM shared_played_tracks
+    LEFT JOIN tracks ON tracks.id = shared_played_tracks.track_id
+    WHERE shared_played_tracks.user_id = ?
+      AND (? IS NULL OR julianday(shared_played_tracks.start_at) >= julianday(?))
+      AND (? IS NULL OR julianday(shared_played_tracks.start_at) < julianday(?))
+      AND (? = '' OR shared_played_tracks.device_id = ?)
+      AND (
+        ? IS NULL
+        OR julianday(shared_played_tracks.start_at) > julianday(?)
+        OR (julianday(shared_played_tracks.start_at) = julianday(?) AND shared_played_tracks.id > ?)
+      )
+    ORDER BY julianday(shared_played_tracks.start_at), shared_played_tracks.id
+    LIMIT ?
+  `,
+		userId,
+
+		filter.From, filter.From,
+		filter.To, filter.To,
+
+		filter.DeviceId, filter.DeviceId,
+
+		afterStartAt, afterStartAt, afterStartAt, afterId,
+
+		limit,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToPlayHistoryEntries(), nil
+}
+
 func (r *SharedPlayedTrackRepository) DeleteSharedPlayedTrack(playedTrack *entities.SharedPlayedTrack) error {
 	m := data_models.SharedPlayedTrackModel{}
 
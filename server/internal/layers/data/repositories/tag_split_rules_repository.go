@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+// GetTagSplitRules return the user rules or the default ones when the user
+// never changed them.
+func (r *TrackRepository) GetTagSplitRules(userId int) (entities.TagSplitRules, error) {
+	m := data_models.TagSplitRulesModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM tag_split_rules
+    WHERE user_id = ?
+  `, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.NewDefaultTagSplitRules(userId), nil
+		}
+		logger.DatabaseLogger.Error(err)
+		return entities.TagSplitRules{}, err
+	}
+
+	return m.ToTagSplitRules(), nil
+}
+
+func (r *TrackRepository) SetTagSplitRules(rules *entities.TagSplitRules) error {
+	m := data_models.TagSplitRulesModel{}
+
+	artistSeparators := "[]"
+
+	if jsonData, err := json.Marshal(rules.ArtistSeparators); err == nil {
+		artistSeparators = string(jsonData)
+	}
+
+	genreSeparators := "[]"
+
+	if jsonData, err := json.Marshal(rules.GenreSeparators); err == nil {
+		genreSeparators = string(jsonData)
+	}
+
+	protectedNames := "[]"
+
+	if jsonData, err := json.Marshal(rules.ProtectedNames); err == nil {
+		protectedNames = string(jsonData)
+	}
+
+	err := r.Database.Get(
+		&m,
+		`
+    INSERT INTO tag_split_rules
+      (
+        user_id,
+
+        artist_separators,
+        genre_separators,
+
+        protected_names
+      )
+    VALUES
+      (
+        ?,
+
+        ?,
+        ?,
+
+        ?
+      )
+    ON CONFLICT (user_id) DO UPDATE SET
+      artist_separators = excluded.artist_separators,
+      genre_separators = excluded.genre_separators,
+      protected_names = excluded.protected_names,
+      updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    RETURNING *
+  `,
+		rules.UserId,
+
+		artistSeparators,
+		genreSeparators,
+
+		protectedNames,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	*rules = m.ToTagSplitRules()
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/pkgs/fingerprintmatch"
+)
+
+func (r *TrackRepository) GetAllTrackFingerprintsFromUser(
+	userId int,
+) ([]entities.TrackFingerprint, error) {
+	m := data_models.TrackFingerprintModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT track_fingerprints.track_id, track_fingerprints.file_signature, track_fingerprints.fingerprint
+    FROM track_fingerprints
+    JOIN tracks ON tracks.id = track_fingerprints.track_id
+    WHERE tracks.user_id = ? AND tracks.file_signature = track_fingerprints.file_signature
+  `, userId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	return m.ToTrackFingerprints(), nil
+}
+
+func (r *TrackRepository) SetTrackFingerprint(fingerprint *entities.TrackFingerprint) error {
+	_, err := r.Database.Exec(`
+    INSERT INTO track_fingerprints
+      (
+        track_id,
+
+        file_signature,
+        fingerprint
+      )
+    VALUES
+      (
+        ?,
+
+        ?,
+        ?
+      )
+    ON CONFLICT (track_id) DO UPDATE SET
+      file_signature = excluded.file_signature,
+      fingerprint = excluded.fingerprint,
+      created_at = CURRENT_TIMESTAMP
+  `,
+		fingerprint.TrackId,
+
+		fingerprint.FileSignature,
+		fingerprintmatch.Encode(fingerprint.Fingerprint),
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
@@ -49,6 +51,21 @@ func (r *TrackRepository) GetAllTracks() ([]entities.Track, error) {
 		return nil, err
 	}
 
+	err = r.LoadGenresInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadWorkInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadRoleArtistsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
 	return tracks, nil
 }
 
@@ -75,6 +92,99 @@ func (r *TrackRepository) GetAllTracksFromUser(userId int) ([]entities.Track, er
 		return nil, err
 	}
 
+	err = r.LoadGenresInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadWorkInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadRoleArtistsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+// GetAllTracksWithUnlinkedGenres return the tracks with a genres tag but no
+// linked genre, like the tracks imported before the genres existed.
+func (r *TrackRepository) GetAllTracksWithUnlinkedGenres() ([]entities.Track, error) {
+	m := data_models.TracksModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT *
+    FROM tracks
+    WHERE
+      user_id IS NOT NULL
+      AND pending_import = 0
+      AND json_array_length(metadata_genres) > 0
+      AND NOT EXISTS (SELECT 1 FROM track_genre WHERE track_genre.track_id = tracks.id)
+  `)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	tracks := m.ToTracks()
+
+	err = r.LoadAlbumsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+// GetSuspiciousTracksFromUser return the tracks whose quality check found a
+// lossy source or an upsampling.
+func (r *TrackRepository) GetSuspiciousTracksFromUser(userId int) ([]entities.Track, error) {
+	m := data_models.TracksModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT * FROM tracks
+    WHERE user_id = ? AND pending_import = 0 AND quality_verdict IN (?, ?)
+    ORDER BY quality_verdict, quality_cutoff_frequency
+  `,
+		userId,
+		entities.TrackQualityVerdictLossyTranscode,
+		entities.TrackQualityVerdictUpsampled,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	tracks := m.ToTracks()
+
+	err = r.LoadAlbumsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadArtistsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadGenresInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadWorkInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadRoleArtistsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
 	return tracks, nil
 }
 
@@ -104,6 +214,21 @@ func (r *TrackRepository) GetAllTracksFromUserSince(
 		return nil, err
 	}
 
+	err = r.LoadGenresInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadWorkInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadRoleArtistsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
 	return tracks, nil
 }
 
@@ -130,6 +255,122 @@ func (r *TrackRepository) GetAllPendingImportTracksFromUser(userId int) ([]entit
 		return nil, err
 	}
 
+	err = r.LoadGenresInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadWorkInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadRoleArtistsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+var trackSortOrders = map[entities.TrackSortField]string{
+	entities.TrackSortDefault:      "metadata_disc_number %[1]s, metadata_track_number %[1]s, title %[1]s",
+	entities.TrackSortTitle:        "title COLLATE NOCASE %[1]s",
+	entities.TrackSortDateAdded:    "date_added %[1]s",
+	entities.TrackSortBpm:          "analysis_bpm IS NULL, analysis_bpm %[1]s",
+	entities.TrackSortCamelot:      "analysis_camelot IS NULL, CAST(analysis_camelot AS INTEGER) %[1]s, analysis_camelot %[1]s",
+	entities.TrackSortEnergy:       "analysis_energy IS NULL, analysis_energy %[1]s",
+	entities.TrackSortDanceability: "analysis_danceability IS NULL, analysis_danceability %[1]s",
+}
+
+func (r *TrackRepository) GetAllTracksFromUserByFilter(
+	userId int,
+	filter entities.TrackFilter,
+	sort entities.TrackSort,
+) ([]entities.Track, error) {
+	m := data_models.TracksModels{}
+
+	order, ok := trackSortOrders[sort.Field]
+	if !ok {
+		order = trackSortOrders[entities.TrackSortDefault]
+	}
+
+	direction := "ASC"
+	if sort.Descending {
+		direction = "DESC"
+	}
+
+	var camelots *string
+
+	if len(filter.Camelots) != 0 {
+		if jsonData, err := json.Marshal(filter.Camelots); err == nil {
+			data := string(jsonData)
+			camelots = &data
+		}
+	}
+
+	err := r.Database.Select(&m, `
+    SELECT *
+    FROM tracks
+    WHERE user_id = ? AND pending_import = 0
+      AND (? IS NULL OR metadata_album = ?)
+      AND (? IS NULL OR id IN (SELECT track_id FROM track_album WHERE album_id = ?))
+      AND (? IS NULL OR id IN (SELECT track_id FROM track_artist WHERE artist_id = ?))
+      AND (? IS NULL OR EXISTS (SELECT 1 FROM json_each(tracks.metadata_genres) WHERE value = ?))
+      AND (? IS NULL OR analysis_bpm >= ?)
+      AND (? IS NULL OR analysis_bpm <= ?)
+      AND (? IS NULL OR analysis_camelot IN (SELECT value FROM json_each(?)))
+      AND (? IS NULL OR analysis_energy >= ?)
+      AND (? IS NULL OR analysis_energy <= ?)
+      AND (? IS NULL OR analysis_danceability >= ?)
+      AND (? IS NULL OR analysis_danceability <= ?)
+    ORDER BY `+fmt.Sprintf(order, direction)+`
+  `,
+		userId,
+		filter.Album, filter.Album,
+		filter.AlbumId, filter.AlbumId,
+		filter.ArtistId, filter.ArtistId,
+		filter.Genre, filter.Genre,
+		filter.MinBpm, filter.MinBpm,
+		filter.MaxBpm, filter.MaxBpm,
+		camelots, camelots,
+		filter.MinEnergy, filter.MinEnergy,
+		filter.MaxEnergy, filter.MaxEnergy,
+		filter.MinDanceability, filter.MinDanceability,
+		filter.MaxDanceability, filter.MaxDanceability,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	tracks := m.ToTracks()
+
+	err = r.LoadAlbumsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadArtistsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadGenresInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadWorkInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadRoleArtistsInTracks(tracks)
+	if err != nil {
+		return nil, err
+	}
+
 	return tracks, nil
 }
 
@@ -163,6 +404,21 @@ func (r *TrackRepository) GetTrack(
 		return nil, err
 	}
 
+	err = r.LoadGenresInTrack(&track)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadWorkInTrack(&track)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.LoadRoleArtistsInTrack(&track)
+	if err != nil {
+		return nil, err
+	}
+
 	return &track, nil
 }
 
@@ -228,6 +484,111 @@ func (r *TrackRepository) LoadArtistsInTracks(tracks []entities.Track) error {
 	return nil
 }
 
+func (r *TrackRepository) LoadGenresInTrack(track *entities.Track) error {
+	m := data_models.GenreModels{}
+
+	err := r.Database.Select(&m, `
+		SELECT genres.*
+		FROM genres
+		JOIN track_genre ON genres.id = track_genre.genre_id
+		WHERE track_genre.track_id = ?
+		ORDER BY track_genre.genre_pos ASC
+  `, track.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	track.Genres = m.ToGenres()
+
+	return nil
+}
+
+func (r *TrackRepository) LoadGenresInTracks(tracks []entities.Track) error {
+	for i := range tracks {
+		err := r.LoadGenresInTrack(&tracks[i])
+		if err != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (r *TrackRepository) LoadWorkInTrack(track *entities.Track) error {
+	m := data_models.WorkModels{}
+
+	err := r.Database.Select(&m, `
+		SELECT works.*
+		FROM works
+		JOIN track_work ON works.id = track_work.work_id
+		WHERE track_work.track_id = ?
+		LIMIT 1
+  `, track.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	track.Work = nil
+
+	if len(m) != 0 {
+		work := m[0].ToWork()
+		track.Work = &work
+	}
+
+	return nil
+}
+
+func (r *TrackRepository) LoadWorkInTracks(tracks []entities.Track) error {
+	for i := range tracks {
+		err := r.LoadWorkInTrack(&tracks[i])
+		if err != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (r *TrackRepository) LoadRoleArtistsInTrack(track *entities.Track) error {
+	m := []data_models.TrackRoleArtistModel{}
+
+	err := r.Database.Select(&m, `
+		SELECT track_artist_role.role, artists.*
+		FROM artists
+		JOIN track_artist_role ON artists.id = track_artist_role.artist_id
+		WHERE track_artist_role.track_id = ?
+		ORDER BY track_artist_role.role_pos ASC
+  `, track.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	track.RoleArtists = make([]entities.TrackRoleArtist, 0, len(m))
+
+	for _, roleArtist := range m {
+		track.RoleArtists = append(track.RoleArtists, entities.TrackRoleArtist{
+			Role:   roleArtist.Role,
+			Artist: roleArtist.ToArtist(),
+		})
+	}
+
+	return nil
+}
+
+func (r *TrackRepository) LoadRoleArtistsInTracks(tracks []entities.Track) error {
+	for i := range tracks {
+		err := r.LoadRoleArtistsInTrack(&tracks[i])
+		if err != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
 func (r *TrackRepository) CreateTrack(track *entities.Track) error {
 	m := data_models.TrackModel{}
 
@@ -243,12 +604,36 @@ func (r *TrackRepository) CreateTrack(track *entities.Track) error {
 		albumArtists = string(jsonData)
 	}
 
+	releaseTypes := "[]"
+
+	if jsonData, err := json.Marshal(track.Metadata.ReleaseTypes); err == nil {
+		releaseTypes = string(jsonData)
+	}
+
+	musicBrainzArtistIds := "[]"
+
+	if jsonData, err := json.Marshal(track.Metadata.MusicBrainzArtistIds); err == nil {
+		musicBrainzArtistIds = string(jsonData)
+	}
+
+	musicBrainzAlbumArtistIds := "[]"
+
+	if jsonData, err := json.Marshal(track.Metadata.MusicBrainzAlbumArtistIds); err == nil {
+		musicBrainzAlbumArtistIds = string(jsonData)
+	}
+
 	genres := "[]"
 
 	if jsonData, err := json.Marshal(track.Metadata.Genres); err == nil {
 		genres = string(jsonData)
 	}
 
+	syncedLyrics := "[]"
+
+	if jsonData, err := json.Marshal(data_models.TrackLyricsLineModelsFromEntities(track.Metadata.SyncedLyrics)); err == nil {
+		syncedLyrics = string(jsonData)
+	}
+
 	artistsRoles := "[]"
 
 	if jsonData, err := json.Marshal(data_models.TrackArtistRoleModelsFromEntities(track.Metadata.ArtistsRoles)); err == nil {
@@ -272,6 +657,11 @@ func (r *TrackRepository) CreateTrack(track *entities.Track) error {
         file_signature,
         cover_signature,
 
+        cover_dominant_color,
+        cover_vibrant_color,
+        cover_muted_color,
+        cover_blurhash,
+
         transcoding_low_signature,
         transcoding_medium_signature,
         transcoding_high_signature,
@@ -290,6 +680,7 @@ func (r *TrackRepository) CreateTrack(track *entities.Track) error {
         metadata_genres,
         metadata_lyrics,
         metadata_comment,
+        metadata_synced_lyrics,
 
         metadata_acoust_id,
 
@@ -297,21 +688,92 @@ func (r *TrackRepository) CreateTrack(track *entities.Track) error {
         metadata_music_brainz_track_id,
         metadata_music_brainz_recording_id,
 
+        metadata_release_types,
+        metadata_original_date,
+        metadata_label,
+        metadata_catalog_number,
+        metadata_barcode,
+
+        metadata_music_brainz_release_group_id,
+
         metadata_artists,
         metadata_album_artists,
         metadata_artists_roles,
 
+        metadata_music_brainz_artist_ids,
+        metadata_music_brainz_album_artist_ids,
+
         metadata_composer,
 
+        metadata_work,
+        metadata_movement_name,
+        metadata_movement_number,
+        metadata_movement_total,
+        metadata_music_brainz_work_id,
+
         sample_rate,
         bit_rate,
         bits_per_raw_sample,
 
-        pending_import,
+        loudness_integrated,
+        loudness_true_peak,
+        loudness_range,
+
+        album_loudness_integrated,
+        album_loudness_true_peak,
+        album_loudness_range,
+
+        analysis_bpm,
+        analysis_key,
+        analysis_camelot,
+        analysis_energy,
+        analysis_danceability,
+
+        quality_verdict,
+        quality_cutoff_frequency,
+        quality_sample_rate,
+        quality_bits_per_sample,
+        quality_bit_rate,
+
+        pending_import,
 				created_at
       )
     VALUES
       (
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
+        ?,
         ?,
         ?,
         ?,
@@ -361,6 +823,11 @@ func (r *TrackRepository) CreateTrack(track *entities.Track) error {
 		track.FileSignature,
 		track.CoverSignature,
 
+		track.CoverPalette.Dominant,
+		track.CoverPalette.Vibrant,
+		track.CoverPalette.Muted,
+		track.CoverPalette.BlurHash,
+
 		track.TranscodingLowSignature,
 		track.TranscodingMediumSignature,
 		track.TranscodingHighSignature,
@@ -379,6 +846,7 @@ func (r *TrackRepository) CreateTrack(track *entities.Track) error {
 		genres,
 		track.Metadata.Lyrics,
 		track.Metadata.Comment,
+		syncedLyrics,
 
 		track.Metadata.AcoustID,
 
@@ -386,16 +854,53 @@ func (r *TrackRepository) CreateTrack(track *entities.Track) error {
 		track.Metadata.MusicBrainzTrackId,
 		track.Metadata.MusicBrainzRecordingId,
 
+		releaseTypes,
+		track.Metadata.OriginalDate,
+		track.Metadata.Label,
+		track.Metadata.CatalogNumber,
+		track.Metadata.Barcode,
+
+		track.Metadata.MusicBrainzReleaseGroupId,
+
 		artists,
 		albumArtists,
 		artistsRoles,
 
+		musicBrainzArtistIds,
+		musicBrainzAlbumArtistIds,
+
 		track.Metadata.Composer,
 
+		track.Metadata.Work,
+		track.Metadata.MovementName,
+		track.Metadata.MovementNumber,
+		track.Metadata.MovementTotal,
+		track.Metadata.MusicBrainzWorkId,
+
 		track.SampleRate,
 		track.BitRate,
 		track.BitsPerRawSample,
 
+		track.Loudness.Integrated,
+		track.Loudness.TruePeak,
+		track.Loudness.Range,
+
+		track.Loudness.AlbumIntegrated,
+		track.Loudness.AlbumTruePeak,
+		track.Loudness.AlbumRange,
+
+		track.Analysis.Bpm,
+		track.Analysis.Key,
+		track.Analysis.Camelot,
+		track.Analysis.Energy,
+		track.Analysis.Danceability,
+
+		track.QualityCheck.Verdict,
+		track.QualityCheck.CutoffFrequency,
+		track.QualityCheck.SampleRate,
+		track.QualityCheck.BitsPerSample,
+		track.QualityCheck.BitRate,
+
 		track.PendingImport,
 	)
 	if err != nil {
@@ -415,10 +920,116 @@ func (r *TrackRepository) CreateTrack(track *entities.Track) error {
 		return err
 	}
 
+	err = r.LoadGenresInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadWorkInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadRoleArtistsInTrack(track)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (r *TrackRepository) UpdateTrack(track *entities.Track) error {
+	m, err := r.updateTrackRow(r.Database, track)
+	if err != nil {
+		return err
+	}
+
+	*track = m.ToTrack()
+
+	err = r.LoadAlbumsInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadArtistsInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadGenresInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadWorkInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadRoleArtistsInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *TrackRepository) UpdateTracks(tracks []entities.Track) error {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	m := make(data_models.TracksModels, len(tracks))
+
+	for i := range tracks {
+		m[i], err = r.updateTrackRow(tx, &tracks[i])
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	for i := range m {
+		tracks[i] = m[i].ToTrack()
+	}
+
+	err = r.LoadAlbumsInTracks(tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadArtistsInTracks(tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadGenresInTracks(tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadWorkInTracks(tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadRoleArtistsInTracks(tracks)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *TrackRepository) updateTrackRow(
+	q sqlx.Queryer,
+	track *entities.Track,
+) (data_models.TrackModel, error) {
 	m := data_models.TrackModel{}
 
 	artists := "[]"
@@ -433,19 +1044,44 @@ func (r *TrackRepository) UpdateTrack(track *entities.Track) error {
 		albumArtists = string(jsonData)
 	}
 
+	releaseTypes := "[]"
+
+	if jsonData, err := json.Marshal(track.Metadata.ReleaseTypes); err == nil {
+		releaseTypes = string(jsonData)
+	}
+
+	musicBrainzArtistIds := "[]"
+
+	if jsonData, err := json.Marshal(track.Metadata.MusicBrainzArtistIds); err == nil {
+		musicBrainzArtistIds = string(jsonData)
+	}
+
+	musicBrainzAlbumArtistIds := "[]"
+
+	if jsonData, err := json.Marshal(track.Metadata.MusicBrainzAlbumArtistIds); err == nil {
+		musicBrainzAlbumArtistIds = string(jsonData)
+	}
+
 	genres := "[]"
 
 	if jsonData, err := json.Marshal(track.Metadata.Genres); err == nil {
 		genres = string(jsonData)
 	}
 
+	syncedLyrics := "[]"
+
+	if jsonData, err := json.Marshal(data_models.TrackLyricsLineModelsFromEntities(track.Metadata.SyncedLyrics)); err == nil {
+		syncedLyrics = string(jsonData)
+	}
+
 	artistsRoles := "[]"
 
 	if jsonData, err := json.Marshal(data_models.TrackArtistRoleModelsFromEntities(track.Metadata.ArtistsRoles)); err == nil {
 		artistsRoles = string(jsonData)
 	}
 
-	err := r.Database.Get(
+	err := sqlx.Get(
+		q,
 		&m,
 		`
     UPDATE tracks
@@ -462,6 +1098,11 @@ func (r *TrackRepository) UpdateTrack(track *entities.Track) error {
         file_signature = ?,
         cover_signature = ?,
 
+        cover_dominant_color = ?,
+        cover_vibrant_color = ?,
+        cover_muted_color = ?,
+        cover_blurhash = ?,
+
         transcoding_low_signature = ?,
         transcoding_medium_signature = ?,
         transcoding_high_signature = ?,
@@ -480,6 +1121,7 @@ func (r *TrackRepository) UpdateTrack(track *entities.Track) error {
         metadata_genres = ?,
         metadata_lyrics = ?,
         metadata_comment = ?,
+        metadata_synced_lyrics = ?,
 
         metadata_acoust_id = ?,
 
@@ -487,18 +1129,57 @@ func (r *TrackRepository) UpdateTrack(track *entities.Track) error {
         metadata_music_brainz_track_id = ?,
         metadata_music_brainz_recording_id = ?,
 
+        metadata_release_types = ?,
+        metadata_original_date = ?,
+        metadata_label = ?,
+        metadata_catalog_number = ?,
+        metadata_barcode = ?,
+
+        metadata_music_brainz_release_group_id = ?,
+
         metadata_artists = ?,
         metadata_album_artists = ?,
         metadata_artists_roles = ?,
 
+        metadata_music_brainz_artist_ids = ?,
+        metadata_music_brainz_album_artist_ids = ?,
+
         metadata_composer = ?,
 
+        metadata_work = ?,
+        metadata_movement_name = ?,
+        metadata_movement_number = ?,
+        metadata_movement_total = ?,
+        metadata_music_brainz_work_id = ?,
+
         sample_rate = ?,
         bit_rate = ?,
         bits_per_raw_sample = ?,
 
+        loudness_integrated = ?,
+        loudness_true_peak = ?,
+        loudness_range = ?,
+
+        album_loudness_integrated = ?,
+        album_loudness_true_peak = ?,
+        album_loudness_range = ?,
+
+        analysis_bpm = ?,
+        analysis_key = ?,
+        analysis_camelot = ?,
+        analysis_energy = ?,
+        analysis_danceability = ?,
+
+        quality_verdict = ?,
+        quality_cutoff_frequency = ?,
+        quality_sample_rate = ?,
+        quality_bits_per_sample = ?,
+        quality_bit_rate = ?,
+
         pending_import = ?,
 
+        metadata_edited_at = ?,
+
         date_added = ?,
 
 				updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
@@ -518,6 +1199,11 @@ func (r *TrackRepository) UpdateTrack(track *entities.Track) error {
 		track.FileSignature,
 		track.CoverSignature,
 
+		track.CoverPalette.Dominant,
+		track.CoverPalette.Vibrant,
+		track.CoverPalette.Muted,
+		track.CoverPalette.BlurHash,
+
 		track.TranscodingLowSignature,
 		track.TranscodingMediumSignature,
 		track.TranscodingHighSignature,
@@ -536,6 +1222,7 @@ func (r *TrackRepository) UpdateTrack(track *entities.Track) error {
 		genres,
 		track.Metadata.Lyrics,
 		track.Metadata.Comment,
+		syncedLyrics,
 
 		track.Metadata.AcoustID,
 
@@ -543,40 +1230,67 @@ func (r *TrackRepository) UpdateTrack(track *entities.Track) error {
 		track.Metadata.MusicBrainzTrackId,
 		track.Metadata.MusicBrainzRecordingId,
 
+		releaseTypes,
+		track.Metadata.OriginalDate,
+		track.Metadata.Label,
+		track.Metadata.CatalogNumber,
+		track.Metadata.Barcode,
+
+		track.Metadata.MusicBrainzReleaseGroupId,
+
 		artists,
 		albumArtists,
 		artistsRoles,
 
+		musicBrainzArtistIds,
+		musicBrainzAlbumArtistIds,
+
 		track.Metadata.Composer,
 
+		track.Metadata.Work,
+		track.Metadata.MovementName,
+		track.Metadata.MovementNumber,
+		track.Metadata.MovementTotal,
+		track.Metadata.MusicBrainzWorkId,
+
 		track.SampleRate,
 		track.BitRate,
 		track.BitsPerRawSample,
 
+		track.Loudness.Integrated,
+		track.Loudness.TruePeak,
+		track.Loudness.Range,
+
+		track.Loudness.AlbumIntegrated,
+		track.Loudness.AlbumTruePeak,
+		track.Loudness.AlbumRange,
+
+		track.Analysis.Bpm,
+		track.Analysis.Key,
+		track.Analysis.Camelot,
+		track.Analysis.Energy,
+		track.Analysis.Danceability,
+
+		track.QualityCheck.Verdict,
+		track.QualityCheck.CutoffFrequency,
+		track.QualityCheck.SampleRate,
+		track.QualityCheck.BitsPerSample,
+		track.QualityCheck.BitRate,
+
 		track.PendingImport,
 
+		track.MetadataEditedAt,
+
 		track.DateAdded,
 
 		track.Id,
 	)
 	if err != nil {
 		logger.DatabaseLogger.Error(err)
-		return err
-	}
-
-	*track = m.ToTrack()
-
-	err = r.LoadAlbumsInTrack(track)
-	if err != nil {
-		return err
+		return data_models.TrackModel{}, err
 	}
 
-	err = r.LoadArtistsInTrack(track)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return m, nil
 }
 
 func (r *TrackRepository) UpdateTrackPath(track *entities.Track) error {
@@ -613,6 +1327,21 @@ func (r *TrackRepository) UpdateTrackPath(track *entities.Track) error {
 		return err
 	}
 
+	err = r.LoadGenresInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadWorkInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadRoleArtistsInTrack(track)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -810,6 +1539,206 @@ func (r TrackRepository) SetTrackArtists(track *entities.Track) error {
 	return nil
 }
 
+func (r TrackRepository) SetTrackGenres(track *entities.Track) error {
+	if len(track.Genres) == 0 {
+		_, err := r.Database.Exec(`
+			DELETE FROM track_genre
+			WHERE track_id = ?
+		`, track.Id)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	genreIds := make([]int, len(track.Genres))
+
+	for i, genre := range track.Genres {
+		genreIds[i] = genre.Id
+	}
+
+	// Remove Extra
+
+	delQuery, delArgs, err := sqlx.In(`
+		DELETE FROM track_genre
+		WHERE track_id = ? AND genre_id NOT IN (?)
+	`, track.Id, genreIds)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	delQuery = tx.Rebind(delQuery)
+
+	_, err = tx.Exec(delQuery, delArgs...)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	// Insert Missing
+
+	insertQuery := `INSERT OR IGNORE INTO track_genre (track_id, genre_id) VALUES `
+	args := make([]any, 0, len(genreIds)*2)
+	valuePlaceholders := ""
+
+	for i, genreId := range genreIds {
+		if i > 0 {
+			valuePlaceholders += ", "
+		}
+		valuePlaceholders += "(?, ?)"
+		args = append(args, track.Id, genreId)
+	}
+
+	insertQuery += valuePlaceholders
+
+	_, err = tx.Exec(insertQuery, args...)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	// Set Order
+
+	for i, genreId := range genreIds {
+		_, err = tx.Exec(
+			"UPDATE track_genre SET genre_pos = ? WHERE track_id = ? AND genre_id = ?",
+			i,
+			track.Id,
+			genreId,
+		)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	// Update
+
+	_, err = tx.Exec(
+		"UPDATE tracks SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE id = ?",
+		track.Id,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r TrackRepository) SetTrackWork(track *entities.Track) error {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	if track.Work == nil {
+		_, err = tx.Exec(`
+			DELETE FROM track_work
+			WHERE track_id = ?
+		`, track.Id)
+	} else {
+		_, err = tx.Exec(`
+			DELETE FROM track_work
+			WHERE track_id = ? AND work_id != ?
+		`, track.Id, track.Work.Id)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if track.Work != nil {
+		_, err = tx.Exec(
+			"INSERT OR IGNORE INTO track_work (track_id, work_id) VALUES (?, ?)",
+			track.Id,
+			track.Work.Id,
+		)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	// Update
+
+	_, err = tx.Exec(
+		"UPDATE tracks SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE id = ?",
+		track.Id,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r TrackRepository) SetTrackRoleArtists(track *entities.Track) error {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	// The same artist can have many roles so links are replaced as a whole
+
+	_, err = tx.Exec(`
+		DELETE FROM track_artist_role
+		WHERE track_id = ?
+	`, track.Id)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	for i, roleArtist := range track.RoleArtists {
+		_, err = tx.Exec(`
+			INSERT OR IGNORE INTO track_artist_role (track_id, artist_id, role, role_pos)
+			VALUES (?, ?, ?, ?)
+		`,
+			track.Id,
+			roleArtist.Artist.Id,
+			roleArtist.Role,
+			i,
+		)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	// Update
+
+	_, err = tx.Exec(
+		"UPDATE tracks SET updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW') WHERE id = ?",
+		track.Id,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *TrackRepository) DeleteTrack(track *entities.Track) error {
 	m := data_models.TrackModel{}
 
@@ -856,6 +1785,227 @@ func (r *TrackRepository) DeleteTrack(track *entities.Track) error {
 		return err
 	}
 
+	err = r.LoadGenresInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadWorkInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	err = r.LoadRoleArtistsInTrack(track)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MergeTracks replace the source tracks by the destination in every playlist,
+// give it their play history and scores then delete them, all of it or
+// nothing is saved.
+func (r *TrackRepository) MergeTracks(destinationId int, sourceIds []int) error {
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return err
+	}
+
+	if err := replacePlaylistsTracks(tx, sourceIds, destinationId); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	for _, sourceId := range sourceIds {
+		if err := moveTrackScoresAndPlays(tx, sourceId, destinationId); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+
+		_, err = tx.Exec("DELETE FROM tracks WHERE id = ?", sourceId)
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			_ = tx.Rollback()
+			return err
+		}
+
+		_, err = tx.Exec("INSERT INTO deleted_tracks (id) VALUES (?)", sourceId)
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// replacePlaylistsTracks swap every occurrence of the source tracks in the
+// playlists and keep their order. A revision is recorded for each changed
+// playlist so the merge can be undone like any other tracks change.
+func replacePlaylistsTracks(tx *sqlx.Tx, sourceIds []int, destinationId int) error {
+	m := data_models.PlaylistsModels{}
+
+	query, args, err := sqlx.In(`
+    SELECT *
+    FROM playlists
+    WHERE EXISTS (SELECT 1 FROM json_each(playlists.track_ids) WHERE value IN (?))
+  `, sourceIds)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Select(&m, tx.Rebind(query), args...)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	for _, playlist := range m {
+		var trackIds []int
+
+		if err := json.Unmarshal([]byte(playlist.TrackIds), &trackIds); err != nil {
+			logger.DatabaseLogger.Errorf("Failed to decode track_ids json of %d : %v", playlist.Id, err)
+			return err
+		}
+
+		addedTrackIds := []int{}
+		removedTrackIds := []int{}
+
+		for i := range trackIds {
+			if slices.Contains(sourceIds, trackIds[i]) {
+				removedTrackIds = append(removedTrackIds, trackIds[i])
+				addedTrackIds = append(addedTrackIds, destinationId)
+
+				trackIds[i] = destinationId
+			}
+		}
+
+		encodedTrackIds, err := json.Marshal(trackIds)
+		if err != nil {
+			logger.DatabaseLogger.Errorf("Failed to encode tracksIds JSON into the database : %v", err)
+			return err
+		}
+
+		encodedAddedTrackIds, err := json.Marshal(addedTrackIds)
+		if err != nil {
+			logger.DatabaseLogger.Errorf("Failed to encode addedTrackIds JSON into the database : %v", err)
+			return err
+		}
+
+		encodedRemovedTrackIds, err := json.Marshal(removedTrackIds)
+		if err != nil {
+			logger.DatabaseLogger.Errorf("Failed to encode removedTrackIds JSON into the database : %v", err)
+			return err
+		}
+
+		_, err = tx.Exec(`
+    INSERT INTO playlist_revisions
+      (
+        playlist_id,
+        user_id,
+
+        action,
+
+        name,
+        description,
+
+        track_ids,
+
+        added_track_ids,
+        removed_track_ids,
+
+        created_at
+      )
+    VALUES
+      (
+        ?,
+        ?,
+
+        ?,
+
+        ?,
+        ?,
+
+        ?,
+
+        ?,
+        ?,
+
+        STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+      )
+  `,
+			playlist.Id,
+			playlist.UserId,
+
+			entities.PlaylistRevisionTracks,
+
+			playlist.Name,
+			playlist.Description,
+
+			playlist.TrackIds,
+
+			encodedAddedTrackIds,
+			encodedRemovedTrackIds,
+		)
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			return err
+		}
+
+		_, err = tx.Exec(`
+    UPDATE playlists
+    SET
+        track_ids = ?,
+        updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE
+      id = ?
+  `, encodedTrackIds, playlist.Id)
+		if err != nil {
+			logger.DatabaseLogger.Error(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// moveTrackScoresAndPlays give the play history and the scores of a track to
+// another one, a score already set on the destination is kept.
+func moveTrackScoresAndPlays(tx *sqlx.Tx, sourceId int, destinationId int) error {
+	_, err := tx.Exec(`
+    UPDATE shared_played_tracks
+    SET
+      track_id = ?,
+      updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE
+      track_id = ?
+  `, destinationId, sourceId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    INSERT OR IGNORE INTO track_score (user_id, track_id, score)
+    SELECT user_id, ?, score
+    FROM track_score
+    WHERE track_id = ?
+  `, destinationId, sourceId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	_, err = tx.Exec(`
+    DELETE FROM track_score WHERE track_id = ?
+  `, sourceId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,530 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	data_models "github.com/gungun974/Melodink/server/internal/layers/data/models"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/jmoiron/sqlx"
+)
+
+var WorkNotFoundError = errors.New("Work is not found")
+
+func NewWorkRepository(
+	db *sqlx.DB,
+	trackRepository TrackRepository,
+) WorkRepository {
+	return WorkRepository{
+		Database:        db,
+		trackRepository: trackRepository,
+
+		getWorkOrCreateMutex: &sync.Mutex{},
+	}
+}
+
+type WorkRepository struct {
+	Database        *sqlx.DB
+	trackRepository TrackRepository
+
+	getWorkOrCreateMutex *sync.Mutex
+}
+
+func (r *WorkRepository) GetAllWorksFromUser(userId int) ([]entities.Work, error) {
+	m := data_models.WorkModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT * FROM works WHERE user_id = ? ORDER BY name COLLATE NOCASE
+  `, userId)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	works := m.ToWorks()
+
+	err = r.LoadStatsInWorks(works)
+	if err != nil {
+		return nil, err
+	}
+
+	return works, nil
+}
+
+func (r *WorkRepository) GetAllWorksFromUserSince(
+	userId int,
+	since time.Time,
+) ([]entities.Work, error) {
+	m := data_models.WorkModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT * FROM works WHERE user_id = ? AND (COALESCE(updated_at, created_at) >= ?)
+  `, userId, since.UTC())
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	works := m.ToWorks()
+
+	err = r.LoadStatsInWorks(works)
+	if err != nil {
+		return nil, err
+	}
+
+	return works, nil
+}
+
+// GetAllWorksFromComposer return the works having at least one track where
+// the artist is linked as composer.
+func (r *WorkRepository) GetAllWorksFromComposer(artistId int) ([]entities.Work, error) {
+	m := data_models.WorkModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT DISTINCT works.*
+    FROM works
+    JOIN track_work ON track_work.work_id = works.id
+    JOIN track_artist_role ON track_artist_role.track_id = track_work.track_id
+    WHERE track_artist_role.artist_id = ? AND track_artist_role.role = ?
+    ORDER BY works.name COLLATE NOCASE
+  `, artistId, entities.TrackArtistRoleComposer)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	works := m.ToWorks()
+
+	err = r.LoadStatsInWorks(works)
+	if err != nil {
+		return nil, err
+	}
+
+	return works, nil
+}
+
+func (r *WorkRepository) GetWorkById(id int) (*entities.Work, error) {
+	m := data_models.WorkModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM works
+    WHERE id = ?
+  `, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, WorkNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	work := m.ToWork()
+
+	err = r.LoadStatsInWork(&work)
+	if err != nil {
+		return nil, err
+	}
+
+	return &work, nil
+}
+
+func (r *WorkRepository) GetWorkByMusicBrainzId(
+	musicBrainzWorkId string,
+	userId int,
+) (*entities.Work, error) {
+	m := data_models.WorkModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM works
+    WHERE music_brainz_work_id = ? AND user_id = ?
+  `, musicBrainzWorkId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, WorkNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	work := m.ToWork()
+
+	return &work, nil
+}
+
+func (r *WorkRepository) GetWorkByName(name string, userId int) (*entities.Work, error) {
+	m := data_models.WorkModel{}
+
+	err := r.Database.Get(&m, `
+    SELECT *
+    FROM works
+    WHERE LOWER(name) = LOWER(?) AND user_id = ?
+  `, name, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, WorkNotFoundError
+		}
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+
+	work := m.ToWork()
+
+	return &work, nil
+}
+
+// GetWorkByNameOrCreate match the work by its MusicBrainz id when known so
+// works sharing a title like "Symphony No. 1" stay apart, otherwise by its
+// name.
+func (r WorkRepository) GetWorkByNameOrCreate(
+	name string,
+	musicBrainzWorkId string,
+	userId int,
+) (*entities.Work, error) {
+	r.getWorkOrCreateMutex.Lock()
+	defer r.getWorkOrCreateMutex.Unlock()
+
+	var work *entities.Work
+	err := WorkNotFoundError
+
+	if musicBrainzWorkId != "" {
+		work, err = r.GetWorkByMusicBrainzId(musicBrainzWorkId, userId)
+	}
+
+	if err != nil && errors.Is(err, WorkNotFoundError) {
+		work, err = r.GetWorkByName(name, userId)
+
+		if err == nil && work.MusicBrainzWorkId != "" && musicBrainzWorkId != "" &&
+			work.MusicBrainzWorkId != musicBrainzWorkId {
+			err = WorkNotFoundError
+		}
+
+		if err == nil && work.MusicBrainzWorkId == "" && musicBrainzWorkId != "" {
+			work.MusicBrainzWorkId = musicBrainzWorkId
+
+			err = r.UpdateWork(work)
+		}
+	}
+
+	if err != nil && errors.Is(err, WorkNotFoundError) {
+		work = &entities.Work{
+			UserId: &userId,
+			Name:   name,
+
+			MusicBrainzWorkId: musicBrainzWorkId,
+		}
+
+		err = r.CreateWork(work)
+	}
+
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, entities.NewInternalError(err)
+	}
+
+	return work, nil
+}
+
+func (r *WorkRepository) LoadStatsInWork(work *entities.Work) error {
+	works := []entities.Work{*work}
+
+	err := r.LoadStatsInWorks(works)
+	if err != nil {
+		return err
+	}
+
+	*work = works[0]
+
+	return nil
+}
+
+// LoadStatsInWorks fill the composers and the track count of the works.
+func (r *WorkRepository) LoadStatsInWorks(works []entities.Work) error {
+	if len(works) == 0 {
+		return nil
+	}
+
+	workIds := make([]int, len(works))
+
+	for i, work := range works {
+		workIds[i] = work.Id
+	}
+
+	query, args, err := sqlx.In(`
+    SELECT track_work.work_id, COUNT(DISTINCT tracks.id) AS count
+    FROM track_work
+    JOIN tracks ON tracks.id = track_work.track_id
+    WHERE track_work.work_id IN (?) AND tracks.pending_import = 0
+    GROUP BY track_work.work_id
+  `, workIds)
+	if err != nil {
+		return err
+	}
+	query = r.Database.Rebind(query)
+
+	counts := []data_models.WorkCountModel{}
+
+	err = r.Database.Select(&counts, query, args...)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	query, args, err = sqlx.In(`
+    SELECT track_work.work_id, artists.*
+    FROM track_work
+    JOIN track_artist_role ON track_artist_role.track_id = track_work.track_id
+    JOIN artists ON artists.id = track_artist_role.artist_id
+    WHERE track_work.work_id IN (?) AND track_artist_role.role = ?
+    GROUP BY track_work.work_id, artists.id
+    ORDER BY MIN(track_artist_role.role_pos), artists.name COLLATE NOCASE
+  `, workIds, entities.TrackArtistRoleComposer)
+	if err != nil {
+		return err
+	}
+	query = r.Database.Rebind(query)
+
+	composers := []data_models.WorkComposerModel{}
+
+	err = r.Database.Select(&composers, query, args...)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	tracksByWork := map[int]int{}
+
+	for _, count := range counts {
+		tracksByWork[count.WorkId] = count.Count
+	}
+
+	composersByWork := map[int][]entities.Artist{}
+
+	for _, composer := range composers {
+		composersByWork[composer.WorkId] = append(
+			composersByWork[composer.WorkId],
+			composer.ToArtist(),
+		)
+	}
+
+	for i := range works {
+		works[i].TrackCount = tracksByWork[works[i].Id]
+		works[i].Composers = composersByWork[works[i].Id]
+
+		if works[i].Composers == nil {
+			works[i].Composers = []entities.Artist{}
+		}
+	}
+
+	return nil
+}
+
+// LoadTracksInWork load the recorded movements of the work in their order.
+func (r *WorkRepository) LoadTracksInWork(work *entities.Work) error {
+	m := data_models.TracksModels{}
+
+	err := r.Database.Select(&m, `
+    SELECT tracks.*
+    FROM tracks
+    JOIN track_work ON track_work.track_id = tracks.id
+    WHERE track_work.work_id = ? AND tracks.pending_import = 0
+    ORDER BY
+      tracks.metadata_movement_number,
+      tracks.metadata_disc_number,
+      tracks.metadata_track_number,
+      tracks.id
+  `, work.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	work.Tracks = m.ToTracks()
+
+	err = r.trackRepository.LoadAlbumsInTracks(work.Tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.trackRepository.LoadArtistsInTracks(work.Tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.trackRepository.LoadGenresInTracks(work.Tracks)
+	if err != nil {
+		return err
+	}
+
+	err = r.trackRepository.LoadWorkInTracks(work.Tracks)
+	if err != nil {
+		return err
+	}
+
+	return r.trackRepository.LoadRoleArtistsInTracks(work.Tracks)
+}
+
+func (r *WorkRepository) CreateWork(work *entities.Work) error {
+	m := data_models.WorkModel{}
+
+	err := r.Database.Get(
+		&m,
+		`
+    INSERT INTO works
+      (
+        user_id,
+
+        name,
+
+        music_brainz_work_id
+      )
+    VALUES
+      (
+        ?,
+        ?,
+        ?
+      )
+    RETURNING *
+  `,
+		work.UserId,
+
+		work.Name,
+
+		work.MusicBrainzWorkId,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	*work = m.ToWork()
+
+	return nil
+}
+
+func (r *WorkRepository) UpdateWork(work *entities.Work) error {
+	m := data_models.WorkModel{}
+
+	err := r.Database.Get(
+		&m,
+		`
+    UPDATE works
+    SET
+      user_id = ?,
+
+      name = ?,
+
+      music_brainz_work_id = ?,
+
+      updated_at = STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW')
+    WHERE
+      id = ?
+    RETURNING *
+  `,
+		work.UserId,
+
+		work.Name,
+
+		work.MusicBrainzWorkId,
+
+		work.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return err
+	}
+
+	composers := work.Composers
+	trackCount := work.TrackCount
+
+	*work = m.ToWork()
+
+	work.Composers = composers
+	work.TrackCount = trackCount
+
+	return nil
+}
+
+// DeleteWorkIfUnused delete the work once no track is linked to it anymore.
+func (r *WorkRepository) DeleteWorkIfUnused(work *entities.Work) (bool, error) {
+	m := data_models.WorkModels{}
+
+	tx, err := r.Database.Beginx()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Select(&m, `
+    DELETE FROM
+      works
+    WHERE
+      id = ? AND NOT EXISTS (
+        SELECT 1
+        FROM track_work
+        JOIN tracks ON tracks.id = track_work.track_id
+        WHERE track_work.work_id = works.id
+      )
+    RETURNING *
+  `,
+		work.Id,
+	)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if len(m) == 0 {
+		_ = tx.Rollback()
+		return false, nil
+	}
+
+	_, err = tx.Exec("DELETE FROM track_work WHERE work_id = ?", work.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	_, err = tx.Exec("INSERT INTO deleted_works (id) VALUES (?)", work.Id)
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	*work = m[0].ToWork()
+
+	return true, nil
+}
+
+func (r *WorkRepository) GetAllDeletedWorksSince(since time.Time) ([]int, error) {
+	rows, err := r.Database.Query(`
+    SELECT id FROM deleted_works WHERE deleted_at >= datetime(?)
+  `, since.UTC())
+	if err != nil {
+		logger.DatabaseLogger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []int{}
+
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
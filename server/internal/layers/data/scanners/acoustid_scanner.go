@@ -72,9 +72,7 @@ type AcoustIdResponse struct {
 
 var AcoustIdNotFoundError = errors.New("AcoustId is not found")
 
-func (s *AcoustIdScanner) ScanAcoustIdFingerprint(path string) (string, error) {
-	var inputSamples io.Reader
-
+func decodeFingerprintSamples(path string) ([]byte, error) {
 	ffmpeg := exec.Command("ffmpeg", "-v", "quiet",
 		"-i", path, "-f", "s16le", "-ac", "1", "-c:a", "pcm_s16le", "-ar", "44100", "pipe:1")
 	var err error
@@ -84,27 +82,35 @@ func (s *AcoustIdScanner) ScanAcoustIdFingerprint(path string) (string, error) {
 		logger.ScannerLogger.Errorf(
 			"Unable to spin up ffmpeg to decode %v", err,
 		)
-		return "", err
+		return nil, err
 	}
 	err = ffmpeg.Start()
 	if err != nil {
 		logger.ScannerLogger.Errorf(
 			"Unable to spin up ffmpeg to decode %v", err,
 		)
-		return "", err
+		return nil, err
 	}
 
 	alldata, _ := io.ReadAll(ffmpegOut)
-	inputSamples = bytes.NewReader(alldata)
 
 	defer ffmpeg.Process.Wait()
 	defer ffmpeg.Process.Kill()
 
+	return alldata, nil
+}
+
+func (s *AcoustIdScanner) ScanAcoustIdFingerprint(path string) (string, error) {
+	samples, err := decodeFingerprintSamples(path)
+	if err != nil {
+		return "", err
+	}
+
 	fpcalc := gochroma.New(gochroma.AlgorithmDefault)
 	defer fpcalc.Close()
 
 	fpoptions := fingerprint.RawInfo{
-		Src:        inputSamples,
+		Src:        bytes.NewReader(samples),
 		Channels:   1,
 		Rate:       44100,
 		MaxSeconds: uint((2 * time.Minute).Seconds()),
@@ -121,6 +127,35 @@ func (s *AcoustIdScanner) ScanAcoustIdFingerprint(path string) (string, error) {
 	return acoustIdFingerprint, nil
 }
 
+// ScanAcoustIdRawFingerprint compute the uncompressed chromaprint fingerprint
+// which can be compared locally with other fingerprints.
+func (s *AcoustIdScanner) ScanAcoustIdRawFingerprint(path string) ([]int32, error) {
+	samples, err := decodeFingerprintSamples(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fpcalc := gochroma.New(gochroma.AlgorithmDefault)
+	defer fpcalc.Close()
+
+	fpoptions := fingerprint.RawInfo{
+		Src:        bytes.NewReader(samples),
+		Channels:   1,
+		Rate:       44100,
+		MaxSeconds: uint((2 * time.Minute).Seconds()),
+	}
+
+	rawFingerprint, err := fpcalc.RawFingerprint(fpoptions)
+	if err != nil {
+		logger.ScannerLogger.Errorf(
+			"Unable to fingerprint %v", err,
+		)
+		return nil, err
+	}
+
+	return rawFingerprint, nil
+}
+
 func (s *AcoustIdScanner) ScanAcoustId(path string) (AcoustIdResponse, error) {
 	acoustIdFingerprint, err := s.ScanAcoustIdFingerprint(path)
 	if err != nil {
@@ -0,0 +1,81 @@
+package scanners
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os/exec"
+	"strconv"
+
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/pkgs/audioanalysis"
+)
+
+func NewAudioAnalysisScanner() AudioAnalysisScanner {
+	return AudioAnalysisScanner{}
+}
+
+// AudioAnalysisScanner estimate tempo, key and energy from the PCM decoded
+// by FFmpeg.
+type AudioAnalysisScanner struct{}
+
+var AudioAnalysisDecodeError = errors.New("FFmpeg failed to decode audio for analysis")
+
+// Only the first minutes are analyzed so long mixes don't use too much memory
+const audioAnalysisMaxDuration = 10 * 60
+
+func (s *AudioAnalysisScanner) ScanAudioAnalysis(path string) (audioanalysis.Result, error) {
+	cmd := exec.Command("ffmpeg",
+		"-v", "error",
+		"-t", strconv.Itoa(audioAnalysisMaxDuration),
+		"-i", path,
+		"-map", "0:a:0",
+		"-ac", "1",
+		"-ar", strconv.Itoa(audioanalysis.SampleRate),
+		"-f", "f32le",
+		"-c:a", "pcm_f32le",
+		"pipe:1",
+	)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return audioanalysis.Result{}, err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		logger.ScannerLogger.Errorf("Unable to spin up ffmpeg to decode %v", err)
+		return audioanalysis.Result{}, err
+	}
+
+	samples := make([]float32, 0, audioanalysis.SampleRate*60*5)
+
+	reader := bufio.NewReader(stdout)
+	sample := make([]byte, 4)
+
+	for {
+		if _, err := io.ReadFull(reader, sample); err != nil {
+			break
+		}
+
+		samples = append(samples, math.Float32frombits(binary.LittleEndian.Uint32(sample)))
+	}
+
+	if err := cmd.Wait(); err != nil {
+		logger.ScannerLogger.Errorf(
+			"Unable to decode %s for analysis : %v %s",
+			path,
+			err,
+			stderr.String(),
+		)
+		return audioanalysis.Result{}, fmt.Errorf("%w: %w", AudioAnalysisDecodeError, err)
+	}
+
+	return audioanalysis.Analyze(samples)
+}
@@ -0,0 +1,98 @@
+package scanners
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+var coverArtArchiveMainMutex = sync.Mutex{}
+
+func NewCoverArtArchiveScanner() CoverArtArchiveScanner {
+	client := resty.New()
+
+	client.
+		SetBaseURL(
+			strings.TrimRight(
+				helpers.GetEnvString("COVER_ART_ARCHIVE_URL", "https://coverartarchive.org"),
+				"/",
+			),
+		).
+		SetHeader("User-Agent", "melodink (https://github.com/gungun974/Melodink)").
+		SetRetryCount(3).
+		SetRetryWaitTime(3 * time.Second).
+		SetRetryMaxWaitTime(30 * time.Second).
+		AddRetryCondition(
+			func(r *resty.Response, err error) bool {
+				return r.StatusCode() >= 500 || err != nil
+			})
+
+	return CoverArtArchiveScanner{
+		client: client,
+	}
+}
+
+// CoverArtArchiveScanner download release artworks from the Cover Art
+// Archive.
+type CoverArtArchiveScanner struct {
+	client *resty.Client
+}
+
+var (
+	CoverArtArchiveNotFoundError = errors.New("Cover Art Archive front cover is not found")
+	CoverArtArchiveQueryError    = errors.New("Failed to query the Cover Art Archive")
+)
+
+// FetchReleaseFrontCover download the front cover of a MusicBrainz release.
+func (s *CoverArtArchiveScanner) FetchReleaseFrontCover(releaseId string) (*bytes.Buffer, error) {
+	if helpers.IsEmptyOrWhitespace(releaseId) {
+		return nil, CoverArtArchiveNotFoundError
+	}
+
+	coverArtArchiveMainMutex.Lock()
+
+	logger.ScannerLogger.Infof("Perform a cover art archive lookup for release %s", releaseId)
+
+	resp, err := s.client.R().
+		SetPathParam("releaseId", strings.TrimSpace(releaseId)).
+		Get("/release/{releaseId}/front")
+
+	time.Sleep(time.Millisecond * 1100)
+	coverArtArchiveMainMutex.Unlock()
+
+	if err != nil {
+		logger.ScannerLogger.Errorf(
+			"Failed to perform a cover art archive lookup for release %s, %v",
+			releaseId,
+			err,
+		)
+		return nil, fmt.Errorf("%w: %w", CoverArtArchiveQueryError, err)
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, CoverArtArchiveNotFoundError
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		logger.ScannerLogger.Errorf("Unable to get cover art archive response %v", resp.Status())
+		return nil, CoverArtArchiveQueryError
+	}
+
+	if !strings.HasPrefix(resp.Header().Get("Content-Type"), "image/") {
+		logger.ScannerLogger.Errorf(
+			"Cover art archive returned a non image content for release %s",
+			releaseId,
+		)
+		return nil, CoverArtArchiveQueryError
+	}
+
+	return bytes.NewBuffer(resp.Body()), nil
+}
@@ -0,0 +1,129 @@
+package scanners
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+func NewLoudnessScanner() LoudnessScanner {
+	return LoudnessScanner{}
+}
+
+// LoudnessScanner measure EBU R128 loudness with the FFmpeg ebur128 filter.
+type LoudnessScanner struct{}
+
+type LoudnessScanResult struct {
+	Integrated *float64
+	TruePeak   *float64
+	Range      *float64
+}
+
+var LoudnessScanError = errors.New("FFmpeg failed to measure loudness")
+
+var (
+	loudnessIntegratedRegex = regexp.MustCompile(`I:\s+(-?[0-9.]+|-?inf)\s+LUFS`)
+	loudnessRangeRegex      = regexp.MustCompile(`LRA:\s+(-?[0-9.]+|-?inf)\s+LU`)
+	loudnessTruePeakRegex   = regexp.MustCompile(`Peak:\s+(-?[0-9.]+|-?inf)\s+dBFS`)
+)
+
+// ScanLoudness measure the loudness of the given audio files played one after
+// the other, giving more than one path measure a whole album.
+func (s *LoudnessScanner) ScanLoudness(paths ...string) (LoudnessScanResult, error) {
+	if len(paths) == 0 {
+		return LoudnessScanResult{}, LoudnessScanError
+	}
+
+	args := []string{
+		"-hide_banner",
+		"-nostats",
+	}
+
+	for _, path := range paths {
+		args = append(args, "-i", path)
+	}
+
+	var filter strings.Builder
+
+	if len(paths) > 1 {
+		// Concat need every input with the same sample rate and channel layout,
+		// the tracks of an album don't always agree on them
+		for i := range paths {
+			fmt.Fprintf(
+				&filter,
+				"[%d:a:0]aresample=48000,aformat=sample_fmts=fltp:channel_layouts=stereo[a%d];",
+				i,
+				i,
+			)
+		}
+
+		for i := range paths {
+			fmt.Fprintf(&filter, "[a%d]", i)
+		}
+
+		fmt.Fprintf(&filter, "concat=n=%d:v=0:a=1,", len(paths))
+	} else {
+		filter.WriteString("[0:a:0]")
+	}
+
+	// Frame logs are moved to verbose or they flood stderr at info level
+	filter.WriteString("ebur128=peak=true:framelog=verbose")
+
+	args = append(args,
+		"-filter_complex", filter.String(),
+		"-f", "null",
+		"-",
+	)
+
+	cmd := exec.Command("ffmpeg", args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		logger.ScannerLogger.Errorf(
+			"Unable to measure loudness of %v : %v %s",
+			paths,
+			err,
+			stderr.String(),
+		)
+		return LoudnessScanResult{}, fmt.Errorf("%w: %w", LoudnessScanError, err)
+	}
+
+	output := stderr.String()
+
+	index := strings.LastIndex(output, "Summary:")
+	if index == -1 {
+		logger.ScannerLogger.Errorf("No loudness summary found for %v", paths)
+		return LoudnessScanResult{}, LoudnessScanError
+	}
+
+	summary := output[index:]
+
+	return LoudnessScanResult{
+		Integrated: parseLoudnessValue(loudnessIntegratedRegex, summary),
+		TruePeak:   parseLoudnessValue(loudnessTruePeakRegex, summary),
+		Range:      parseLoudnessValue(loudnessRangeRegex, summary),
+	}, nil
+}
+
+func parseLoudnessValue(regex *regexp.Regexp, summary string) *float64 {
+	match := regex.FindStringSubmatch(summary)
+	if match == nil {
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil || math.IsInf(value, 0) || math.IsNaN(value) {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,224 @@
+package scanners
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+var lyricsMainMutex = sync.Mutex{}
+
+const (
+	lyricsRequestDelay = time.Millisecond * 500
+
+	// A provider result is only trusted when its duration is close enough
+	lyricsMaxDurationDelta = 2.0
+)
+
+func NewLyricsScanner() LyricsScanner {
+	client := resty.New()
+
+	client.
+		SetBaseURL(
+			strings.TrimRight(helpers.GetEnvString("LYRICS_PROVIDER_URL", "https://lrclib.net"), "/"),
+		).
+		SetHeader("User-Agent", "melodink (https://github.com/gungun974/Melodink)").
+		SetRetryCount(3).
+		SetRetryWaitTime(3 * time.Second).
+		SetRetryMaxWaitTime(30 * time.Second).
+		AddRetryCondition(
+			func(r *resty.Response, err error) bool {
+				return r.StatusCode() >= 500 || err != nil
+			})
+
+	return LyricsScanner{
+		client: client,
+	}
+}
+
+// LyricsScanner search lyrics on an LRCLIB compatible API.
+type LyricsScanner struct {
+	client *resty.Client
+}
+
+type LyricsQuery struct {
+	Title  string
+	Artist string
+	Album  string
+
+	// Duration is in milliseconds, 0 when unknown
+	Duration int
+}
+
+type LyricsScanResult struct {
+	Instrumental bool
+
+	PlainLyrics string
+
+	// SyncedLyrics is in the LRC format
+	SyncedLyrics string
+}
+
+type lyricsProviderRecord struct {
+	Id           int     `json:"id"`
+	TrackName    string  `json:"trackName"`
+	ArtistName   string  `json:"artistName"`
+	AlbumName    string  `json:"albumName"`
+	Duration     float64 `json:"duration"`
+	Instrumental bool    `json:"instrumental"`
+	PlainLyrics  *string `json:"plainLyrics"`
+	SyncedLyrics *string `json:"syncedLyrics"`
+}
+
+func (r lyricsProviderRecord) hasSyncedLyrics() bool {
+	return r.SyncedLyrics != nil && !helpers.IsEmptyOrWhitespace(*r.SyncedLyrics)
+}
+
+func (r lyricsProviderRecord) toScanResult() LyricsScanResult {
+	result := LyricsScanResult{
+		Instrumental: r.Instrumental,
+	}
+
+	if r.PlainLyrics != nil {
+		result.PlainLyrics = *r.PlainLyrics
+	}
+
+	if r.SyncedLyrics != nil {
+		result.SyncedLyrics = *r.SyncedLyrics
+	}
+
+	return result
+}
+
+var (
+	LyricsNotFoundError      = errors.New("Lyrics are not found")
+	LyricsProviderQueryError = errors.New("Failed to query the lyrics provider")
+)
+
+// FetchLyrics look for the exact track first and fall back to a search when
+// it doesn't have synced lyrics, synced lyrics are always preferred.
+func (s *LyricsScanner) FetchLyrics(query LyricsQuery) (LyricsScanResult, error) {
+	if helpers.IsEmptyOrWhitespace(query.Title) {
+		return LyricsScanResult{}, LyricsNotFoundError
+	}
+
+	params := map[string]string{
+		"track_name":  query.Title,
+		"artist_name": query.Artist,
+		"album_name":  query.Album,
+	}
+
+	if query.Duration > 0 {
+		params["duration"] = strconv.Itoa(int(math.Round(float64(query.Duration) / 1000)))
+	}
+
+	var exact *lyricsProviderRecord
+
+	if !helpers.IsEmptyOrWhitespace(query.Artist) {
+		record := lyricsProviderRecord{}
+
+		found, err := s.request("/api/get", params, &record)
+		if err != nil {
+			return LyricsScanResult{}, err
+		}
+
+		if found {
+			if record.Instrumental || record.hasSyncedLyrics() {
+				return record.toScanResult(), nil
+			}
+
+			exact = &record
+		}
+	}
+
+	delete(params, "duration")
+
+	records := []lyricsProviderRecord{}
+
+	if _, err := s.request("/api/search", params, &records); err != nil {
+		if exact != nil {
+			return exact.toScanResult(), nil
+		}
+		return LyricsScanResult{}, err
+	}
+
+	var best *lyricsProviderRecord
+
+	for i := range records {
+		record := &records[i]
+
+		if query.Duration > 0 &&
+			math.Abs(record.Duration-float64(query.Duration)/1000) > lyricsMaxDurationDelta {
+			continue
+		}
+
+		if !record.Instrumental &&
+			(record.PlainLyrics == nil || helpers.IsEmptyOrWhitespace(*record.PlainLyrics)) &&
+			!record.hasSyncedLyrics() {
+			continue
+		}
+
+		if best == nil || (record.hasSyncedLyrics() && !best.hasSyncedLyrics()) {
+			best = record
+		}
+	}
+
+	switch {
+	case best != nil && (best.hasSyncedLyrics() || exact == nil):
+		return best.toScanResult(), nil
+	case exact != nil:
+		return exact.toScanResult(), nil
+	}
+
+	return LyricsScanResult{}, LyricsNotFoundError
+}
+
+// request perform a rate limited GET on the provider, it return false when
+// the provider answer it has nothing.
+func (s *LyricsScanner) request(path string, params map[string]string, result any) (bool, error) {
+	lyricsMainMutex.Lock()
+
+	logger.ScannerLogger.Infof(
+		"Perform a lyrics lookup on %s for %s - %s",
+		path,
+		params["artist_name"],
+		params["track_name"],
+	)
+
+	resp, err := s.client.R().
+		SetQueryParams(params).
+		Get(path)
+
+	time.Sleep(lyricsRequestDelay)
+	lyricsMainMutex.Unlock()
+
+	if err != nil {
+		logger.ScannerLogger.Errorf("Failed to perform a lyrics lookup %v", err)
+		return false, errors.Join(LyricsProviderQueryError, err)
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		logger.ScannerLogger.Errorf("Unable to get lyrics provider response %v", resp)
+		return false, LyricsProviderQueryError
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		logger.ScannerLogger.Errorf("Failed to parse lyrics provider response %v", err)
+		return false, errors.Join(LyricsProviderQueryError, err)
+	}
+
+	return true, nil
+}
@@ -2,6 +2,8 @@ package scanners
 
 import (
 	"errors"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -39,12 +41,32 @@ type MusicBrainzScanResult struct {
 	MusicBrainzTrackId     string
 	MusicBrainzRecordingId string
 
+	ReleaseTypes  []string
+	OriginalDate  string
+	Label         string
+	CatalogNumber string
+	Barcode       string
+
+	MusicBrainzReleaseGroupId string
+
 	Artists      []string
 	AlbumArtists []string
 
+	MusicBrainzArtistIds      []string
+	MusicBrainzAlbumArtistIds []string
+
+	// MusicBrainzArtists describe every credited artist of the track and the
+	// release
+	MusicBrainzArtists []entities.MusicBrainzArtist
+
 	ArtistsRoles []entities.TrackArtistRole
 
 	Composer string
+
+	Work         string
+	MovementName string
+
+	MusicBrainzWorkId string
 }
 
 func (s *MusicBrainzScanner) FetchRecordingInfoFromAlbumName(
@@ -95,7 +117,10 @@ func (s *MusicBrainzScanner) FetchRecordingInfoFromRelease(
 			"media",
 			"recordings",
 			"artist-credits",
+			"aliases",
 			"genres",
+			"labels",
+			"release-groups",
 		},
 	}
 
@@ -155,15 +180,83 @@ outerloop:
 	}
 
 	artists := []string{}
+	artistIds := []string{}
 
 	for _, artist := range track.Recording.ArtistCredit {
 		artists = append(artists, artist.Name)
+		artistIds = append(artistIds, string(artist.Artist.ID))
 	}
 
 	albumArtists := []string{}
+	albumArtistIds := []string{}
 
 	for _, artist := range release.ArtistCredit {
 		albumArtists = append(albumArtists, artist.Name)
+		albumArtistIds = append(albumArtistIds, string(artist.Artist.ID))
+	}
+
+	musicBrainzArtists := []entities.MusicBrainzArtist{}
+
+	seenArtists := map[musicbrainzws2.MBID]bool{}
+
+	for _, credits := range []musicbrainzws2.ArtistCredit{
+		track.Recording.ArtistCredit,
+		release.ArtistCredit,
+	} {
+		for _, credit := range credits {
+			if credit.Artist.ID == "" || seenArtists[credit.Artist.ID] {
+				continue
+			}
+
+			seenArtists[credit.Artist.ID] = true
+
+			aliases := make([]string, 0, len(credit.Artist.Aliases))
+
+			for _, alias := range credit.Artist.Aliases {
+				aliases = append(aliases, alias.Name)
+			}
+
+			musicBrainzArtists = append(musicBrainzArtists, entities.MusicBrainzArtist{
+				Id: string(credit.Artist.ID),
+
+				Name:           credit.Artist.Name,
+				SortName:       credit.Artist.SortName,
+				Disambiguation: credit.Artist.Disambiguation,
+
+				Aliases: aliases,
+			})
+		}
+	}
+
+	releaseTypes := []string{}
+	originalDate := ""
+	releaseGroupId := ""
+
+	if release.ReleaseGroup != nil {
+		if release.ReleaseGroup.PrimaryType != "" {
+			releaseTypes = append(releaseTypes, release.ReleaseGroup.PrimaryType)
+		}
+
+		releaseTypes = append(releaseTypes, release.ReleaseGroup.SecondaryTypes...)
+
+		if release.ReleaseGroup.FirstReleaseDate.Year != 0 {
+			originalDate = release.ReleaseGroup.FirstReleaseDate.String()
+		}
+
+		releaseGroupId = string(release.ReleaseGroup.ID)
+	}
+
+	label := ""
+	catalogNumber := ""
+
+	for _, labelInfo := range release.LabelInfo {
+		if label == "" && labelInfo.Label != nil {
+			label = labelInfo.Label.Name
+		}
+
+		if catalogNumber == "" {
+			catalogNumber = labelInfo.CatalogNumber
+		}
 	}
 
 	genres := []string{}
@@ -224,6 +317,8 @@ outerloop:
 			artistsRoles = append(artistsRoles, entities.TrackArtistRole{
 				Type: string(relation.TypeID),
 
+				Role: getMusicBrainzArtistRole(relation),
+
 				Artist: relation.Artist.Name,
 
 				Attributes: attributes,
@@ -231,6 +326,23 @@ outerloop:
 		}
 	}
 
+	workResult := musicBrainzWorkResult{}
+
+	for _, relation := range recordingWithRelation.Relations {
+		if relation.Work != nil && relation.Type == "performance" {
+			workResult = s.fetchWorkInfo(client, relation.Work.ID)
+			break
+		}
+	}
+
+	artistsRoles = append(artistsRoles, workResult.ComposerRoles...)
+
+	composers := make([]string, len(workResult.ComposerRoles))
+
+	for i, role := range workResult.ComposerRoles {
+		composers[i] = role.Artist
+	}
+
 	return MusicBrainzScanResult{
 		Title: track.Title,
 
@@ -251,8 +363,122 @@ outerloop:
 		MusicBrainzTrackId:     string(track.ID),
 		MusicBrainzRecordingId: string(track.Recording.ID),
 
+		ReleaseTypes:  releaseTypes,
+		OriginalDate:  originalDate,
+		Label:         label,
+		CatalogNumber: catalogNumber,
+		Barcode:       release.Barcode,
+
+		MusicBrainzReleaseGroupId: releaseGroupId,
+
 		Artists:      artists,
 		AlbumArtists: albumArtists,
+
+		MusicBrainzArtistIds:      artistIds,
+		MusicBrainzAlbumArtistIds: albumArtistIds,
+
+		MusicBrainzArtists: musicBrainzArtists,
+
 		ArtistsRoles: artistsRoles,
+
+		Composer: strings.Join(composers, "; "),
+
+		Work:         workResult.Work,
+		MovementName: workResult.MovementName,
+
+		MusicBrainzWorkId: workResult.MusicBrainzWorkId,
 	}, nil
 }
+
+// getMusicBrainzArtistRole tell which recording relations are shown to users
+// as classical music roles.
+func getMusicBrainzArtistRole(relation musicbrainzws2.Relationship) string {
+	switch relation.Type {
+	case "conductor":
+		return entities.TrackArtistRoleConductor
+	case "performing orchestra":
+		return entities.TrackArtistRoleOrchestra
+	case "instrument", "vocal":
+		if slices.Contains(relation.Attributes, "solo") {
+			return entities.TrackArtistRoleSoloist
+		}
+	}
+
+	return ""
+}
+
+type musicBrainzWorkResult struct {
+	Work         string
+	MovementName string
+
+	MusicBrainzWorkId string
+
+	ComposerRoles []entities.TrackArtistRole
+}
+
+// fetchWorkInfo lookup the work performed by the recording. A movement is a
+// part of its parent work and its title is prefixed by the parent one like
+// "Symphony No. 5 in C minor, op. 67: I. Allegro con brio".
+func (s *MusicBrainzScanner) fetchWorkInfo(
+	client *musicbrainzws2.Client,
+	workId musicbrainzws2.MBID,
+) musicBrainzWorkResult {
+	workFilter := musicbrainzws2.IncludesFilter{
+		Includes: []string{
+			"artist-rels",
+			"work-rels",
+		},
+	}
+
+	musicBrainzMainMutex.Lock()
+
+	logger.ScannerLogger.Infof("Perform a musicbrainz lookup for work %s", workId)
+
+	work, err := client.LookupWork(workId, workFilter)
+
+	time.Sleep(time.Millisecond * 1100)
+	musicBrainzMainMutex.Unlock()
+
+	if err != nil {
+		logger.ScannerLogger.Errorf(
+			"Failed to perform a musicbrainz lookup for work %s, %v",
+			workId,
+			err,
+		)
+
+		return musicBrainzWorkResult{}
+	}
+
+	result := musicBrainzWorkResult{
+		Work: work.Title,
+
+		MusicBrainzWorkId: string(work.ID),
+
+		ComposerRoles: []entities.TrackArtistRole{},
+	}
+
+	for _, relation := range work.Relations {
+		if relation.Type == "parts" && relation.Work != nil &&
+			strings.HasPrefix(work.Title, relation.Work.Title+":") {
+			result.Work = relation.Work.Title
+			result.MovementName = strings.TrimSpace(
+				strings.TrimPrefix(work.Title, relation.Work.Title+":"),
+			)
+			result.MusicBrainzWorkId = string(relation.Work.ID)
+		}
+
+		if relation.Type == "composer" && relation.Artist != nil {
+			result.ComposerRoles = append(result.ComposerRoles, entities.TrackArtistRole{
+				Type: string(relation.TypeID),
+
+				Role: entities.TrackArtistRoleComposer,
+
+				Artist: relation.Artist.Name,
+
+				Attributes: []string{},
+			})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,105 @@
+package scanners
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/pkgs/audioanalysis"
+)
+
+func NewSpectrumScanner() SpectrumScanner {
+	return SpectrumScanner{}
+}
+
+// SpectrumScanner look at the spectrum and the samples of the first channel
+// decoded by FFmpeg at the file own sample rate and bit depth.
+type SpectrumScanner struct{}
+
+type SpectrumScanResult struct {
+	Spectrum audioanalysis.SpectrumResult
+
+	EffectiveBitsPerSample int
+}
+
+var SpectrumDecodeError = errors.New("FFmpeg failed to decode audio for spectrum analysis")
+
+// Two minutes are enough to see the lowpass of an encoder
+const spectrumMaxDuration = 2 * 60
+
+// Samples decoded from the pipe at once
+const spectrumChunkSize = 16 * 1024
+
+func (s *SpectrumScanner) ScanSpectrum(path string, sampleRate int) (SpectrumScanResult, error) {
+	cmd := exec.Command("ffmpeg",
+		"-v", "error",
+		"-t", strconv.Itoa(spectrumMaxDuration),
+		"-i", path,
+		"-map", "0:a:0",
+		// A pure channel mapping keep the samples untouched unlike a downmix
+		"-af", "pan=mono|c0=c0",
+		"-f", "s32le",
+		"-c:a", "pcm_s32le",
+		"pipe:1",
+	)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return SpectrumScanResult{}, err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		logger.ScannerLogger.Errorf("Unable to spin up ffmpeg to decode %v", err)
+		return SpectrumScanResult{}, err
+	}
+
+	// Samples are analyzed while decoding, two minutes of a 192 kHz file
+	// would take almost 100 MB
+	analyzer := audioanalysis.NewSpectrumAnalyzer(sampleRate)
+
+	chunk := make([]byte, 4*spectrumChunkSize)
+	samples := make([]int32, spectrumChunkSize)
+
+	for {
+		n, err := io.ReadFull(stdout, chunk)
+
+		for i := range n / 4 {
+			samples[i] = int32(binary.LittleEndian.Uint32(chunk[i*4:]))
+		}
+
+		analyzer.Add(samples[:n/4])
+
+		if err != nil {
+			break
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		logger.ScannerLogger.Errorf(
+			"Unable to decode %s for spectrum analysis : %v %s",
+			path,
+			err,
+			stderr.String(),
+		)
+		return SpectrumScanResult{}, fmt.Errorf("%w: %w", SpectrumDecodeError, err)
+	}
+
+	spectrum, err := analyzer.Result()
+	if err != nil {
+		return SpectrumScanResult{}, err
+	}
+
+	return SpectrumScanResult{
+		Spectrum: spectrum,
+
+		EffectiveBitsPerSample: analyzer.EffectiveBitsPerSample(),
+	}, nil
+}
@@ -0,0 +1,189 @@
+package scrobblers
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+func NewLastFmScrobbler() LastFmScrobbler {
+	client := resty.New()
+
+	client.
+		SetHeader("User-Agent", scrobblerUserAgent).
+		SetTimeout(30 * time.Second)
+
+	return LastFmScrobbler{
+		client: client,
+		defaultBaseUrl: strings.TrimRight(
+			helpers.GetEnvString("LASTFM_URL", "https://ws.audioscrobbler.com/2.0"),
+			"/",
+		),
+	}
+}
+
+// LastFmScrobbler submit scrobbles to the Last.fm API or a compatible one
+// like Libre.fm (https://libre.fm/2.0).
+type LastFmScrobbler struct {
+	client *resty.Client
+
+	defaultBaseUrl string
+}
+
+type lastFmErrorResponse struct {
+	Error   int    `json:"error"`
+	Message string `json:"message"`
+}
+
+// Last.fm errors worth trying again, the other ones like an invalid session
+// key will fail the same way
+var lastFmTemporaryErrors = []int{
+	8,  // Operation failed
+	11, // Service Offline
+	16, // Temporarily unavailable
+	29, // Rate limit exceeded
+}
+
+// signLastFmParams compute the api_sig of the params as described in
+// https://www.last.fm/api/authspec
+func signLastFmParams(params map[string]string, apiSecret string) string {
+	keys := make([]string, 0, len(params))
+
+	for key := range params {
+		if key == "format" || key == "callback" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	var builder strings.Builder
+
+	for _, key := range keys {
+		builder.WriteString(key)
+		builder.WriteString(params[key])
+	}
+
+	builder.WriteString(apiSecret)
+
+	hash := md5.Sum([]byte(builder.String()))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func (s *LastFmScrobbler) call(
+	scrobbler entities.Scrobbler,
+	params map[string]string,
+) ([]byte, error) {
+	params["api_key"] = scrobbler.ApiKey
+	params["api_sig"] = signLastFmParams(params, scrobbler.ApiSecret)
+	params["format"] = "json"
+
+	resp, err := s.client.R().
+		SetFormData(params).
+		Post(getBaseUrl(scrobbler, s.defaultBaseUrl) + "/")
+	if err != nil {
+		return nil, getResponseError(resp, err)
+	}
+
+	result := lastFmErrorResponse{}
+
+	if json.Unmarshal(resp.Body(), &result) == nil && result.Error != 0 {
+		if slices.Contains(lastFmTemporaryErrors, result.Error) {
+			return nil, fmt.Errorf("%w: %d %s", ScrobbleQueryError, result.Error, result.Message)
+		}
+		return nil, fmt.Errorf("%w: %d %s", ScrobbleRejectedError, result.Error, result.Message)
+	}
+
+	if err := getResponseError(resp, nil); err != nil {
+		return nil, err
+	}
+
+	return resp.Body(), nil
+}
+
+// GetMobileSession exchange the user credentials for a session key, the
+// password is never stored.
+func (s *LastFmScrobbler) GetMobileSession(
+	scrobbler entities.Scrobbler,
+	username string,
+	password string,
+) (string, error) {
+	body, err := s.call(scrobbler, map[string]string{
+		"method":   "auth.getMobileSession",
+		"username": username,
+		"password": password,
+	})
+	if err != nil {
+		logger.ScrobblerLogger.Errorf("Failed to get a Last.fm session %v", err)
+		return "", err
+	}
+
+	result := struct {
+		Session struct {
+			Key string `json:"key"`
+		} `json:"session"`
+	}{}
+
+	if err := json.Unmarshal(body, &result); err != nil || result.Session.Key == "" {
+		logger.ScrobblerLogger.Errorf("Failed to parse Last.fm session response %v", err)
+		return "", ScrobbleQueryError
+	}
+
+	return result.Session.Key, nil
+}
+
+func (s *LastFmScrobbler) SubmitScrobble(
+	scrobbler entities.Scrobbler,
+	track entities.Track,
+	listenedAt time.Time,
+) error {
+	logger.ScrobblerLogger.Infof(
+		"Submit scrobble of %s - %s to Last.fm",
+		getTrackArtistName(track),
+		track.Title,
+	)
+
+	params := map[string]string{
+		"method":    "track.scrobble",
+		"sk":        scrobbler.SessionKey,
+		"artist":    getTrackArtistName(track),
+		"track":     track.Title,
+		"timestamp": strconv.FormatInt(listenedAt.Unix(), 10),
+	}
+
+	if album := getTrackAlbumName(track); album != "" {
+		params["album"] = album
+	}
+
+	if len(track.Metadata.AlbumArtists) != 0 {
+		params["albumArtist"] = strings.Join(track.Metadata.AlbumArtists, ", ")
+	}
+
+	if track.Metadata.TrackNumber > 0 {
+		params["trackNumber"] = strconv.Itoa(track.Metadata.TrackNumber)
+	}
+
+	if track.Duration > 0 {
+		params["duration"] = strconv.Itoa(track.Duration / 1000)
+	}
+
+	if track.Metadata.MusicBrainzRecordingId != "" {
+		params["mbid"] = track.Metadata.MusicBrainzRecordingId
+	}
+
+	_, err := s.call(scrobbler, params)
+
+	return err
+}
@@ -0,0 +1,167 @@
+package scrobblers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+func TestSignLastFmParams(t *testing.T) {
+	params := map[string]string{
+		"method":  "track.scrobble",
+		"sk":      "session",
+		"api_key": "key",
+		// format and callback are never signed
+		"format":   "json",
+		"callback": "fn",
+	}
+
+	// md5("api_keykeymethodtrack.scrobblesksessionsecret")
+	expected := "258e32db13d7112c91bf57a0b025de31"
+
+	if sig := signLastFmParams(params, "secret"); sig != expected {
+		t.Fatalf("expected %s, got %s", expected, sig)
+	}
+}
+
+func TestSubmitScrobble(t *testing.T) {
+	track := entities.Track{
+		Title:    "Title",
+		Duration: 215500,
+		Metadata: entities.TrackMetadata{
+			Album:                  "Album",
+			TrackNumber:            3,
+			Artists:                []string{"Artist A", "Artist B"},
+			AlbumArtists:           []string{"Album Artist"},
+			MusicBrainzRecordingId: "recording-id",
+		},
+	}
+
+	var form url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("invalid form : %v", err)
+		}
+		form = r.PostForm
+
+		_, _ = w.Write([]byte(`{"scrobbles":{"@attr":{"accepted":1,"ignored":0}}}`))
+	}))
+	defer server.Close()
+
+	scrobbler := LastFmScrobbler{client: resty.New(), defaultBaseUrl: server.URL}
+
+	err := scrobbler.SubmitScrobble(
+		entities.Scrobbler{ApiKey: "key", ApiSecret: "secret", SessionKey: "session"},
+		track,
+		time.Unix(1700000000, 0),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	expected := map[string]string{
+		"method":      "track.scrobble",
+		"api_key":     "key",
+		"sk":          "session",
+		"artist":      "Artist A, Artist B",
+		"track":       "Title",
+		"timestamp":   "1700000000",
+		"album":       "Album",
+		"albumArtist": "Album Artist",
+		"trackNumber": "3",
+		"duration":    "215",
+		"mbid":        "recording-id",
+		"format":      "json",
+	}
+
+	for key, value := range expected {
+		if form.Get(key) != value {
+			t.Errorf("expected %s=%q, got %q", key, value, form.Get(key))
+		}
+	}
+
+	signed := map[string]string{}
+	for key := range form {
+		if key != "api_sig" {
+			signed[key] = form.Get(key)
+		}
+	}
+
+	if form.Get("api_sig") != signLastFmParams(signed, "secret") {
+		t.Errorf("api_sig doesn't match the sent params")
+	}
+}
+
+func TestSubmitScrobbleErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+
+		expected error
+	}{
+		{
+			name:     "invalid session key",
+			status:   http.StatusForbidden,
+			body:     `{"error":9,"message":"Invalid session key"}`,
+			expected: ScrobbleRejectedError,
+		},
+		{
+			name:     "service offline",
+			status:   http.StatusOK,
+			body:     `{"error":11,"message":"Service Offline"}`,
+			expected: ScrobbleQueryError,
+		},
+		{
+			name:     "rate limit exceeded",
+			status:   http.StatusTooManyRequests,
+			body:     `{"error":29,"message":"Rate limit exceeded"}`,
+			expected: ScrobbleQueryError,
+		},
+		{
+			name:     "server error without json",
+			status:   http.StatusInternalServerError,
+			body:     `Internal Server Error`,
+			expected: ScrobbleQueryError,
+		},
+		{
+			name:     "client error without json",
+			status:   http.StatusBadRequest,
+			body:     `Bad Request`,
+			expected: ScrobbleRejectedError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			scrobbler := LastFmScrobbler{client: resty.New(), defaultBaseUrl: server.URL}
+
+			err := scrobbler.SubmitScrobble(
+				entities.Scrobbler{ApiKey: "key", ApiSecret: "secret", SessionKey: "session"},
+				entities.Track{Title: "Title"},
+				time.Now(),
+			)
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package scrobblers
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+func NewListenBrainzScrobbler() ListenBrainzScrobbler {
+	client := resty.New()
+
+	client.
+		SetHeader("User-Agent", scrobblerUserAgent).
+		SetTimeout(30 * time.Second)
+
+	return ListenBrainzScrobbler{
+		client: client,
+		defaultBaseUrl: strings.TrimRight(
+			helpers.GetEnvString("LISTENBRAINZ_URL", "https://api.listenbrainz.org"),
+			"/",
+		),
+	}
+}
+
+// ListenBrainzScrobbler submit listens to a ListenBrainz compatible API.
+type ListenBrainzScrobbler struct {
+	client *resty.Client
+
+	defaultBaseUrl string
+}
+
+type ListenBrainzListen struct {
+	ListenedAt int64 `json:"listened_at,omitempty"`
+
+	TrackMetadata ListenBrainzTrackMetadata `json:"track_metadata"`
+}
+
+type ListenBrainzTrackMetadata struct {
+	ArtistName  string `json:"artist_name"`
+	TrackName   string `json:"track_name"`
+	ReleaseName string `json:"release_name,omitempty"`
+
+	AdditionalInfo ListenBrainzAdditionalInfo `json:"additional_info"`
+}
+
+type ListenBrainzAdditionalInfo struct {
+	RecordingMbid    string   `json:"recording_mbid,omitempty"`
+	ReleaseMbid      string   `json:"release_mbid,omitempty"`
+	ReleaseGroupMbid string   `json:"release_group_mbid,omitempty"`
+	TrackMbid        string   `json:"track_mbid,omitempty"`
+	ArtistMbids      []string `json:"artist_mbids,omitempty"`
+	WorkMbids        []string `json:"work_mbids,omitempty"`
+
+	TrackNumber int `json:"tracknumber,omitempty"`
+	DurationMs  int `json:"duration_ms,omitempty"`
+
+	MediaPlayer      string `json:"media_player,omitempty"`
+	SubmissionClient string `json:"submission_client,omitempty"`
+}
+
+type listenBrainzSubmission struct {
+	ListenType string               `json:"listen_type"`
+	Payload    []ListenBrainzListen `json:"payload"`
+}
+
+// NewListenBrainzListen build the listen of a track with every MusicBrainz
+// ids known by the track
+func NewListenBrainzListen(track entities.Track, listenedAt time.Time) ListenBrainzListen {
+	artistMbids := []string{}
+
+	for _, mbid := range track.Metadata.MusicBrainzArtistIds {
+		if strings.TrimSpace(mbid) != "" {
+			artistMbids = append(artistMbids, mbid)
+		}
+	}
+
+	workMbids := []string{}
+
+	if track.Metadata.MusicBrainzWorkId != "" {
+		workMbids = append(workMbids, track.Metadata.MusicBrainzWorkId)
+	}
+
+	return ListenBrainzListen{
+		ListenedAt: listenedAt.Unix(),
+
+		TrackMetadata: ListenBrainzTrackMetadata{
+			ArtistName:  getTrackArtistName(track),
+			TrackName:   track.Title,
+			ReleaseName: getTrackAlbumName(track),
+
+			AdditionalInfo: ListenBrainzAdditionalInfo{
+				RecordingMbid:    track.Metadata.MusicBrainzRecordingId,
+				ReleaseMbid:      track.Metadata.MusicBrainzReleaseId,
+				ReleaseGroupMbid: track.Metadata.MusicBrainzReleaseGroupId,
+				TrackMbid:        track.Metadata.MusicBrainzTrackId,
+				ArtistMbids:      artistMbids,
+				WorkMbids:        workMbids,
+
+				TrackNumber: track.Metadata.TrackNumber,
+				DurationMs:  track.Duration,
+
+				MediaPlayer:      "Melodink",
+				SubmissionClient: "Melodink",
+			},
+		},
+	}
+}
+
+// ValidateToken return a ScrobbleRejectedError when ListenBrainz doesn't know
+// the user token
+func (s *ListenBrainzScrobbler) ValidateToken(scrobbler entities.Scrobbler) error {
+	result := struct {
+		Valid bool `json:"valid"`
+	}{}
+
+	resp, err := s.client.R().
+		SetHeader("Authorization", "Token "+scrobbler.Token).
+		Get(getBaseUrl(scrobbler, s.defaultBaseUrl) + "/1/validate-token")
+	if err := getResponseError(resp, err); err != nil {
+		logger.ScrobblerLogger.Errorf("Failed to validate ListenBrainz token %v", err)
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		logger.ScrobblerLogger.Errorf("Failed to parse ListenBrainz response %v", err)
+		return ScrobbleQueryError
+	}
+
+	if !result.Valid {
+		return ScrobbleRejectedError
+	}
+
+	return nil
+}
+
+func (s *ListenBrainzScrobbler) SubmitListen(
+	scrobbler entities.Scrobbler,
+	track entities.Track,
+	listenedAt time.Time,
+) error {
+	logger.ScrobblerLogger.Infof(
+		"Submit listen of %s - %s to ListenBrainz",
+		getTrackArtistName(track),
+		track.Title,
+	)
+
+	resp, err := s.client.R().
+		SetHeader("Authorization", "Token "+scrobbler.Token).
+		SetHeader("Content-Type", "application/json").
+		SetBody(listenBrainzSubmission{
+			ListenType: "single",
+			Payload: []ListenBrainzListen{
+				NewListenBrainzListen(track, listenedAt),
+			},
+		}).
+		Post(getBaseUrl(scrobbler, s.defaultBaseUrl) + "/1/submit-listens")
+
+	return getResponseError(resp, err)
+}
@@ -0,0 +1,118 @@
+package scrobblers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+func TestSubmitListen(t *testing.T) {
+	track := entities.Track{
+		Title:    "Title",
+		Duration: 215000,
+		Metadata: entities.TrackMetadata{
+			Album:                  "Album",
+			TrackNumber:            3,
+			Artists:                []string{"Artist A", "Artist B"},
+			MusicBrainzRecordingId: "recording-id",
+			MusicBrainzArtistIds:   []string{"artist-a", "", "artist-b"},
+		},
+	}
+
+	listenedAt := time.Unix(1700000000, 0)
+
+	var submission listenBrainzSubmission
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/1/submit-listens" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if r.Header.Get("Authorization") != "Token user-token" {
+			t.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&submission); err != nil {
+			t.Errorf("invalid body : %v", err)
+		}
+
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	scrobbler := ListenBrainzScrobbler{client: resty.New(), defaultBaseUrl: server.URL}
+
+	err := scrobbler.SubmitListen(entities.Scrobbler{Token: "user-token"}, track, listenedAt)
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	if submission.ListenType != "single" || len(submission.Payload) != 1 {
+		t.Fatalf("expected a single listen, got %+v", submission)
+	}
+
+	listen := submission.Payload[0]
+
+	if listen.ListenedAt != listenedAt.Unix() {
+		t.Errorf("expected listened_at %d, got %d", listenedAt.Unix(), listen.ListenedAt)
+	}
+
+	expected := ListenBrainzTrackMetadata{
+		ArtistName:  "Artist A, Artist B",
+		TrackName:   "Title",
+		ReleaseName: "Album",
+		AdditionalInfo: ListenBrainzAdditionalInfo{
+			RecordingMbid:    "recording-id",
+			ArtistMbids:      []string{"artist-a", "artist-b"},
+			TrackNumber:      3,
+			DurationMs:       215000,
+			MediaPlayer:      "Melodink",
+			SubmissionClient: "Melodink",
+		},
+	}
+
+	if !reflect.DeepEqual(listen.TrackMetadata, expected) {
+		t.Errorf("expected %+v, got %+v", expected, listen.TrackMetadata)
+	}
+}
+
+func TestSubmitListenErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		expected error
+	}{
+		{name: "invalid token", status: http.StatusUnauthorized, expected: ScrobbleRejectedError},
+		{name: "invalid listen", status: http.StatusBadRequest, expected: ScrobbleRejectedError},
+		{name: "rate limited", status: http.StatusTooManyRequests, expected: ScrobbleQueryError},
+		{name: "server down", status: http.StatusBadGateway, expected: ScrobbleQueryError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			scrobbler := ListenBrainzScrobbler{client: resty.New(), defaultBaseUrl: "http://unused"}
+
+			err := scrobbler.SubmitListen(
+				entities.Scrobbler{Token: "user-token", BaseUrl: server.URL + "/"},
+				entities.Track{Title: "Title"},
+				time.Now(),
+			)
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+		})
+	}
+}
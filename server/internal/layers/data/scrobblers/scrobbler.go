@@ -0,0 +1,73 @@
+package scrobblers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+const scrobblerUserAgent = "melodink (https://github.com/gungun974/Melodink)"
+
+var (
+	// ScrobbleRejectedError means the service refused the request and sending
+	// it again will not change anything, like with an invalid token
+	ScrobbleRejectedError = errors.New("Scrobble was rejected by the service")
+
+	ScrobbleQueryError = errors.New("Failed to query the scrobbling service")
+)
+
+// getResponseError return nil for a successful response, a
+// ScrobbleRejectedError for the client errors and a ScrobbleQueryError for
+// everything that can be tried again later
+func getResponseError(resp *resty.Response, err error) error {
+	if err != nil {
+		return errors.Join(ScrobbleQueryError, err)
+	}
+
+	switch {
+	case resp.IsSuccess():
+		return nil
+	case resp.StatusCode() == http.StatusTooManyRequests || resp.StatusCode() >= 500:
+		return fmt.Errorf("%w: %s", ScrobbleQueryError, resp.Status())
+	default:
+		return fmt.Errorf("%w: %s %s", ScrobbleRejectedError, resp.Status(), resp.String())
+	}
+}
+
+func getTrackArtistName(track entities.Track) string {
+	if len(track.Metadata.Artists) != 0 {
+		return strings.Join(track.Metadata.Artists, ", ")
+	}
+
+	names := make([]string, len(track.Artists))
+
+	for i, artist := range track.Artists {
+		names[i] = artist.Name
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func getTrackAlbumName(track entities.Track) string {
+	if track.Metadata.Album != "" {
+		return track.Metadata.Album
+	}
+
+	if len(track.Albums) != 0 {
+		return track.Albums[0].Name
+	}
+
+	return ""
+}
+
+func getBaseUrl(scrobbler entities.Scrobbler, defaultBaseUrl string) string {
+	if strings.TrimSpace(scrobbler.BaseUrl) == "" {
+		return defaultBaseUrl
+	}
+
+	return strings.TrimRight(strings.TrimSpace(scrobbler.BaseUrl), "/")
+}
@@ -0,0 +1,81 @@
+package scrobblers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+func TestGetResponseError(t *testing.T) {
+	tests := []struct {
+		status   int
+		expected error
+	}{
+		{status: http.StatusOK, expected: nil},
+		{status: http.StatusNoContent, expected: nil},
+		{status: http.StatusBadRequest, expected: ScrobbleRejectedError},
+		{status: http.StatusUnauthorized, expected: ScrobbleRejectedError},
+		{status: http.StatusTooManyRequests, expected: ScrobbleQueryError},
+		{status: http.StatusInternalServerError, expected: ScrobbleQueryError},
+		{status: http.StatusServiceUnavailable, expected: ScrobbleQueryError},
+	}
+
+	for _, test := range tests {
+		t.Run(http.StatusText(test.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			err := getResponseError(resty.New().R().Get(server.URL))
+
+			if test.expected == nil {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestGetResponseErrorUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	err := getResponseError(resty.New().R().Get(server.URL))
+
+	if !errors.Is(err, ScrobbleQueryError) {
+		t.Fatalf("an unreachable service should be tried again, got %v", err)
+	}
+}
+
+func TestGetBaseUrl(t *testing.T) {
+	tests := []struct {
+		baseUrl  string
+		expected string
+	}{
+		{baseUrl: "", expected: "https://default"},
+		{baseUrl: "  ", expected: "https://default"},
+		{baseUrl: "https://libre.fm/2.0/", expected: "https://libre.fm/2.0"},
+		{baseUrl: " https://custom ", expected: "https://custom"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.baseUrl, func(t *testing.T) {
+			url := getBaseUrl(entities.Scrobbler{BaseUrl: test.baseUrl}, "https://default")
+
+			if url != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, url)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"path"
 
 	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/logger"
 	"github.com/h2non/bimg"
 )
 
@@ -91,6 +92,10 @@ func (s *CoverStorage) generateCompressedCovers(directory string) error {
 		}
 	}
 
+	if _, err := s.generateCoverPalette(directory); err != nil {
+		logger.MainLogger.Warnf("Failed to generate cover palette of %s : %v", directory, err)
+	}
+
 	return nil
 }
 
@@ -116,9 +116,13 @@ func (s *CoverStorage) GetAlbumCoverSignature(
 	return s.getCoverSignature(s.getAlbumStorageDirectoryPath(album))
 }
 
+// LoadAlbumCoverSignature also load the cover palette since both come from
+// the same cover.
 func (s *CoverStorage) LoadAlbumCoverSignature(
 	album *entities.Album,
 ) {
+	s.LoadAlbumCoverPalette(album)
+
 	album.CoverSignature = s.GetAlbumCoverSignature(album)
 
 	if album.CoverSignature != "" {
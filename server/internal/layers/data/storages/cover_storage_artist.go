@@ -0,0 +1,144 @@
+package storages
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+// artistSidecarCoverSource is the name of the artist image next to the audio
+// files, usually in the artist directory above the album one.
+const artistSidecarCoverSource = "artist"
+
+func (s *CoverStorage) getArtistStorageDirectoryPath(artist *entities.Artist) string {
+	directory := fmt.Sprintf(
+		"%s/artists/%d/%d",
+		COVER_STORAGE,
+		*artist.UserId,
+		artist.Id,
+	)
+
+	return directory
+}
+
+func (s *CoverStorage) UploadCustomArtistCover(
+	artist *entities.Artist,
+	file io.Reader,
+) error {
+	directory := s.getArtistStorageDirectoryPath(artist)
+
+	err := os.MkdirAll(directory, 0o755)
+	if err != nil {
+		return err
+	}
+
+	originalFileLocation := helpers.SafeJoin(directory, "original")
+
+	destFile, err := os.Create(originalFileLocation)
+	if err != nil {
+		return err
+	}
+	defer destFile.Close()
+
+	_, err = io.Copy(destFile, file)
+	if err != nil {
+		return err
+	}
+
+	err = s.generateCompressedArtistCovers(artist)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GenerateArtistCoverFromSidecar look for an artist image from sidecarDirectory
+// up to rootDirectory.
+func (s *CoverStorage) GenerateArtistCoverFromSidecar(
+	artist *entities.Artist,
+	sidecarDirectory string,
+	rootDirectory string,
+) error {
+	directory := filepath.Clean(sidecarDirectory)
+	root := filepath.Clean(rootDirectory)
+
+	for {
+		sidecarPath, ok := findSidecarCover(directory, artistSidecarCoverSource)
+		if ok {
+			file, err := os.Open(sidecarPath)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			return s.UploadCustomArtistCover(artist, file)
+		}
+
+		if directory == root || !strings.HasPrefix(directory, root+string(filepath.Separator)) {
+			return SidecarCoverNotFoundError
+		}
+
+		directory = filepath.Dir(directory)
+	}
+}
+
+func (s *CoverStorage) DuplicateArtistCover(
+	source *entities.Artist,
+	dest *entities.Artist,
+) error {
+	sourceFile, err := os.Open(path.Join(s.getArtistStorageDirectoryPath(source), "original"))
+	if err != nil {
+		return OriginalCoverNotFoundError
+	}
+	defer sourceFile.Close()
+
+	return s.UploadCustomArtistCover(dest, sourceFile)
+}
+
+func (s *CoverStorage) generateCompressedArtistCovers(artist *entities.Artist) error {
+	return s.generateCompressedCovers(s.getArtistStorageDirectoryPath(artist))
+}
+
+func (s *CoverStorage) GetCompressedArtistCover(
+	artist *entities.Artist,
+	quality string,
+) (bytes.Buffer, error) {
+	return s.getCompressedCover(s.getArtistStorageDirectoryPath(artist), quality)
+}
+
+func (s *CoverStorage) GetOriginalArtistCover(
+	artist *entities.Artist,
+) (bytes.Buffer, error) {
+	return s.getOriginalCover(s.getArtistStorageDirectoryPath(artist))
+}
+
+func (s *CoverStorage) GetArtistCoverSignature(
+	artist *entities.Artist,
+) string {
+	return s.getCoverSignature(s.getArtistStorageDirectoryPath(artist))
+}
+
+func (s *CoverStorage) LoadArtistCoverSignature(
+	artist *entities.Artist,
+) {
+	artist.CoverSignature = s.GetArtistCoverSignature(artist)
+}
+
+func (s *CoverStorage) RemoveArtistCoverFiles(artist *entities.Artist) error {
+	directory := s.getArtistStorageDirectoryPath(artist)
+
+	err := os.RemoveAll(directory)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
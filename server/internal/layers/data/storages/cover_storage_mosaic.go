@@ -0,0 +1,192 @@
+package storages
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/png"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/h2non/bimg"
+)
+
+var PlaylistMosaicNotAvailableError = errors.New(
+	"Playlist doesn't have enough distinct album covers for a mosaic",
+)
+
+const (
+	playlistMosaicTileSize = 600
+	playlistMosaicTiles    = 4
+)
+
+var playlistMosaicMutex sync.Mutex
+
+func (s *CoverStorage) getPlaylistMosaicDirectoryPath(playlist *entities.Playlist) string {
+	return path.Join(s.getPlaylistStorageDirectoryPath(playlist), "mosaic")
+}
+
+// getPlaylistMosaicSources return one track for each of the first distinct
+// album covers of the playlist.
+func getPlaylistMosaicSources(playlist *entities.Playlist) []entities.Track {
+	sources := make([]entities.Track, 0, playlistMosaicTiles)
+
+	seenAlbums := map[int]bool{}
+	seenCovers := map[string]bool{}
+
+	for _, track := range playlist.Tracks {
+		if track.CoverSignature == "" || seenCovers[track.CoverSignature] {
+			continue
+		}
+
+		if len(track.Albums) > 0 {
+			if seenAlbums[track.Albums[0].Id] {
+				continue
+			}
+
+			seenAlbums[track.Albums[0].Id] = true
+		}
+
+		seenCovers[track.CoverSignature] = true
+
+		sources = append(sources, track)
+
+		if len(sources) >= playlistMosaicTiles {
+			break
+		}
+	}
+
+	return sources
+}
+
+// generatePlaylistMosaic build a 2x2 mosaic of the first distinct album
+// covers of the playlist and return its directory. The mosaic is only built
+// again when those covers change.
+func (s *CoverStorage) generatePlaylistMosaic(playlist *entities.Playlist) (string, error) {
+	directory := s.getPlaylistMosaicDirectoryPath(playlist)
+
+	sources := getPlaylistMosaicSources(playlist)
+
+	if len(sources) < playlistMosaicTiles {
+		_ = os.RemoveAll(directory)
+		return "", PlaylistMosaicNotAvailableError
+	}
+
+	signatures := make([]string, len(sources))
+
+	for i, track := range sources {
+		signatures[i] = track.CoverSignature
+	}
+
+	key := strings.Join(signatures, "\n")
+
+	playlistMosaicMutex.Lock()
+	defer playlistMosaicMutex.Unlock()
+
+	if data, err := os.ReadFile(path.Join(directory, "sources")); err == nil && string(data) == key {
+		if _, err := os.Stat(path.Join(directory, "original")); err == nil {
+			return directory, nil
+		}
+	}
+
+	mosaic := image.NewRGBA(image.Rect(0, 0, 2*playlistMosaicTileSize, 2*playlistMosaicTileSize))
+
+	for i, track := range sources {
+		tile, err := s.getMosaicTile(s.getTrackStorageDirectoryPath(&track))
+		if err != nil {
+			return "", err
+		}
+
+		x := (i % 2) * playlistMosaicTileSize
+		y := (i / 2) * playlistMosaicTileSize
+
+		draw.Draw(
+			mosaic,
+			image.Rect(x, y, x+playlistMosaicTileSize, y+playlistMosaicTileSize),
+			tile,
+			tile.Bounds().Min,
+			draw.Src,
+		)
+	}
+
+	err := os.MkdirAll(directory, 0o755)
+	if err != nil {
+		return "", err
+	}
+
+	destFile, err := os.Create(helpers.SafeJoin(directory, "original"))
+	if err != nil {
+		return "", err
+	}
+	defer destFile.Close()
+
+	err = png.Encode(destFile, mosaic)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.generateCompressedCovers(directory)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.WriteFile(path.Join(directory, "sources"), []byte(key), 0o644)
+	if err != nil {
+		return "", err
+	}
+
+	return directory, nil
+}
+
+func (s *CoverStorage) getMosaicTile(directory string) (image.Image, error) {
+	rawImage, err := bimg.Read(helpers.SafeJoin(directory, "original"))
+	if err != nil {
+		return nil, OriginalCoverNotFoundError
+	}
+
+	tile, err := bimg.NewImage(rawImage).Process(bimg.Options{
+		Type:          bimg.PNG,
+		Width:         playlistMosaicTileSize,
+		Height:        playlistMosaicTileSize,
+		Crop:          true,
+		Enlarge:       true,
+		Gravity:       bimg.GravityCentre,
+		StripMetadata: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(tile))
+	if err != nil {
+		return nil, err
+	}
+
+	return img, nil
+}
+
+// getPlaylistCoverDirectoryPath return the directory of the playlist custom
+// cover or of its mosaic when the playlist doesn't have one.
+func (s *CoverStorage) getPlaylistCoverDirectoryPath(playlist *entities.Playlist) string {
+	directory := s.getPlaylistStorageDirectoryPath(playlist)
+
+	if _, err := os.Stat(path.Join(directory, "original")); err == nil {
+		return directory
+	}
+
+	mosaicDirectory, err := s.generatePlaylistMosaic(playlist)
+	if err != nil {
+		if !errors.Is(err, PlaylistMosaicNotAvailableError) {
+			logger.MainLogger.Warnf("Failed to generate playlist mosaic of %d : %v", playlist.Id, err)
+		}
+		return directory
+	}
+
+	return mosaicDirectory
+}
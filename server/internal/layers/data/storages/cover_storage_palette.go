@@ -0,0 +1,165 @@
+package storages
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	_ "image/png"
+	"os"
+	"path"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/pkgs/blurhash"
+	"github.com/gungun974/Melodink/server/pkgs/imagecolors"
+	"github.com/h2non/bimg"
+)
+
+// The palette and the blurhash don't need more than a thumbnail
+const coverPaletteThumbnailSize = 32
+
+type coverPaletteFile struct {
+	Signature string `json:"signature"`
+
+	Dominant string `json:"dominant"`
+	Vibrant  string `json:"vibrant"`
+	Muted    string `json:"muted"`
+
+	BlurHash string `json:"blurhash"`
+}
+
+// generateCoverPalette compute the palette and blurhash of the original cover
+// and save them with the signature they come from.
+func (s *CoverStorage) generateCoverPalette(directory string) (entities.CoverPalette, error) {
+	rawImage, err := bimg.Read(helpers.SafeJoin(directory, "original"))
+	if err != nil {
+		return entities.CoverPalette{}, err
+	}
+
+	thumbnail, err := bimg.NewImage(rawImage).Process(bimg.Options{
+		Type:          bimg.PNG,
+		Width:         coverPaletteThumbnailSize,
+		Height:        coverPaletteThumbnailSize,
+		Force:         true,
+		StripMetadata: true,
+	})
+	if err != nil {
+		return entities.CoverPalette{}, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(thumbnail))
+	if err != nil {
+		return entities.CoverPalette{}, err
+	}
+
+	colors := imagecolors.Extract(img)
+
+	hash, err := blurhash.Encode(4, 3, img)
+	if err != nil {
+		return entities.CoverPalette{}, err
+	}
+
+	file := coverPaletteFile{
+		Signature: s.getCoverSignature(directory),
+
+		Dominant: colors.Dominant,
+		Vibrant:  colors.Vibrant,
+		Muted:    colors.Muted,
+
+		BlurHash: hash,
+	}
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		return entities.CoverPalette{}, err
+	}
+
+	err = os.WriteFile(path.Join(directory, "palette.json"), data, 0o644)
+	if err != nil {
+		return entities.CoverPalette{}, err
+	}
+
+	return file.toCoverPalette(), nil
+}
+
+// getCoverPalette read the saved palette of the directory cover, it's
+// generated again when the cover changed since.
+func (s *CoverStorage) getCoverPalette(directory string) entities.CoverPalette {
+	signature := s.getCoverSignature(directory)
+	if signature == "" {
+		return entities.CoverPalette{}
+	}
+
+	if data, err := os.ReadFile(path.Join(directory, "palette.json")); err == nil {
+		file := coverPaletteFile{}
+
+		if err := json.Unmarshal(data, &file); err == nil && file.Signature == signature {
+			return file.toCoverPalette()
+		}
+	}
+
+	palette, err := s.generateCoverPalette(directory)
+	if err != nil {
+		logger.MainLogger.Warnf("Failed to generate cover palette of %s : %v", directory, err)
+		return entities.CoverPalette{}
+	}
+
+	return palette
+}
+
+func (f coverPaletteFile) toCoverPalette() entities.CoverPalette {
+	return entities.CoverPalette{
+		Dominant: f.Dominant,
+		Vibrant:  f.Vibrant,
+		Muted:    f.Muted,
+
+		BlurHash: f.BlurHash,
+	}
+}
+
+func (s *CoverStorage) GetTrackCoverPalette(track *entities.Track) entities.CoverPalette {
+	return s.getCoverPalette(s.getTrackStorageDirectoryPath(track))
+}
+
+func (s *CoverStorage) LoadAlbumCoverPalette(album *entities.Album) {
+	album.CoverPalette = s.getCoverPalette(s.getAlbumStorageDirectoryPath(album))
+
+	if album.CoverPalette.BlurHash != "" {
+		return
+	}
+
+	for _, track := range album.Tracks {
+		album.CoverPalette = s.getTrackStoredCoverPalette(&track)
+
+		if album.CoverPalette.BlurHash != "" {
+			return
+		}
+	}
+}
+
+func (s *CoverStorage) LoadPlaylistCoverPalette(playlist *entities.Playlist) {
+	playlist.CoverPalette = s.getCoverPalette(s.getPlaylistCoverDirectoryPath(playlist))
+
+	if playlist.CoverPalette.BlurHash != "" {
+		return
+	}
+
+	for _, track := range playlist.Tracks {
+		playlist.CoverPalette = s.getTrackStoredCoverPalette(&track)
+
+		if playlist.CoverPalette.BlurHash != "" {
+			return
+		}
+	}
+}
+
+// getTrackStoredCoverPalette prefer the palette saved in the database with
+// the track to avoid reading its cover.
+func (s *CoverStorage) getTrackStoredCoverPalette(track *entities.Track) entities.CoverPalette {
+	if track.CoverPalette.BlurHash != "" {
+		return track.CoverPalette
+	}
+
+	return s.GetTrackCoverPalette(track)
+}
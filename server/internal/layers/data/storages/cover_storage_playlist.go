@@ -111,13 +111,13 @@ func (s *CoverStorage) GetCompressedPlaylistCover(
 	playlist *entities.Playlist,
 	quality string,
 ) (bytes.Buffer, error) {
-	return s.getCompressedCover(s.getPlaylistStorageDirectoryPath(playlist), quality)
+	return s.getCompressedCover(s.getPlaylistCoverDirectoryPath(playlist), quality)
 }
 
 func (s *CoverStorage) GetOriginalPlaylistCover(
 	playlist *entities.Playlist,
 ) (bytes.Buffer, error) {
-	return s.getOriginalCover(s.getPlaylistStorageDirectoryPath(playlist))
+	return s.getOriginalCover(s.getPlaylistCoverDirectoryPath(playlist))
 }
 
 func (s *CoverStorage) GetPlaylistCoverSignature(
@@ -126,10 +126,14 @@ func (s *CoverStorage) GetPlaylistCoverSignature(
 	return s.getCoverSignature(s.getPlaylistStorageDirectoryPath(playlist))
 }
 
+// LoadPlaylistCoverSignature also load the cover palette since both come from
+// the same cover.
 func (s *CoverStorage) LoadPlaylistCoverSignature(
 	playlist *entities.Playlist,
 ) {
-	playlist.CoverSignature = s.GetPlaylistCoverSignature(playlist)
+	s.LoadPlaylistCoverPalette(playlist)
+
+	playlist.CoverSignature = s.getCoverSignature(s.getPlaylistCoverDirectoryPath(playlist))
 
 	if playlist.CoverSignature != "" {
 		return
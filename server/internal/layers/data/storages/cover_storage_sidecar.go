@@ -0,0 +1,146 @@
+package storages
+
+import (
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+var (
+	SidecarCoverNotFoundError = errors.New("Sidecar cover is not found")
+	TrackCoverNotFoundError   = errors.New("Track cover is not found")
+)
+
+// embeddedCoverSource is the name in COVER_SIDECAR_PRIORITY for the picture
+// inside the audio file, it is tried last when it's not in the list.
+const embeddedCoverSource = "embedded"
+
+var sidecarCoverExtensions = []string{".jpg", ".jpeg", ".png", ".webp"}
+
+// getCoverSourcesPriority return the COVER_SIDECAR_PRIORITY list, a name
+// without extension match every image extension.
+func getCoverSourcesPriority() []string {
+	sources := []string{}
+
+	for _, source := range strings.Split(
+		helpers.GetEnvString("COVER_SIDECAR_PRIORITY", "cover,folder,front,album,albumart"),
+		",",
+	) {
+		source = strings.ToLower(strings.TrimSpace(source))
+
+		if source == "" || slices.Contains(sources, source) {
+			continue
+		}
+
+		sources = append(sources, source)
+	}
+
+	if !slices.Contains(sources, embeddedCoverSource) {
+		sources = append(sources, embeddedCoverSource)
+	}
+
+	return sources
+}
+
+func findSidecarCover(directory string, source string) (string, bool) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return "", false
+	}
+
+	candidates := []string{source}
+
+	if path.Ext(source) == "" {
+		candidates = make([]string, len(sidecarCoverExtensions))
+
+		for i, extension := range sidecarCoverExtensions {
+			candidates[i] = source + extension
+		}
+	}
+
+	for _, candidate := range candidates {
+		for _, entry := range entries {
+			if entry.Type().IsRegular() && strings.EqualFold(entry.Name(), candidate) {
+				return filepath.Join(directory, entry.Name()), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// FindSidecarCover return the path of the first image of the priority list
+// found in the directory.
+func (s *CoverStorage) FindSidecarCover(directory string) (string, error) {
+	for _, source := range getCoverSourcesPriority() {
+		if source == embeddedCoverSource {
+			continue
+		}
+
+		if sidecarPath, ok := findSidecarCover(directory, source); ok {
+			return sidecarPath, nil
+		}
+	}
+
+	return "", SidecarCoverNotFoundError
+}
+
+// GenerateTrackCover use the first source of the priority list available,
+// either a sidecar image from sidecarDirectory or the embedded picture.
+func (s *CoverStorage) GenerateTrackCover(track *entities.Track, sidecarDirectory string) error {
+	for _, source := range getCoverSourcesPriority() {
+		if source == embeddedCoverSource {
+			if err := s.GenerateTrackCoverFromAudioFile(track); err == nil {
+				return nil
+			}
+			continue
+		}
+
+		if helpers.IsEmptyOrWhitespace(sidecarDirectory) {
+			continue
+		}
+
+		sidecarPath, ok := findSidecarCover(sidecarDirectory, source)
+		if !ok {
+			continue
+		}
+
+		file, err := os.Open(sidecarPath)
+		if err != nil {
+			continue
+		}
+
+		err = s.UploadCustomTrackCover(track, file)
+		file.Close()
+
+		if err == nil {
+			return nil
+		}
+	}
+
+	return TrackCoverNotFoundError
+}
+
+func (s *CoverStorage) GenerateAlbumCoverFromSidecar(
+	album *entities.Album,
+	sidecarDirectory string,
+) error {
+	sidecarPath, err := s.FindSidecarCover(sidecarDirectory)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(sidecarPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return s.UploadCustomAlbumCover(album, file)
+}
@@ -0,0 +1,240 @@
+package storages
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/h2non/bimg"
+)
+
+const (
+	COVER_VARIANTS_STORAGE = "./data/cover_variants/"
+)
+
+var CoverFormatNotSupportedError = errors.New("Cover format is not supported")
+
+var coverVariantImageTypes = map[entities.CoverFormat]struct {
+	imageType bimg.ImageType
+	quality   int
+}{
+	entities.CoverFormatJPEG: {imageType: bimg.JPEG, quality: 85},
+	entities.CoverFormatPNG:  {imageType: bimg.PNG, quality: 100},
+	entities.CoverFormatWebP: {imageType: bimg.WEBP, quality: 80},
+	entities.CoverFormatAVIF: {imageType: bimg.AVIF, quality: 60},
+}
+
+type coverVariantsCacheFile struct {
+	size       int64
+	lastAccess time.Time
+}
+
+// coverVariantsCache keep the size of every generated variant to remove the
+// least recently used ones once COVER_VARIANTS_CACHE_SIZE megabytes is
+// reached.
+type coverVariantsCache struct {
+	mutex sync.Mutex
+
+	loaded bool
+
+	size  int64
+	files map[string]coverVariantsCacheFile
+}
+
+var coverVariantsCacheIndex = coverVariantsCache{}
+
+func (c *coverVariantsCache) load() {
+	if c.loaded {
+		return
+	}
+
+	c.loaded = true
+	c.files = map[string]coverVariantsCacheFile{}
+
+	_ = filepath.WalkDir(COVER_VARIANTS_STORAGE, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+
+		c.files[filePath] = coverVariantsCacheFile{
+			size:       info.Size(),
+			lastAccess: info.ModTime(),
+		}
+		c.size += info.Size()
+
+		return nil
+	})
+}
+
+func (c *coverVariantsCache) touch(filePath string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.load()
+
+	if file, ok := c.files[filePath]; ok {
+		file.lastAccess = time.Now()
+		c.files[filePath] = file
+	}
+}
+
+func (c *coverVariantsCache) add(filePath string, size int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.load()
+
+	if file, ok := c.files[filePath]; ok {
+		c.size -= file.size
+	}
+
+	c.files[filePath] = coverVariantsCacheFile{
+		size:       size,
+		lastAccess: time.Now(),
+	}
+	c.size += size
+
+	limit := int64(helpers.GetEnvInt("COVER_VARIANTS_CACHE_SIZE", 512)) * 1024 * 1024
+
+	if c.size <= limit {
+		return
+	}
+
+	paths := make([]string, 0, len(c.files))
+	for filePath := range c.files {
+		paths = append(paths, filePath)
+	}
+
+	slices.SortFunc(paths, func(a string, b string) int {
+		return c.files[a].lastAccess.Compare(c.files[b].lastAccess)
+	})
+
+	for _, oldPath := range paths {
+		if c.size <= limit || oldPath == filePath {
+			break
+		}
+
+		if err := os.Remove(oldPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			logger.MainLogger.Warnf("Failed to remove cover variant %s : %v", oldPath, err)
+			continue
+		}
+
+		_ = os.Remove(path.Dir(oldPath))
+
+		c.size -= c.files[oldPath].size
+		delete(c.files, oldPath)
+	}
+}
+
+// getCoverVariant return the variant of the original cover of the directory
+// and its ETag, variants are cached by the cover signature.
+func (s *CoverStorage) getCoverVariant(
+	directory string,
+	variant entities.CoverVariant,
+) (bytes.Buffer, string, error) {
+	imageType, ok := coverVariantImageTypes[variant.Format]
+	if !ok {
+		return bytes.Buffer{}, "", CoverFormatNotSupportedError
+	}
+
+	signature := s.getCoverSignature(directory)
+	if signature == "" {
+		return bytes.Buffer{}, "", OriginalCoverNotFoundError
+	}
+
+	variantSize := variant.Size
+
+	etag := fmt.Sprintf("%s-%d-%s", signature, variantSize, variant.Format)
+
+	variantPath := filepath.Join(
+		COVER_VARIANTS_STORAGE,
+		signature,
+		fmt.Sprintf("%d.%s", variantSize, variant.Format),
+	)
+
+	if data, err := os.ReadFile(variantPath); err == nil {
+		coverVariantsCacheIndex.touch(variantPath)
+
+		return *bytes.NewBuffer(data), etag, nil
+	}
+
+	rawImage, err := bimg.Read(helpers.SafeJoin(directory, "original"))
+	if err != nil {
+		return bytes.Buffer{}, "", OriginalCoverNotFoundError
+	}
+
+	options := bimg.Options{
+		Type:          imageType.imageType,
+		Quality:       imageType.quality,
+		Interlace:     true,
+		StripMetadata: true,
+	}
+
+	if variantSize > 0 {
+		options.Width = variantSize
+		options.Height = variantSize
+		options.Crop = true
+		options.Enlarge = true
+		options.Gravity = bimg.GravityCentre
+	}
+
+	data, err := bimg.NewImage(rawImage).Process(options)
+	if err != nil {
+		return bytes.Buffer{}, "", err
+	}
+
+	if err := os.MkdirAll(path.Dir(variantPath), 0o755); err != nil {
+		return bytes.Buffer{}, "", err
+	}
+
+	if err := bimg.Write(variantPath, data); err != nil {
+		logger.MainLogger.Warnf("Failed to cache cover variant %s : %v", variantPath, err)
+	} else {
+		coverVariantsCacheIndex.add(variantPath, int64(len(data)))
+	}
+
+	return *bytes.NewBuffer(data), etag, nil
+}
+
+func (s *CoverStorage) GetTrackCoverVariant(
+	track *entities.Track,
+	variant entities.CoverVariant,
+) (bytes.Buffer, string, error) {
+	return s.getCoverVariant(s.getTrackStorageDirectoryPath(track), variant)
+}
+
+func (s *CoverStorage) GetAlbumCoverVariant(
+	album *entities.Album,
+	variant entities.CoverVariant,
+) (bytes.Buffer, string, error) {
+	return s.getCoverVariant(s.getAlbumStorageDirectoryPath(album), variant)
+}
+
+func (s *CoverStorage) GetPlaylistCoverVariant(
+	playlist *entities.Playlist,
+	variant entities.CoverVariant,
+) (bytes.Buffer, string, error) {
+	return s.getCoverVariant(s.getPlaylistCoverDirectoryPath(playlist), variant)
+}
+
+func (s *CoverStorage) GetArtistCoverVariant(
+	artist *entities.Artist,
+	variant entities.CoverVariant,
+) (bytes.Buffer, string, error) {
+	return s.getCoverVariant(s.getArtistStorageDirectoryPath(artist), variant)
+}
@@ -0,0 +1,136 @@
+package storages
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/gungun974/Melodink/server/internal/helpers"
+)
+
+const maxExtractedArchiveSize = 16 * 1024 * 1024 * 1024
+
+var (
+	InvalidAudioArchiveError  = errors.New("Audio archive is not a valid zip file")
+	AudioArchiveTooLargeError = errors.New("Audio archive is too large once extracted")
+)
+
+var archiveExtensionRegex = regexp.MustCompile(`^\.[a-zA-Z0-9]+$`)
+
+func (s *TrackStorage) IsSupportedAudioFile(fileLocation string) bool {
+	mtype, err := mimetype.DetectFile(fileLocation)
+	if err != nil {
+		return false
+	}
+
+	return helpers.IsSupportedAudioMimeType(mtype.String())
+}
+
+// ExtractAudioArchive extract a zip archive in the user upload directory.
+// Directories of the archive are kept so sidecar files stay next to their
+// audio files. It return the extraction directory and the extracted files.
+func (s *TrackStorage) ExtractAudioArchive(
+	userId int,
+	archive io.ReaderAt,
+	size int64,
+) (string, []string, error) {
+	reader, err := zip.NewReader(archive, size)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %w", InvalidAudioArchiveError, err)
+	}
+
+	root := helpers.SafeJoin(
+		fmt.Sprintf("%s/%d", AUDIOS_UPLOAD_STORAGE, userId),
+		fmt.Sprintf("%d-%d-archive", time.Now().UnixMicro(), rand.Int()),
+	)
+
+	err = os.MkdirAll(root, 0o755)
+	if err != nil {
+		return "", nil, err
+	}
+
+	files := []string{}
+
+	var extractedSize uint64
+
+	for _, entry := range reader.File {
+		if !entry.Mode().IsRegular() {
+			continue
+		}
+
+		name := path.Clean(strings.ReplaceAll(entry.Name, "\\", "/"))
+		base := path.Base(name)
+
+		if strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(base, ".") {
+			continue
+		}
+
+		extractedSize += entry.UncompressedSize64
+		if extractedSize > maxExtractedArchiveSize {
+			os.RemoveAll(root)
+			return "", nil, AudioArchiveTooLargeError
+		}
+
+		directory := root
+
+		for _, segment := range strings.Split(path.Dir(name), "/") {
+			if segment == "." {
+				continue
+			}
+
+			directory = helpers.SafeJoin(directory, segment)
+		}
+
+		err := os.MkdirAll(directory, 0o755)
+		if err != nil {
+			os.RemoveAll(root)
+			return "", nil, err
+		}
+
+		extension := path.Ext(base)
+		if !archiveExtensionRegex.MatchString(extension) {
+			extension = ""
+		}
+
+		fileLocation := helpers.SafeJoin(directory, strings.TrimSuffix(base, extension)) +
+			strings.ToLower(extension)
+
+		if err := extractArchiveFile(entry, fileLocation); err != nil {
+			os.RemoveAll(root)
+			return "", nil, err
+		}
+
+		files = append(files, fileLocation)
+	}
+
+	return root, files, nil
+}
+
+func extractArchiveFile(entry *zip.File, fileLocation string) error {
+	source, err := entry.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %w", InvalidAudioArchiveError, err)
+	}
+	defer source.Close()
+
+	destFile, err := os.Create(fileLocation)
+	if err != nil {
+		return err
+	}
+	defer destFile.Close()
+
+	_, err = io.Copy(destFile, io.LimitReader(source, int64(entry.UncompressedSize64)))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
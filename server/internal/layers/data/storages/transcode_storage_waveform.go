@@ -0,0 +1,69 @@
+package storages
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+var WaveformNotFoundError = errors.New("Waveform is not found")
+
+const waveformFile = "waveform.json"
+
+type waveformFileModel struct {
+	Signature string        `json:"signature"`
+	Peaks     map[int][]int `json:"peaks"`
+}
+
+func (s *TranscodeStorage) GetTrackWaveform(trackId int) (*entities.TrackWaveform, error) {
+	directory, err := s.GetTrackTranscodeDirectory(trackId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path.Join(directory, waveformFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, WaveformNotFoundError
+		}
+		return nil, err
+	}
+
+	var m waveformFileModel
+
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, WaveformNotFoundError
+	}
+
+	return &entities.TrackWaveform{
+		Signature: m.Signature,
+		Peaks:     m.Peaks,
+	}, nil
+}
+
+func (s *TranscodeStorage) SaveTrackWaveform(trackId int, waveform entities.TrackWaveform) error {
+	directory, err := s.GetTrackTranscodeDirectory(trackId)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(waveformFileModel{
+		Signature: waveform.Signature,
+		Peaks:     waveform.Peaks,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write in a temporary file first so a crash never leave a broken cache
+	tempPath := path.Join(directory, waveformFile+".tmp")
+
+	if err := os.WriteFile(tempPath, data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, path.Join(directory, waveformFile))
+}
@@ -1,5 +1,7 @@
 package entities
 
+import "strings"
+
 type Album struct {
 	Id int
 
@@ -7,9 +9,62 @@ type Album struct {
 
 	Name string
 
+	// ReleaseType is the MusicBrainz primary type like album, single or ep
+	// and ReleaseSecondaryTypes tell if it's a compilation, a live...
+	ReleaseType           string
+	ReleaseSecondaryTypes []string
+
+	ReleaseDate         string
+	OriginalReleaseDate string
+
+	Label         string
+	CatalogNumber string
+	Barcode       string
+
+	MusicBrainzReleaseId      string
+	MusicBrainzReleaseGroupId string
+
 	Artists []Artist
 
+	Genres []Genre
+
 	Tracks []Track
 
 	CoverSignature string
+	CoverPalette   CoverPalette
+}
+
+var AlbumPrimaryReleaseTypes = []string{"album", "single", "ep", "broadcast", "other"}
+
+// SplitReleaseTypes separate the primary type from the secondary ones in a
+// list of MusicBrainz release types like "album; live".
+func SplitReleaseTypes(releaseTypes []string) (string, []string) {
+	primary := ""
+	secondaries := []string{}
+
+	for _, releaseType := range releaseTypes {
+		releaseType = strings.ToLower(strings.TrimSpace(releaseType))
+
+		if releaseType == "" {
+			continue
+		}
+
+		isPrimary := false
+
+		for _, primaryType := range AlbumPrimaryReleaseTypes {
+			if releaseType == primaryType {
+				isPrimary = true
+				break
+			}
+		}
+
+		if isPrimary && primary == "" {
+			primary = releaseType
+			continue
+		}
+
+		secondaries = append(secondaries, releaseType)
+	}
+
+	return primary, secondaries
 }
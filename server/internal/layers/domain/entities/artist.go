@@ -7,6 +7,18 @@ type Artist struct {
 
 	Name string
 
+	// SortName is how the artist is ordered like "Beatles, The"
+	SortName string
+
+	// Disambiguation tell apart artists with the same name
+	Disambiguation string
+
+	Aliases []string
+
+	MusicBrainzArtistId string
+
+	CoverSignature string
+
 	Albums        []Album
 	AppearAlbums  []Album
 	HasRoleAlbums []Album
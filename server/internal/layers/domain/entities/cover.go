@@ -0,0 +1,36 @@
+package entities
+
+type CoverFormat string
+
+const (
+	CoverFormatJPEG CoverFormat = "jpeg"
+	CoverFormatPNG  CoverFormat = "png"
+	CoverFormatWebP CoverFormat = "webp"
+	CoverFormatAVIF CoverFormat = "avif"
+)
+
+const (
+	CoverVariantMinSize = 16
+	CoverVariantMaxSize = 2048
+)
+
+// CoverVariant describe a cover derived from the original one, a Size of 0
+// keep the original dimensions otherwise the cover is cropped to a square.
+type CoverVariant struct {
+	Size   int
+	Format CoverFormat
+}
+
+func (f CoverFormat) MIMEType() string {
+	return "image/" + string(f)
+}
+
+// CoverPalette colors are hexadecimal strings like #1a2b3c, every field is
+// empty when there is no cover.
+type CoverPalette struct {
+	Dominant string
+	Vibrant  string
+	Muted    string
+
+	BlurHash string
+}
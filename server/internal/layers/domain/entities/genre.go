@@ -0,0 +1,24 @@
+package entities
+
+type Genre struct {
+	Id int
+
+	UserId *int
+
+	Name string
+
+	// ParentId is the broader genre like "Metal" for "Progressive Metal"
+	ParentId *int
+
+	// Aliases are the other names mapped to this genre when tracks are linked
+	Aliases []string
+
+	// TrackCount and AlbumCount also include the sub genres
+	TrackCount int
+	AlbumCount int
+
+	CoverSignature string
+
+	Albums []Album
+	Tracks []Track
+}
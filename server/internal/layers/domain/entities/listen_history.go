@@ -0,0 +1,25 @@
+package entities
+
+// ListenHistoryDuplicateWindow is in seconds, an imported listen of a track
+// played in this window is considered already in the history
+const ListenHistoryDuplicateWindow = 60
+
+type ListenHistoryImportReport struct {
+	Source string
+
+	EntryCount     int
+	ImportedCount  int
+	DuplicateCount int
+	UnmatchedCount int
+
+	// Unmatched groups the entries without a track in the library
+	Unmatched []ListenHistoryUnmatchedEntry
+}
+
+type ListenHistoryUnmatchedEntry struct {
+	Artist string
+	Title  string
+	Album  string
+
+	Count int
+}
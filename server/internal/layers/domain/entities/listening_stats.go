@@ -0,0 +1,117 @@
+package entities
+
+import "time"
+
+// ListeningSkipThreshold is the listened time in milliseconds under which a
+// play that didn't reach the end of the track is counted as a skip
+const ListeningSkipThreshold = 30000
+
+type ListeningPeriod struct {
+	From time.Time
+	To   time.Time
+
+	// UtcOffset is in minutes and is applied to the plays before grouping them
+	// by hour, day or month
+	UtcOffset int
+}
+
+type ListeningCategory string
+
+const (
+	ListeningCategoryTracks  ListeningCategory = "tracks"
+	ListeningCategoryAlbums  ListeningCategory = "albums"
+	ListeningCategoryArtists ListeningCategory = "artists"
+	ListeningCategoryGenres  ListeningCategory = "genres"
+)
+
+type ListeningInterval string
+
+const (
+	ListeningIntervalDay   ListeningInterval = "day"
+	ListeningIntervalMonth ListeningInterval = "month"
+)
+
+// ListeningCount is the plays of a track, album, artist or genre depending on
+// the requested ListeningCategory
+type ListeningCount struct {
+	Id int
+
+	PlayCount int
+
+	// ListenedTime is in milliseconds like the played track positions
+	ListenedTime int
+}
+
+type ListeningTimeBucket struct {
+	// Date is "2006-01-02" for days and "2006-01" for months
+	Date string
+
+	PlayCount    int
+	ListenedTime int
+}
+
+type ListeningHeatmapCell struct {
+	// Weekday starts at 0 for Sunday
+	Weekday int
+	Hour    int
+
+	PlayCount    int
+	ListenedTime int
+}
+
+type ListeningSummary struct {
+	PlayCount    int
+	ListenedTime int
+
+	TrackCount  int
+	AlbumCount  int
+	ArtistCount int
+
+	SkipCount      int
+	CompletedCount int
+}
+
+func (s ListeningSummary) SkipRate() float64 {
+	if s.PlayCount == 0 {
+		return 0
+	}
+	return float64(s.SkipCount) / float64(s.PlayCount)
+}
+
+func (s ListeningSummary) CompletionRate() float64 {
+	if s.PlayCount == 0 {
+		return 0
+	}
+	return float64(s.CompletedCount) / float64(s.PlayCount)
+}
+
+// ListeningStreak is a run of consecutive days with at least one play
+type ListeningStreak struct {
+	From string
+	To   string
+
+	Days int
+}
+
+type ListeningStreaks struct {
+	// Current is nil when nothing was played today or yesterday
+	Current *ListeningStreak
+	Longest *ListeningStreak
+}
+
+type ListeningYearInReview struct {
+	Year int
+
+	Summary ListeningSummary
+
+	TopTracks  []ListeningCount
+	TopAlbums  []ListeningCount
+	TopArtists []ListeningCount
+	TopGenres  []ListeningCount
+
+	Months  []ListeningTimeBucket
+	Heatmap []ListeningHeatmapCell
+
+	BusiestDay    *ListeningTimeBucket
+	LongestStreak *ListeningStreak
+}
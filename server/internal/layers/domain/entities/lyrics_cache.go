@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// LyricsCache keep the answer of the lyrics provider for a search, a miss is
+// cached too so the provider isn't asked again on every batch.
+type LyricsCache struct {
+	Key string
+
+	Found        bool
+	Instrumental bool
+
+	PlainLyrics  string
+	SyncedLyrics string
+
+	FetchedAt time.Time
+}
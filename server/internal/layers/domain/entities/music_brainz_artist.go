@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// MusicBrainzArtist keep what a release lookup told about a credited artist
+// until a track link it to a local artist.
+type MusicBrainzArtist struct {
+	Id string
+
+	Name           string
+	SortName       string
+	Disambiguation string
+
+	Aliases []string
+
+	FetchedAt time.Time
+}
@@ -0,0 +1,30 @@
+package entities
+
+import "time"
+
+type PlayHistoryFormat string
+
+const (
+	PlayHistoryFormatCSV   PlayHistoryFormat = "csv"
+	PlayHistoryFormatJSONL PlayHistoryFormat = "jsonl"
+
+	// PlayHistoryFormatListenBrainz is a JSON array of listens like the
+	// ListenBrainz exports, only the scrobblable plays are kept
+	PlayHistoryFormatListenBrainz PlayHistoryFormat = "listenbrainz"
+)
+
+type PlayHistoryFilter struct {
+	From *time.Time
+	To   *time.Time
+
+	// DeviceId is empty to keep the plays of every device
+	DeviceId string
+}
+
+// PlayHistoryEntry is a played track with the metadata of its track, Track is
+// nil when the track was deleted from the library
+type PlayHistoryEntry struct {
+	PlayedTrack SharedPlayedTrack
+
+	Track *Track
+}
@@ -1,5 +1,7 @@
 package entities
 
+import "time"
+
 type PlaylistType string
 
 type Playlist struct {
@@ -11,6 +13,42 @@ type Playlist struct {
 	Description string
 
 	CoverSignature string
+	CoverPalette   CoverPalette
 
 	Tracks []Track
 }
+
+type PlaylistRevisionAction string
+
+const (
+	PlaylistRevisionEdit    PlaylistRevisionAction = "edit"
+	PlaylistRevisionTracks  PlaylistRevisionAction = "tracks"
+	PlaylistRevisionRestore PlaylistRevisionAction = "restore"
+	PlaylistRevisionDelete  PlaylistRevisionAction = "delete"
+
+	// PlaylistRevisionUndo is the snapshot taken before an undo, it is skipped
+	// by the next undo so they keep going back in the history
+	PlaylistRevisionUndo PlaylistRevisionAction = "undo"
+)
+
+// PlaylistRevision is a snapshot of a playlist taken right before a change was
+// applied to it. Restoring a revision puts the playlist back in that state.
+type PlaylistRevision struct {
+	Id int
+
+	PlaylistId int
+	UserId     *int
+
+	DeviceId string
+	Action   PlaylistRevisionAction
+
+	Name        string
+	Description string
+
+	TrackIds []int
+
+	AddedTrackIds   []int
+	RemovedTrackIds []int
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,57 @@
+package entities
+
+import "time"
+
+type ScrobblerService string
+
+const (
+	ScrobblerServiceListenBrainz ScrobblerService = "listenbrainz"
+
+	// ScrobblerServiceLastFm also covers the Last.fm compatible services like
+	// Libre.fm with a custom BaseUrl
+	ScrobblerServiceLastFm ScrobblerService = "lastfm"
+)
+
+type Scrobbler struct {
+	Id int
+
+	UserId int
+
+	Service ScrobblerService
+	Name    string
+
+	// BaseUrl is empty to use the default url of the service
+	BaseUrl string
+
+	// Token is the ListenBrainz user token
+	Token string
+
+	// ApiKey, ApiSecret and SessionKey are the Last.fm credentials
+	ApiKey     string
+	ApiSecret  string
+	SessionKey string
+
+	Enabled bool
+
+	PendingCount int
+	FailedCount  int
+}
+
+// Scrobble is a played track waiting in the queue of a scrobbler
+type Scrobble struct {
+	Id int
+
+	ScrobblerId int
+
+	PlayedTrackId int
+	TrackId       int
+
+	ListenedAt time.Time
+
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+
+	SubmittedAt *time.Time
+	FailedAt    *time.Time
+}
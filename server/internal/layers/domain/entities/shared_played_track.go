@@ -26,3 +26,22 @@ type SharedPlayedTrack struct {
 
 	SharedAt time.Time
 }
+
+const (
+	// Tracks shorter than 30 seconds are never scrobbled
+	ScrobbleMinTrackDuration = 30000
+
+	ScrobbleMinListenedTime = 4 * 60 * 1000
+)
+
+// IsScrobblable follows the Last.fm and ListenBrainz rule, the track must
+// have been listened for half its duration or for 4 minutes
+func (p SharedPlayedTrack) IsScrobblable() bool {
+	if p.TrackDuration <= ScrobbleMinTrackDuration {
+		return false
+	}
+
+	listenedTime := p.EndedAt - p.BeginAt
+
+	return listenedTime*2 >= p.TrackDuration || listenedTime >= ScrobbleMinListenedTime
+}
@@ -0,0 +1,36 @@
+package entities
+
+import "testing"
+
+func TestSharedPlayedTrackIsScrobblable(t *testing.T) {
+	tests := []struct {
+		name string
+
+		trackDuration int
+		listened      int
+
+		expected bool
+	}{
+		{name: "half of the track", trackDuration: 200000, listened: 100000, expected: true},
+		{name: "just under half", trackDuration: 200000, listened: 99999, expected: false},
+		{name: "half of an odd duration", trackDuration: 200001, listened: 100000, expected: false},
+		{name: "four minutes of a long track", trackDuration: 3600000, listened: 240000, expected: true},
+		{name: "just under four minutes", trackDuration: 3600000, listened: 239999, expected: false},
+		{name: "whole short track", trackDuration: 30000, listened: 30000, expected: false},
+		{name: "just over the minimum duration", trackDuration: 30001, listened: 15001, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			played := SharedPlayedTrack{
+				BeginAt:       5000,
+				EndedAt:       5000 + test.listened,
+				TrackDuration: test.trackDuration,
+			}
+
+			if played.IsScrobblable() != test.expected {
+				t.Fatalf("expected %v", test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package entities
+
+type TagSplitRules struct {
+	UserId int
+
+	ArtistSeparators []string
+	GenreSeparators  []string
+
+	ProtectedNames []string
+}
+
+func NewDefaultTagSplitRules(userId int) TagSplitRules {
+	return TagSplitRules{
+		UserId: userId,
+
+		ArtistSeparators: []string{";", " & ", "/", " feat. "},
+		GenreSeparators:  []string{",", ";", "/"},
+
+		ProtectedNames: []string{
+			"Earth, Wind & Fire",
+			"Crosby, Stills, Nash & Young",
+			"Crosby, Stills & Nash",
+			"AC/DC",
+		},
+	}
+}
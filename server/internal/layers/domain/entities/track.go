@@ -19,12 +19,22 @@ type Track struct {
 	FileSignature  string
 	CoverSignature string
 
+	CoverPalette CoverPalette
+
 	TranscodingLowSignature    string
 	TranscodingMediumSignature string
 	TranscodingHighSignature   string
 
 	Albums  []Album
 	Artists []Artist
+	Genres  []Genre
+
+	// Work is the classical composition the track is a movement of
+	Work *Work
+
+	// RoleArtists are the linked composers, conductors, orchestras and
+	// soloists of the track
+	RoleArtists []TrackRoleArtist
 
 	Metadata TrackMetadata
 
@@ -32,10 +42,20 @@ type Track struct {
 	BitRate          *int
 	BitsPerRawSample *int
 
+	Loudness TrackLoudness
+
+	Analysis TrackAnalysis
+
+	QualityCheck TrackQualityCheck
+
 	Scores []TrackScore
 
 	PendingImport bool
 
+	// MetadataEditedAt is set when the metadata was edited by hand, the
+	// resplit of the tags leave these tracks alone
+	MetadataEditedAt *time.Time
+
 	DateAdded time.Time
 }
 
@@ -55,31 +75,229 @@ type TrackMetadata struct {
 	Lyrics  string
 	Comment string
 
+	SyncedLyrics []TrackLyricsLine
+
 	AcoustID string
 
 	MusicBrainzReleaseId   string
 	MusicBrainzTrackId     string
 	MusicBrainzRecordingId string
 
+	// Release infos are the same for every track of the album, they are used
+	// to fill the linked album
+	ReleaseTypes  []string
+	OriginalDate  string
+	Label         string
+	CatalogNumber string
+	Barcode       string
+
+	MusicBrainzReleaseGroupId string
+
 	Artists      []string
 	AlbumArtists []string
 
+	// MusicBrainz artist ids follow the order of Artists and AlbumArtists
+	MusicBrainzArtistIds      []string
+	MusicBrainzAlbumArtistIds []string
+
 	ArtistsRoles []TrackArtistRole
 
 	Composer string
+
+	Work         string
+	MovementName string
+
+	MovementNumber int
+	MovementTotal  int
+
+	MusicBrainzWorkId string
 }
 
+type TrackLyricsLine struct {
+	Time time.Duration
+	Text string
+}
+
+type TrackLyricsFormat string
+
+const (
+	TrackLyricsFormatPlain TrackLyricsFormat = "plain"
+	TrackLyricsFormatLRC   TrackLyricsFormat = "lrc"
+	TrackLyricsFormatJSON  TrackLyricsFormat = "json"
+)
+
 type TrackArtistRole struct {
 	Type string
 
+	// Role is set for the roles shown to users like conductors, empty for
+	// the other MusicBrainz relations
+	Role string
+
 	Artist string
 
 	Attributes []string
 }
 
+const (
+	TrackArtistRoleComposer  = "composer"
+	TrackArtistRoleConductor = "conductor"
+	TrackArtistRoleOrchestra = "orchestra"
+	TrackArtistRoleSoloist   = "soloist"
+)
+
+type TrackRoleArtist struct {
+	Role string
+
+	Artist Artist
+}
+
+// TrackLoudness hold EBU R128 measurements, integrated loudness and range
+// are in LUFS and LU while true peaks are in dBTP.
+type TrackLoudness struct {
+	Integrated *float64
+	TruePeak   *float64
+	Range      *float64
+
+	AlbumIntegrated *float64
+	AlbumTruePeak   *float64
+	AlbumRange      *float64
+}
+
+// TrackAnalysis hold the tempo, key and feel of a track, read from tags or
+// estimated from the audio. Energy and Danceability are between 0 and 1.
+type TrackAnalysis struct {
+	Bpm *float64
+
+	Key     *string
+	Camelot *string
+
+	Energy       *float64
+	Danceability *float64
+}
+
+type TrackQualityVerdict string
+
+const (
+	TrackQualityVerdictGenuine        TrackQualityVerdict = "genuine"
+	TrackQualityVerdictLossyTranscode TrackQualityVerdict = "lossy_transcode"
+	TrackQualityVerdictUpsampled      TrackQualityVerdict = "upsampled"
+)
+
+// TrackQualityCheck hold the spectral analysis of the audio file. The cutoff
+// is in Hz and the estimated bitrate of a lossy source in kbps, Verdict is nil
+// when the track was never checked.
+type TrackQualityCheck struct {
+	Verdict *TrackQualityVerdict
+
+	CutoffFrequency *int
+
+	SampleRate    *int
+	BitsPerSample *int
+	BitRate       *int
+}
+
+// ReplayGain 2.0 reference level, it's 5 dB above the EBU R128 target.
+const ReplayGainReferenceLoudness = -18.0
+
+func (l TrackLoudness) TrackGain() *float64 {
+	if l.Integrated == nil {
+		return nil
+	}
+
+	gain := ReplayGainReferenceLoudness - *l.Integrated
+
+	return &gain
+}
+
+func (l TrackLoudness) AlbumGain() *float64 {
+	if l.AlbumIntegrated == nil {
+		return nil
+	}
+
+	gain := ReplayGainReferenceLoudness - *l.AlbumIntegrated
+
+	return &gain
+}
+
 type TrackScore struct {
 	TrackId int
 	UserId  int
 
 	Score float64
 }
+
+type TrackFilter struct {
+	Album *string
+
+	AlbumId  *int
+	ArtistId *int
+
+	Genre *string
+
+	MinBpm *float64
+	MaxBpm *float64
+
+	Camelots []string
+
+	MinEnergy *float64
+	MaxEnergy *float64
+
+	MinDanceability *float64
+	MaxDanceability *float64
+}
+
+// IsEmpty is true when the filter match every track of the user
+func (f TrackFilter) IsEmpty() bool {
+	return f.Album == nil &&
+		f.AlbumId == nil &&
+		f.ArtistId == nil &&
+		f.Genre == nil &&
+		f.MinBpm == nil &&
+		f.MaxBpm == nil &&
+		len(f.Camelots) == 0 &&
+		f.MinEnergy == nil &&
+		f.MaxEnergy == nil &&
+		f.MinDanceability == nil &&
+		f.MaxDanceability == nil
+}
+
+type TrackSortField string
+
+const (
+	TrackSortDefault      TrackSortField = ""
+	TrackSortTitle        TrackSortField = "title"
+	TrackSortDateAdded    TrackSortField = "date_added"
+	TrackSortBpm          TrackSortField = "bpm"
+	TrackSortCamelot      TrackSortField = "camelot"
+	TrackSortEnergy       TrackSortField = "energy"
+	TrackSortDanceability TrackSortField = "danceability"
+)
+
+type TrackSort struct {
+	Field      TrackSortField
+	Descending bool
+}
+
+type TrackFieldChange struct {
+	Field string
+
+	Before any
+	After  any
+}
+
+type TrackChanges struct {
+	TrackId int
+	Title   string
+
+	Changes []TrackFieldChange
+}
+
+// TrackWaveformResolutions are the amount of peaks generated for every track
+// waveform.
+var TrackWaveformResolutions = []int{64, 256, 1024, 4096}
+
+type TrackWaveform struct {
+	Signature string
+
+	Peaks map[int][]int
+}
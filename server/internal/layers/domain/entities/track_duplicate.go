@@ -0,0 +1,26 @@
+package entities
+
+// TrackFingerprint is the raw chromaprint of a track, FileSignature tell
+// which audio file it was computed from.
+type TrackFingerprint struct {
+	TrackId int
+
+	FileSignature string
+	Fingerprint   []int32
+}
+
+type TrackDuplicateReason string
+
+const (
+	TrackDuplicateReasonFileSignature TrackDuplicateReason = "file_signature"
+	TrackDuplicateReasonAcoustID      TrackDuplicateReason = "acoustid"
+	TrackDuplicateReasonFingerprint   TrackDuplicateReason = "fingerprint"
+)
+
+// TrackDuplicateGroup hold tracks which are the same recording, the best
+// quality version come first.
+type TrackDuplicateGroup struct {
+	Reasons []TrackDuplicateReason
+
+	Tracks []Track
+}
@@ -0,0 +1,27 @@
+package entities
+
+// Work is a classical composition, its tracks are the recorded movements.
+type Work struct {
+	Id int
+
+	UserId *int
+
+	Name string
+
+	MusicBrainzWorkId string
+
+	// Composers are the artists linked as composer of the work tracks
+	Composers []Artist
+
+	TrackCount int
+
+	Tracks []Track
+}
+
+// AlbumWorkGroup is a part of an album, the movements of one work or the
+// following tracks without work.
+type AlbumWorkGroup struct {
+	Work *Work
+
+	Tracks []Track
+}
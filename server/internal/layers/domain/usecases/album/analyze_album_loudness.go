@@ -0,0 +1,78 @@
+package album_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *AlbumUsecase) AnalyzeAlbumLoudness(
+	ctx context.Context,
+	albumId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	album, err := u.albumRepository.GetAlbumById(albumId)
+	if err != nil {
+		if errors.Is(err, repositories.AlbumNotFoundError) {
+			return nil, entities.NewNotFoundError("Album not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if album.UserId != nil && *album.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if len(album.Tracks) == 0 {
+		return nil, entities.NewValidationError("Album has no track to analyze")
+	}
+
+	paths := make([]string, len(album.Tracks))
+
+	for i := range album.Tracks {
+		track := &album.Tracks[i]
+
+		paths[i] = track.Path
+
+		result, err := u.loudnessScanner.ScanLoudness(track.Path)
+		if err != nil {
+			return nil, entities.NewInternalError(errors.New("Failed to analyze track loudness"))
+		}
+
+		track.Loudness.Integrated = result.Integrated
+		track.Loudness.TruePeak = result.TruePeak
+		track.Loudness.Range = result.Range
+	}
+
+	result, err := u.loudnessScanner.ScanLoudness(paths...)
+	if err != nil {
+		return nil, entities.NewInternalError(errors.New("Failed to analyze album loudness"))
+	}
+
+	for i := range album.Tracks {
+		album.Tracks[i].Loudness.AlbumIntegrated = result.Integrated
+		album.Tracks[i].Loudness.AlbumTruePeak = result.TruePeak
+		album.Tracks[i].Loudness.AlbumRange = result.Range
+	}
+
+	if err := u.trackRepository.UpdateTracks(album.Tracks); err != nil {
+		logger.MainLogger.Error("Couldn't update album tracks loudness in Database", err, *album)
+		return nil, entities.NewInternalError(errors.New("Failed to update album tracks"))
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.albumPresenter.ShowAlbum(ctx, *album), nil
+}
@@ -15,6 +15,20 @@ type EditAlbumParams struct {
 	Id int
 
 	Name string
+
+	// nil fields keep their current value
+	ReleaseType           *string
+	ReleaseSecondaryTypes []string
+
+	ReleaseDate         *string
+	OriginalReleaseDate *string
+
+	Label         *string
+	CatalogNumber *string
+	Barcode       *string
+
+	MusicBrainzReleaseId      *string
+	MusicBrainzReleaseGroupId *string
 }
 
 func (u *AlbumUsecase) EditAlbum(
@@ -40,6 +54,42 @@ func (u *AlbumUsecase) EditAlbum(
 
 	album.Name = params.Name
 
+	if params.ReleaseType != nil {
+		album.ReleaseType = *params.ReleaseType
+	}
+
+	if params.ReleaseSecondaryTypes != nil {
+		album.ReleaseSecondaryTypes = params.ReleaseSecondaryTypes
+	}
+
+	if params.ReleaseDate != nil {
+		album.ReleaseDate = *params.ReleaseDate
+	}
+
+	if params.OriginalReleaseDate != nil {
+		album.OriginalReleaseDate = *params.OriginalReleaseDate
+	}
+
+	if params.Label != nil {
+		album.Label = *params.Label
+	}
+
+	if params.CatalogNumber != nil {
+		album.CatalogNumber = *params.CatalogNumber
+	}
+
+	if params.Barcode != nil {
+		album.Barcode = *params.Barcode
+	}
+
+	if params.MusicBrainzReleaseId != nil {
+		album.MusicBrainzReleaseId = *params.MusicBrainzReleaseId
+	}
+
+	if params.MusicBrainzReleaseGroupId != nil {
+		album.MusicBrainzReleaseGroupId = *params.MusicBrainzReleaseGroupId
+	}
+
 	if err := u.albumRepository.UpdateAlbum(album); err != nil {
 		logger.MainLogger.Error("Couldn't update album in Database", err, *album)
 		return nil, entities.NewInternalError(errors.New("Failed to update album"))
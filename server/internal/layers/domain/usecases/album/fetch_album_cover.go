@@ -0,0 +1,132 @@
+package album_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/scanners"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *AlbumUsecase) FetchAlbumCover(
+	ctx context.Context,
+	albumId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	album, err := u.albumRepository.GetAlbumById(albumId)
+	if err != nil {
+		if errors.Is(err, repositories.AlbumNotFoundError) {
+			return nil, entities.NewNotFoundError("Album not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if album.UserId != nil && *album.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if err := u.fetchAlbumCover(album); err != nil {
+		if errors.Is(err, scanners.CoverArtArchiveNotFoundError) {
+			return nil, entities.NewNotFoundError("No cover found for this album")
+		}
+		return nil, entities.NewInternalError(errors.New("Failed to fetch album cover"))
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.albumPresenter.ShowAlbum(ctx, *album), nil
+}
+
+// FillMissingAlbumsCovers fetch a cover for every album of the user which
+// has neither a custom cover nor a track with an embedded one.
+func (u *AlbumUsecase) FillMissingAlbumsCovers(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	albums, err := u.albumRepository.GetAllAlbumsFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.albumRepository.LoadTracksInAlbums(albums)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	updatedAlbums := make([]entities.Album, 0)
+
+	for i := range albums {
+		album := &albums[i]
+
+		u.coverStorage.LoadAlbumCoverSignature(album)
+
+		if album.CoverSignature != "" {
+			continue
+		}
+
+		if err := u.fetchAlbumCover(album); err != nil {
+			if !errors.Is(err, scanners.CoverArtArchiveNotFoundError) {
+				logger.MainLogger.Warn("Couldn't fetch album cover", err, album.Id)
+			}
+			continue
+		}
+
+		updatedAlbums = append(updatedAlbums, *album)
+	}
+
+	return u.albumPresenter.ShowAlbums(ctx, updatedAlbums), nil
+}
+
+// fetchAlbumCover try the MusicBrainz releases of the album tracks on the
+// Cover Art Archive and save the first front cover found as the album
+// custom cover.
+func (u *AlbumUsecase) fetchAlbumCover(album *entities.Album) error {
+	releaseIds := []string{}
+
+	for _, track := range album.Tracks {
+		releaseId := track.Metadata.MusicBrainzReleaseId
+
+		if helpers.IsEmptyOrWhitespace(releaseId) || slices.Contains(releaseIds, releaseId) {
+			continue
+		}
+
+		releaseIds = append(releaseIds, releaseId)
+	}
+
+	for _, releaseId := range releaseIds {
+		image, err := u.coverArtArchiveScanner.FetchReleaseFrontCover(releaseId)
+		if err != nil {
+			if errors.Is(err, scanners.CoverArtArchiveNotFoundError) {
+				continue
+			}
+			return err
+		}
+
+		if err := u.coverStorage.UploadCustomAlbumCover(album, image); err != nil {
+			logger.MainLogger.Error("Failed to save Cover Art Archive cover", err, album.Id)
+			return err
+		}
+
+		u.coverStorage.LoadAlbumCoverSignature(album)
+
+		return nil
+	}
+
+	return scanners.CoverArtArchiveNotFoundError
+}
@@ -0,0 +1,57 @@
+package album_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *AlbumUsecase) GetAlbumCoverVariant(
+	ctx context.Context,
+	albumId int,
+	variant entities.CoverVariant,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	album, err := u.albumRepository.GetAlbumById(albumId)
+	if err != nil {
+		if errors.Is(err, repositories.AlbumNotFoundError) {
+			return nil, entities.NewNotFoundError("Album not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if album.UserId != nil && *album.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	image, etag, err := u.coverStorage.GetAlbumCoverVariant(album, variant)
+
+	for i := 0; errors.Is(err, storages.OriginalCoverNotFoundError) && i < len(album.Tracks); i++ {
+		image, etag, err = u.coverStorage.GetTrackCoverVariant(&album.Tracks[i], variant)
+	}
+
+	if err != nil {
+		if errors.Is(err, storages.OriginalCoverNotFoundError) {
+			return nil, entities.NewNotFoundError("No image available for this album")
+		}
+		if errors.Is(err, storages.CoverFormatNotSupportedError) {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	return &models.ImageAPIResponse{
+		MIMEType: variant.Format.MIMEType(),
+		Data:     image,
+		ETag:     etag,
+	}, nil
+}
@@ -46,5 +46,20 @@ func (u *AlbumUsecase) GetUserAlbumById(
 		return nil, entities.NewInternalError(err)
 	}
 
+	err = u.trackRepository.LoadGenresInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadWorkInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadRoleArtistsInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
 	return u.albumPresenter.ShowAlbum(ctx, *album), nil
 }
@@ -0,0 +1,116 @@
+package album_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *AlbumUsecase) GetUserAlbumWorks(
+	ctx context.Context,
+	albumId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	album, err := u.albumRepository.GetAlbumById(albumId)
+	if err != nil {
+		if errors.Is(err, repositories.AlbumNotFoundError) {
+			return nil, entities.NewNotFoundError("Album not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if album.UserId != nil && *album.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	err = u.trackRepository.LoadWorkInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	groups := groupAlbumTracksByWork(album.Tracks)
+
+	works := []entities.Work{}
+
+	for _, group := range groups {
+		if group.Work != nil {
+			works = append(works, *group.Work)
+		}
+	}
+
+	err = u.workRepository.LoadStatsInWorks(works)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	i := 0
+
+	for _, group := range groups {
+		if group.Work != nil {
+			*group.Work = works[i]
+			i++
+		}
+	}
+
+	return u.albumPresenter.ShowAlbumWorks(ctx, groups), nil
+}
+
+// groupAlbumTracksByWork keep the album order of the groups, the movements of
+// a work are gathered even when the album interleave them and the following
+// tracks without work are kept together.
+func groupAlbumTracksByWork(tracks []entities.Track) []entities.AlbumWorkGroup {
+	groups := []entities.AlbumWorkGroup{}
+
+	for _, track := range tracks {
+		if track.Work == nil {
+			if len(groups) != 0 && groups[len(groups)-1].Work == nil {
+				groups[len(groups)-1].Tracks = append(groups[len(groups)-1].Tracks, track)
+				continue
+			}
+
+			groups = append(groups, entities.AlbumWorkGroup{
+				Tracks: []entities.Track{track},
+			})
+			continue
+		}
+
+		index := slices.IndexFunc(groups, func(group entities.AlbumWorkGroup) bool {
+			return group.Work != nil && group.Work.Id == track.Work.Id
+		})
+
+		if index == -1 {
+			groups = append(groups, entities.AlbumWorkGroup{
+				Work:   track.Work,
+				Tracks: []entities.Track{track},
+			})
+			continue
+		}
+
+		groups[index].Tracks = append(groups[index].Tracks, track)
+	}
+
+	// Movements are only reordered when all of them are numbered
+
+	for _, group := range groups {
+		if group.Work == nil || slices.ContainsFunc(group.Tracks, func(track entities.Track) bool {
+			return track.Metadata.MovementNumber <= 0
+		}) {
+			continue
+		}
+
+		slices.SortStableFunc(group.Tracks, func(a, b entities.Track) int {
+			return a.Metadata.MovementNumber - b.Metadata.MovementNumber
+		})
+	}
+
+	return groups
+}
@@ -0,0 +1,129 @@
+package album_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// MergeAlbums keep the album albumId and merge its duplicates into it, tracks,
+// artists and cover of the duplicates are moved to the kept album and their
+// names are remembered so new tracks get linked to the kept album.
+func (u *AlbumUsecase) MergeAlbums(
+	ctx context.Context,
+	albumId int,
+	duplicateIds []int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	album, err := u.albumRepository.GetAlbumById(albumId)
+	if err != nil {
+		if errors.Is(err, repositories.AlbumNotFoundError) {
+			return nil, entities.NewNotFoundError("Album not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if album.UserId != nil && *album.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	duplicates := make([]entities.Album, 0, len(duplicateIds))
+	checkedIds := make([]int, 0, len(duplicateIds))
+
+	for _, duplicateId := range duplicateIds {
+		if duplicateId == album.Id {
+			return nil, entities.NewValidationError("An album can't be merged with itself")
+		}
+
+		if slices.Contains(checkedIds, duplicateId) {
+			continue
+		}
+		checkedIds = append(checkedIds, duplicateId)
+
+		duplicate, err := u.albumRepository.GetAlbumById(duplicateId)
+		if err != nil {
+			if errors.Is(err, repositories.AlbumNotFoundError) {
+				return nil, entities.NewNotFoundError("Album not found")
+			}
+			return nil, entities.NewInternalError(err)
+		}
+
+		if duplicate.UserId != nil && *duplicate.UserId != user.Id {
+			return nil, entities.NewUnauthorizedError()
+		}
+
+		duplicates = append(duplicates, *duplicate)
+	}
+
+	if err := u.albumRepository.MergeAlbums(duplicates, album); err != nil {
+		logger.MainLogger.Error("Couldn't merge albums in Database", err, album.Id)
+		return nil, entities.NewInternalError(errors.New("Failed to merge albums"))
+	}
+
+	// Cover files are only moved once the merge is saved
+	for i := range duplicates {
+		duplicate := &duplicates[i]
+
+		if u.coverStorage.GetAlbumCoverSignature(album) == "" &&
+			u.coverStorage.GetAlbumCoverSignature(duplicate) != "" {
+			if err := u.coverStorage.DuplicateAlbumCover(duplicate, album); err != nil {
+				logger.MainLogger.Warn("Couldn't move album cover", err, duplicate.Id)
+			}
+		}
+
+		if err := u.coverStorage.RemoveAlbumCoverFiles(duplicate); err != nil {
+			logger.MainLogger.Warn("Couldn't delete cover files from storage", err, *duplicate)
+		}
+	}
+
+	if err := u.albumRepository.RefreshAlbumGenres(album); err != nil {
+		logger.MainLogger.Warn("Couldn't refresh album genres", err, album.Id)
+	}
+
+	album, err = u.albumRepository.GetAlbumById(album.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadAlbumsInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadArtistsInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadGenresInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadWorkInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadRoleArtistsInTracks(album.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.albumPresenter.ShowAlbum(ctx, *album), nil
+}
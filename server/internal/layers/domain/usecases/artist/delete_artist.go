@@ -37,5 +37,9 @@ func (u *ArtistUsecase) DeleteArtist(
 		return nil, entities.NewInternalError(errors.New("Failed to delete artist"))
 	}
 
+	if err := u.coverStorage.RemoveArtistCoverFiles(artist); err != nil {
+		logger.MainLogger.Warn("Failed to remove artist Cover", err, artist.Id)
+	}
+
 	return u.artistPresenter.ShowArtist(ctx, *artist), nil
 }
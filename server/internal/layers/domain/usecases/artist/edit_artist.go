@@ -15,6 +15,14 @@ type EditArtistParams struct {
 	Id int
 
 	Name string
+
+	// nil fields keep their current value
+	SortName       *string
+	Disambiguation *string
+
+	Aliases []string
+
+	MusicBrainzArtistId *string
 }
 
 func (u *ArtistUsecase) EditArtist(
@@ -40,6 +48,22 @@ func (u *ArtistUsecase) EditArtist(
 
 	artist.Name = params.Name
 
+	if params.SortName != nil {
+		artist.SortName = *params.SortName
+	}
+
+	if params.Disambiguation != nil {
+		artist.Disambiguation = *params.Disambiguation
+	}
+
+	if params.Aliases != nil {
+		artist.Aliases = params.Aliases
+	}
+
+	if params.MusicBrainzArtistId != nil {
+		artist.MusicBrainzArtistId = *params.MusicBrainzArtistId
+	}
+
 	if err := u.artistRepository.UpdateArtist(artist); err != nil {
 		logger.MainLogger.Error("Couldn't update artist in Database", err, *artist)
 		return nil, entities.NewInternalError(errors.New("Failed to update artist"))
@@ -0,0 +1,73 @@
+package artist_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *ArtistUsecase) GetArtistCoverVariant(
+	ctx context.Context,
+	artistId int,
+	variant entities.CoverVariant,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artist, err := u.artistRepository.GetArtistById(artistId)
+	if err != nil {
+		if errors.Is(err, repositories.ArtistNotFoundError) {
+			return nil, entities.NewNotFoundError("Artist not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if artist.UserId != nil && *artist.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	tracks := make(
+		[]entities.Track,
+		0,
+		len(artist.AllTracks)+len(artist.AllAppearTracks)+len(artist.AllHasRoleTracks),
+	)
+
+	tracks = append(tracks, artist.AllTracks...)
+	tracks = append(tracks, artist.AllAppearTracks...)
+	tracks = append(tracks, artist.AllHasRoleTracks...)
+
+	image, etag, err := u.coverStorage.GetArtistCoverVariant(artist, variant)
+	if err == nil {
+		return &models.ImageAPIResponse{
+			MIMEType: variant.Format.MIMEType(),
+			Data:     image,
+			ETag:     etag,
+		}, nil
+	}
+
+	for i := 0; errors.Is(err, storages.OriginalCoverNotFoundError) && i < len(tracks); i++ {
+		image, etag, err = u.coverStorage.GetTrackCoverVariant(&tracks[i], variant)
+		if err == nil {
+			return &models.ImageAPIResponse{
+				MIMEType: variant.Format.MIMEType(),
+				Data:     image,
+				ETag:     etag,
+			}, nil
+		}
+	}
+
+	if errors.Is(err, storages.OriginalCoverNotFoundError) {
+		return nil, entities.NewNotFoundError("No image available for this artist")
+	}
+	if errors.Is(err, storages.CoverFormatNotSupportedError) {
+		return nil, entities.NewValidationError(err.Error())
+	}
+	return nil, entities.NewInternalError(err)
+}
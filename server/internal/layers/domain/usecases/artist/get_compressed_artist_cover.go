@@ -33,6 +33,17 @@ func (u *ArtistUsecase) GetCompressedArtistCover(
 		return nil, entities.NewUnauthorizedError()
 	}
 
+	image, err := u.coverStorage.GetCompressedArtistCover(artist, quality)
+
+	if err == nil {
+		mtype := mimetype.Detect(image.Bytes())
+
+		return &models.ImageAPIResponse{
+			MIMEType: mtype.String(),
+			Data:     image,
+		}, nil
+	}
+
 	if len(artist.AllTracks) <= 0 && len(artist.AllAppearTracks) <= 0 &&
 		len(artist.AllHasRoleTracks) <= 0 {
 		return nil, entities.NewNotFoundError(
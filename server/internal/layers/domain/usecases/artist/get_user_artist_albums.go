@@ -31,5 +31,7 @@ func (u *ArtistUsecase) GetUserArtistAlbums(
 		return nil, entities.NewUnauthorizedError()
 	}
 
+	u.coverStorage.LoadArtistCoverSignature(artist)
+
 	return u.artistPresenter.ShowAllArtistAlbums(ctx, *artist), nil
 }
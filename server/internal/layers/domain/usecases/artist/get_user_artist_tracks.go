@@ -31,5 +31,7 @@ func (u *ArtistUsecase) GetUserArtistTracks(
 		return nil, entities.NewUnauthorizedError()
 	}
 
+	u.coverStorage.LoadArtistCoverSignature(artist)
+
 	return u.artistPresenter.ShowAllArtistTracks(ctx, *artist), nil
 }
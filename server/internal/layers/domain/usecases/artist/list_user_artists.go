@@ -21,5 +21,9 @@ func (u *ArtistUsecase) ListUserArtists(
 		return nil, entities.NewInternalError(err)
 	}
 
+	for i := range artists {
+		u.coverStorage.LoadArtistCoverSignature(&artists[i])
+	}
+
 	return u.artistPresenter.ShowArtists(ctx, artists), nil
 }
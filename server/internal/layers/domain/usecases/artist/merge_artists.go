@@ -0,0 +1,103 @@
+package artist_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// MergeArtists keep the artist artistId and merge its duplicates into it,
+// tracks, albums and cover of the duplicates are moved to the kept artist and
+// their names are remembered so new tracks get linked to the kept artist.
+func (u *ArtistUsecase) MergeArtists(
+	ctx context.Context,
+	artistId int,
+	duplicateIds []int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artist, err := u.artistRepository.GetArtistById(artistId)
+	if err != nil {
+		if errors.Is(err, repositories.ArtistNotFoundError) {
+			return nil, entities.NewNotFoundError("Artist not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if artist.UserId != nil && *artist.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	duplicates := make([]entities.Artist, 0, len(duplicateIds))
+	checkedIds := make([]int, 0, len(duplicateIds))
+
+	for _, duplicateId := range duplicateIds {
+		if duplicateId == artist.Id {
+			return nil, entities.NewValidationError("An artist can't be merged with itself")
+		}
+
+		if slices.Contains(checkedIds, duplicateId) {
+			continue
+		}
+		checkedIds = append(checkedIds, duplicateId)
+
+		duplicate, err := u.artistRepository.GetArtistById(duplicateId)
+		if err != nil {
+			if errors.Is(err, repositories.ArtistNotFoundError) {
+				return nil, entities.NewNotFoundError("Artist not found")
+			}
+			return nil, entities.NewInternalError(err)
+		}
+
+		if duplicate.UserId != nil && *duplicate.UserId != user.Id {
+			return nil, entities.NewUnauthorizedError()
+		}
+
+		if artist.MusicBrainzArtistId == "" && duplicate.MusicBrainzArtistId != "" {
+			artist.MusicBrainzArtistId = duplicate.MusicBrainzArtistId
+			artist.SortName = duplicate.SortName
+			artist.Disambiguation = duplicate.Disambiguation
+		}
+
+		duplicates = append(duplicates, *duplicate)
+	}
+
+	if err := u.artistRepository.MergeArtists(duplicates, artist); err != nil {
+		logger.MainLogger.Error("Couldn't merge artists in Database", err, artist.Id)
+		return nil, entities.NewInternalError(errors.New("Failed to merge artists"))
+	}
+
+	// Cover files are only moved once the merge is saved
+	for i := range duplicates {
+		duplicate := &duplicates[i]
+
+		if u.coverStorage.GetArtistCoverSignature(artist) == "" &&
+			u.coverStorage.GetArtistCoverSignature(duplicate) != "" {
+			if err := u.coverStorage.DuplicateArtistCover(duplicate, artist); err != nil {
+				logger.MainLogger.Warn("Couldn't move artist cover", err, duplicate.Id)
+			}
+		}
+
+		if err := u.coverStorage.RemoveArtistCoverFiles(duplicate); err != nil {
+			logger.MainLogger.Warn("Failed to remove artist Cover", err, duplicate.Id)
+		}
+	}
+
+	artist, err = u.artistRepository.GetArtistById(artist.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	u.coverStorage.LoadArtistCoverSignature(artist)
+
+	return u.artistPresenter.ShowArtist(ctx, *artist), nil
+}
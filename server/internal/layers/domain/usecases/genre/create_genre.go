@@ -0,0 +1,54 @@
+package genre_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+type CreateGenreParams struct {
+	Name string
+
+	ParentId *int
+
+	Aliases []string
+}
+
+func (u *GenreUsecase) CreateGenre(
+	ctx context.Context,
+	params CreateGenreParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.genreRepository.GetGenreByName(params.Name, user.Id); err == nil {
+		return nil, entities.NewValidationError("A genre with this name already exists")
+	}
+
+	newGenre := entities.Genre{
+		UserId: &user.Id,
+
+		Name: params.Name,
+
+		Aliases: params.Aliases,
+	}
+
+	if err := u.checkGenreParent(user.Id, &newGenre, params.ParentId); err != nil {
+		return nil, err
+	}
+
+	newGenre.ParentId = params.ParentId
+
+	if err := u.genreRepository.CreateGenre(&newGenre); err != nil {
+		logger.MainLogger.Error("Couldn't create genre", err, newGenre)
+		return nil, entities.NewInternalError(errors.New("Failed to create genre"))
+	}
+
+	return u.genrePresenter.ShowGenre(ctx, newGenre), nil
+}
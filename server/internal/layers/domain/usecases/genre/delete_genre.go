@@ -0,0 +1,41 @@
+package genre_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *GenreUsecase) DeleteGenre(
+	ctx context.Context,
+	genreId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genre, err := u.genreRepository.GetGenreById(genreId)
+	if err != nil {
+		if errors.Is(err, repositories.GenreNotFoundError) {
+			return nil, entities.NewNotFoundError("Genre not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if genre.UserId != nil && *genre.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if err := u.genreRepository.DeleteGenre(genre); err != nil {
+		logger.MainLogger.Error("Couldn't delete genre from Database", err, *genre)
+		return nil, entities.NewInternalError(errors.New("Failed to delete genre"))
+	}
+
+	return u.genrePresenter.ShowGenre(ctx, *genre), nil
+}
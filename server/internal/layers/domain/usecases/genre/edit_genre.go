@@ -0,0 +1,130 @@
+package genre_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+type EditGenreParams struct {
+	Id int
+
+	Name string
+
+	// nil fields keep their current value, RemoveParent detach the genre
+	ParentId     *int
+	RemoveParent bool
+
+	Aliases []string
+}
+
+func (u *GenreUsecase) EditGenre(
+	ctx context.Context,
+	params EditGenreParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genre, err := u.genreRepository.GetGenreById(params.Id)
+	if err != nil {
+		if errors.Is(err, repositories.GenreNotFoundError) {
+			return nil, entities.NewNotFoundError("Genre not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if genre.UserId != nil && *genre.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if other, err := u.genreRepository.GetGenreByName(params.Name, user.Id); err == nil &&
+		other.Id != genre.Id {
+		return nil, entities.NewValidationError("A genre with this name already exists")
+	}
+
+	previousName := genre.Name
+
+	genre.Name = params.Name
+
+	if params.RemoveParent {
+		genre.ParentId = nil
+	}
+
+	if params.ParentId != nil {
+		if err := u.checkGenreParent(user.Id, genre, params.ParentId); err != nil {
+			return nil, err
+		}
+
+		genre.ParentId = params.ParentId
+	}
+
+	if params.Aliases != nil {
+		genre.Aliases = params.Aliases
+	}
+
+	// The tracks are still tagged with the previous name, without an alias the
+	// next link would create the genre again
+	if !strings.EqualFold(previousName, genre.Name) {
+		genre.Aliases = append(slices.Clone(genre.Aliases), previousName)
+	}
+
+	if err := u.genreRepository.UpdateGenre(genre); err != nil {
+		logger.MainLogger.Error("Couldn't update genre in Database", err, *genre)
+		return nil, entities.NewInternalError(errors.New("Failed to update genre"))
+	}
+
+	err = u.genreRepository.LoadStatsInGenre(genre)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.genrePresenter.ShowGenre(ctx, *genre), nil
+}
+
+// checkGenreParent make sure the parent belong to the user and isn't the
+// genre itself or one of its sub genres which would create a cycle.
+func (u *GenreUsecase) checkGenreParent(
+	userId int,
+	genre *entities.Genre,
+	parentId *int,
+) error {
+	if parentId == nil {
+		return nil
+	}
+
+	parent, err := u.genreRepository.GetGenreById(*parentId)
+	if err != nil {
+		if errors.Is(err, repositories.GenreNotFoundError) {
+			return entities.NewNotFoundError("Parent genre not found")
+		}
+		return entities.NewInternalError(err)
+	}
+
+	if parent.UserId != nil && *parent.UserId != userId {
+		return entities.NewUnauthorizedError()
+	}
+
+	if genre.Id == 0 {
+		return nil
+	}
+
+	descendantIds, err := u.genreRepository.GetGenreDescendantIds(genre.Id)
+	if err != nil {
+		return entities.NewInternalError(err)
+	}
+
+	if slices.Contains(descendantIds, parent.Id) {
+		return entities.NewValidationError("A genre can't be placed under itself or its sub genres")
+	}
+
+	return nil
+}
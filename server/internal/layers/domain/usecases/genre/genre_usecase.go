@@ -0,0 +1,25 @@
+package genre_usecase
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/presentation/presenters"
+)
+
+type GenreUsecase struct {
+	genreRepository repositories.GenreRepository
+	coverStorage    storages.CoverStorage
+	genrePresenter  presenters.GenrePresenter
+}
+
+func NewGenreUsecase(
+	genreRepository repositories.GenreRepository,
+	coverStorage storages.CoverStorage,
+	genrePresenter presenters.GenrePresenter,
+) GenreUsecase {
+	return GenreUsecase{
+		genreRepository,
+		coverStorage,
+		genrePresenter,
+	}
+}
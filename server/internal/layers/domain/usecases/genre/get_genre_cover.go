@@ -0,0 +1,55 @@
+package genre_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// GetGenreCover return the cover of the first track of the genre having one.
+func (u *GenreUsecase) GetGenreCover(
+	ctx context.Context,
+	genreId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genre, err := u.genreRepository.GetGenreById(genreId)
+	if err != nil {
+		if errors.Is(err, repositories.GenreNotFoundError) {
+			return nil, entities.NewNotFoundError("Genre not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if genre.UserId != nil && *genre.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	track, err := u.genreRepository.GetGenreCoverTrack(genre)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("No image available for this genre")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	image, err := u.coverStorage.GetOriginalTrackCover(track)
+	if err != nil {
+		return nil, entities.NewNotFoundError("No image available for this genre")
+	}
+
+	mtype := mimetype.Detect(image.Bytes())
+
+	return &models.ImageAPIResponse{
+		MIMEType: mtype.String(),
+		Data:     image,
+	}, nil
+}
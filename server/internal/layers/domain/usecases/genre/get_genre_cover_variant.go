@@ -0,0 +1,60 @@
+package genre_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *GenreUsecase) GetGenreCoverVariant(
+	ctx context.Context,
+	genreId int,
+	variant entities.CoverVariant,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genre, err := u.genreRepository.GetGenreById(genreId)
+	if err != nil {
+		if errors.Is(err, repositories.GenreNotFoundError) {
+			return nil, entities.NewNotFoundError("Genre not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if genre.UserId != nil && *genre.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	track, err := u.genreRepository.GetGenreCoverTrack(genre)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("No image available for this genre")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	image, etag, err := u.coverStorage.GetTrackCoverVariant(track, variant)
+	if err == nil {
+		return &models.ImageAPIResponse{
+			MIMEType: variant.Format.MIMEType(),
+			Data:     image,
+			ETag:     etag,
+		}, nil
+	}
+
+	if errors.Is(err, storages.OriginalCoverNotFoundError) {
+		return nil, entities.NewNotFoundError("No image available for this genre")
+	}
+	if errors.Is(err, storages.CoverFormatNotSupportedError) {
+		return nil, entities.NewValidationError(err.Error())
+	}
+	return nil, entities.NewInternalError(err)
+}
@@ -0,0 +1,44 @@
+package genre_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *GenreUsecase) GetUserGenreAlbums(
+	ctx context.Context,
+	genreId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genre, err := u.genreRepository.GetGenreById(genreId)
+	if err != nil {
+		if errors.Is(err, repositories.GenreNotFoundError) {
+			return nil, entities.NewNotFoundError("Genre not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if genre.UserId != nil && *genre.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	err = u.genreRepository.LoadAlbumsInGenre(genre)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	for i := range genre.Albums {
+		u.coverStorage.LoadAlbumCoverSignature(&genre.Albums[i])
+	}
+
+	return u.genrePresenter.ShowGenreAlbums(ctx, *genre), nil
+}
@@ -0,0 +1,30 @@
+package genre_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *GenreUsecase) ListUserGenres(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genres, err := u.genreRepository.GetAllGenresFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.genreRepository.LoadStatsInGenres(user.Id, genres)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.genrePresenter.ShowGenres(ctx, genres), nil
+}
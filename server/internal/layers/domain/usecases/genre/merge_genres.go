@@ -0,0 +1,93 @@
+package genre_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// MergeGenres keep the genre genreId and merge its duplicates into it, their
+// tracks, albums and sub genres are moved to the kept genre and their names
+// become aliases so new tracks get linked to the kept genre.
+func (u *GenreUsecase) MergeGenres(
+	ctx context.Context,
+	genreId int,
+	duplicateIds []int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genre, err := u.genreRepository.GetGenreById(genreId)
+	if err != nil {
+		if errors.Is(err, repositories.GenreNotFoundError) {
+			return nil, entities.NewNotFoundError("Genre not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if genre.UserId != nil && *genre.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	duplicates := make([]entities.Genre, 0, len(duplicateIds))
+
+	for _, duplicateId := range duplicateIds {
+		if duplicateId == genre.Id {
+			return nil, entities.NewValidationError("A genre can't be merged with itself")
+		}
+
+		duplicate, err := u.genreRepository.GetGenreById(duplicateId)
+		if err != nil {
+			if errors.Is(err, repositories.GenreNotFoundError) {
+				return nil, entities.NewNotFoundError("Genre not found")
+			}
+			return nil, entities.NewInternalError(err)
+		}
+
+		if duplicate.UserId != nil && *duplicate.UserId != user.Id {
+			return nil, entities.NewUnauthorizedError()
+		}
+
+		duplicates = append(duplicates, *duplicate)
+	}
+
+	for i := range duplicates {
+		duplicate := &duplicates[i]
+
+		// Parents may have changed with the previous merge
+
+		genre, err = u.genreRepository.GetGenreById(genre.Id)
+		if err != nil {
+			return nil, entities.NewInternalError(err)
+		}
+
+		duplicate, err = u.genreRepository.GetGenreById(duplicate.Id)
+		if err != nil {
+			return nil, entities.NewInternalError(err)
+		}
+
+		if err := u.genreRepository.MergeGenres(duplicate, genre); err != nil {
+			logger.MainLogger.Error("Couldn't merge genre in Database", err, *duplicate)
+			return nil, entities.NewInternalError(errors.New("Failed to merge genres"))
+		}
+	}
+
+	genre, err = u.genreRepository.GetGenreById(genre.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.genreRepository.LoadStatsInGenre(genre)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.genrePresenter.ShowGenre(ctx, *genre), nil
+}
@@ -0,0 +1,58 @@
+package listening_stats_usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *ListeningStatsUsecase) GetUserListeningStreaks(
+	ctx context.Context,
+	period entities.ListeningPeriod,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	streaks, err := u.listeningStatsRepository.GetListeningStreaks(user.Id, period)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.listeningStatsPresenter.ShowListeningStreaks(
+		groupListeningStreaks(streaks, time.Now(), period.UtcOffset),
+	), nil
+}
+
+// groupListeningStreaks expects the streaks to be sorted from the most recent
+// like the repository returns them
+func groupListeningStreaks(
+	streaks []entities.ListeningStreak,
+	now time.Time,
+	utcOffset int,
+) entities.ListeningStreaks {
+	result := entities.ListeningStreaks{}
+
+	for i := range streaks {
+		if result.Longest == nil || streaks[i].Days > result.Longest.Days {
+			result.Longest = &streaks[i]
+		}
+	}
+
+	if len(streaks) == 0 {
+		return result
+	}
+
+	today := now.UTC().Add(time.Duration(utcOffset) * time.Minute)
+
+	if streaks[0].To == today.Format(time.DateOnly) ||
+		streaks[0].To == today.AddDate(0, 0, -1).Format(time.DateOnly) {
+		result.Current = &streaks[0]
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package listening_stats_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *ListeningStatsUsecase) GetUserListeningSummary(
+	ctx context.Context,
+	period entities.ListeningPeriod,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := u.listeningStatsRepository.GetListeningSummary(user.Id, period)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.listeningStatsPresenter.ShowListeningSummary(summary), nil
+}
@@ -0,0 +1,27 @@
+package listening_stats_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *ListeningStatsUsecase) GetUserListeningTimeline(
+	ctx context.Context,
+	period entities.ListeningPeriod,
+	interval entities.ListeningInterval,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, err := u.listeningStatsRepository.GetListeningTimeline(user.Id, period, interval)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.listeningStatsPresenter.ShowListeningTimeline(buckets), nil
+}
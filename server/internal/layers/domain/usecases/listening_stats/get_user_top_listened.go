@@ -0,0 +1,33 @@
+package listening_stats_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *ListeningStatsUsecase) GetUserTopListened(
+	ctx context.Context,
+	category entities.ListeningCategory,
+	period entities.ListeningPeriod,
+	limit int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := u.listeningStatsRepository.GetTopListened(user.Id, category, period, limit)
+	if err != nil {
+		if errors.Is(err, repositories.UnknownListeningCategoryError) {
+			return nil, entities.NewValidationError("Unknown category \"" + string(category) + "\"")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.listeningStatsPresenter.ShowListeningCounts(counts), nil
+}
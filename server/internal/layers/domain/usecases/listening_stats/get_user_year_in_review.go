@@ -0,0 +1,95 @@
+package listening_stats_usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+const yearInReviewTopLimit = 10
+
+func (u *ListeningStatsUsecase) GetUserYearInReview(
+	ctx context.Context,
+	year int,
+	utcOffset int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	location := time.FixedZone("", utcOffset*60)
+
+	period := entities.ListeningPeriod{
+		From:      time.Date(year, time.January, 1, 0, 0, 0, 0, location),
+		To:        time.Date(year+1, time.January, 1, 0, 0, 0, 0, location),
+		UtcOffset: utcOffset,
+	}
+
+	review := entities.ListeningYearInReview{
+		Year: year,
+	}
+
+	review.Summary, err = u.listeningStatsRepository.GetListeningSummary(user.Id, period)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	for category, top := range map[entities.ListeningCategory]*[]entities.ListeningCount{
+		entities.ListeningCategoryTracks:  &review.TopTracks,
+		entities.ListeningCategoryAlbums:  &review.TopAlbums,
+		entities.ListeningCategoryArtists: &review.TopArtists,
+		entities.ListeningCategoryGenres:  &review.TopGenres,
+	} {
+		*top, err = u.listeningStatsRepository.GetTopListened(
+			user.Id,
+			category,
+			period,
+			yearInReviewTopLimit,
+		)
+		if err != nil {
+			return nil, entities.NewInternalError(err)
+		}
+	}
+
+	review.Months, err = u.listeningStatsRepository.GetListeningTimeline(
+		user.Id,
+		period,
+		entities.ListeningIntervalMonth,
+	)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	review.Heatmap, err = u.listeningStatsRepository.GetListeningHeatmap(user.Id, period)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	days, err := u.listeningStatsRepository.GetListeningTimeline(
+		user.Id,
+		period,
+		entities.ListeningIntervalDay,
+	)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	for i := range days {
+		if review.BusiestDay == nil || days[i].ListenedTime > review.BusiestDay.ListenedTime {
+			review.BusiestDay = &days[i]
+		}
+	}
+
+	streaks, err := u.listeningStatsRepository.GetListeningStreaks(user.Id, period)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	review.LongestStreak = groupListeningStreaks(streaks, time.Now(), utcOffset).Longest
+
+	return u.listeningStatsPresenter.ShowYearInReview(review), nil
+}
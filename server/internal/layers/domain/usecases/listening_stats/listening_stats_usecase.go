@@ -0,0 +1,21 @@
+package listening_stats_usecase
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/presentation/presenters"
+)
+
+type ListeningStatsUsecase struct {
+	listeningStatsRepository repositories.ListeningStatsRepository
+	listeningStatsPresenter  presenters.ListeningStatsPresenter
+}
+
+func NewListeningStatsUsecase(
+	listeningStatsRepository repositories.ListeningStatsRepository,
+	listeningStatsPresenter presenters.ListeningStatsPresenter,
+) ListeningStatsUsecase {
+	return ListeningStatsUsecase{
+		listeningStatsRepository,
+		listeningStatsPresenter,
+	}
+}
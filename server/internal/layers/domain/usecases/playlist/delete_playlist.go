@@ -14,6 +14,7 @@ import (
 func (u *PlaylistUsecase) DeletePlaylist(
 	ctx context.Context,
 	playlistId int,
+	deviceId string,
 ) (models.APIResponse, error) {
 	user, err := helpers.ExtractCurrentLoggedUser(ctx)
 	if err != nil {
@@ -32,6 +33,15 @@ func (u *PlaylistUsecase) DeletePlaylist(
 		return nil, entities.NewUnauthorizedError()
 	}
 
+	if err := u.recordPlaylistRevision(
+		*playlist,
+		[]int{},
+		entities.PlaylistRevisionDelete,
+		deviceId,
+	); err != nil {
+		return nil, err
+	}
+
 	if err := u.coverStorage.RemovePlaylistCoverFiles(playlist); err != nil {
 		logger.MainLogger.Warn("Couldn't delete cover files from storage", err, *playlist)
 	}
@@ -16,6 +16,8 @@ type EditPlaylistParams struct {
 
 	Name        string
 	Description string
+
+	DeviceId string
 }
 
 func (u *PlaylistUsecase) EditPlaylist(
@@ -44,6 +46,17 @@ func (u *PlaylistUsecase) EditPlaylist(
 		return nil, entities.NewInternalError(err)
 	}
 
+	if playlist.Name != params.Name || playlist.Description != params.Description {
+		if err := u.recordPlaylistRevision(
+			*playlist,
+			getPlaylistTrackIds(*playlist),
+			entities.PlaylistRevisionEdit,
+			params.DeviceId,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	playlist.Name = params.Name
 	playlist.Description = params.Description
 
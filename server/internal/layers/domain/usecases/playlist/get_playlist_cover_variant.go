@@ -0,0 +1,57 @@
+package playlist_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *PlaylistUsecase) GetPlaylistCoverVariant(
+	ctx context.Context,
+	playlistId int,
+	variant entities.CoverVariant,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := u.playlistRepository.GetPlaylist(playlistId)
+	if err != nil {
+		if errors.Is(err, repositories.PlaylistNotFoundError) {
+			return nil, entities.NewNotFoundError("Playlist not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if playlist.UserId != nil && *playlist.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	image, etag, err := u.coverStorage.GetPlaylistCoverVariant(playlist, variant)
+
+	for i := 0; errors.Is(err, storages.OriginalCoverNotFoundError) && i < len(playlist.Tracks); i++ {
+		image, etag, err = u.coverStorage.GetTrackCoverVariant(&playlist.Tracks[i], variant)
+	}
+
+	if err != nil {
+		if errors.Is(err, storages.OriginalCoverNotFoundError) {
+			return nil, entities.NewNotFoundError("No image available for this playlist")
+		}
+		if errors.Is(err, storages.CoverFormatNotSupportedError) {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	return &models.ImageAPIResponse{
+		MIMEType: variant.Format.MIMEType(),
+		Data:     image,
+		ETag:     etag,
+	}, nil
+}
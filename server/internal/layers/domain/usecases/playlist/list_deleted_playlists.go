@@ -0,0 +1,28 @@
+package playlist_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *PlaylistUsecase) ListDeletedPlaylists(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := u.playlistRepository.GetDeletedPlaylistRevisionsFromUser(
+		user.Id,
+		getPlaylistRevisionRetentionStart(),
+	)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.playlistPresenter.ShowPlaylistRevisions(revisions), nil
+}
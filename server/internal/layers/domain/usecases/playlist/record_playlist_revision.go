@@ -0,0 +1,130 @@
+package playlist_usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+const defaultPlaylistRevisionRetentionDays = 30
+
+// Revisions (and deleted playlists) older than this window are purged and
+// can't be restored anymore.
+func getPlaylistRevisionRetentionStart() time.Time {
+	days := helpers.GetEnvInt(
+		"PLAYLIST_REVISION_RETENTION_DAYS",
+		defaultPlaylistRevisionRetentionDays,
+	)
+
+	return time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+}
+
+func getPlaylistTrackIds(playlist entities.Playlist) []int {
+	trackIds := make([]int, len(playlist.Tracks))
+
+	for i, track := range playlist.Tracks {
+		trackIds[i] = track.Id
+	}
+
+	return trackIds
+}
+
+// diffPlaylistTrackIds treat both lists as multisets since a playlist can
+// contain the same track more than once.
+func diffPlaylistTrackIds(previous []int, next []int) ([]int, []int) {
+	counts := map[int]int{}
+
+	for _, trackId := range previous {
+		counts[trackId]++
+	}
+
+	added := []int{}
+
+	for _, trackId := range next {
+		if counts[trackId] > 0 {
+			counts[trackId]--
+			continue
+		}
+		added = append(added, trackId)
+	}
+
+	removed := []int{}
+
+	for _, trackId := range previous {
+		if counts[trackId] > 0 {
+			counts[trackId]--
+			removed = append(removed, trackId)
+		}
+	}
+
+	return added, removed
+}
+
+func (u *PlaylistUsecase) recordPlaylistRevision(
+	previous entities.Playlist,
+	nextTrackIds []int,
+	action entities.PlaylistRevisionAction,
+	deviceId string,
+) error {
+	previousTrackIds := getPlaylistTrackIds(previous)
+
+	added, removed := diffPlaylistTrackIds(previousTrackIds, nextTrackIds)
+
+	revision := entities.PlaylistRevision{
+		PlaylistId: previous.Id,
+		UserId:     previous.UserId,
+
+		DeviceId: deviceId,
+		Action:   action,
+
+		Name:        previous.Name,
+		Description: previous.Description,
+
+		TrackIds: previousTrackIds,
+
+		AddedTrackIds:   added,
+		RemovedTrackIds: removed,
+	}
+
+	if err := u.playlistRepository.CreatePlaylistRevision(&revision); err != nil {
+		logger.MainLogger.Error("Couldn't save playlist revision in Database", err, previous)
+		return entities.NewInternalError(errors.New("Failed to save playlist revision"))
+	}
+
+	if err := u.playlistRepository.DeleteExpiredPlaylistRevisions(
+		getPlaylistRevisionRetentionStart(),
+	); err != nil {
+		logger.MainLogger.Warn("Couldn't purge expired playlist revisions", err)
+	}
+
+	return nil
+}
+
+func (u *PlaylistUsecase) loadPlaylistRevisionTracks(
+	user entities.User,
+	revision entities.PlaylistRevision,
+) ([]entities.Track, error) {
+	tracks := make([]entities.Track, 0, len(revision.TrackIds))
+
+	for _, trackId := range revision.TrackIds {
+		track, err := u.trackRepository.GetTrack(trackId)
+		if err != nil {
+			if errors.Is(err, repositories.TrackNotFoundError) {
+				continue
+			}
+			return nil, entities.NewInternalError(err)
+		}
+
+		if track.UserId != nil && *track.UserId != user.Id {
+			continue
+		}
+
+		tracks = append(tracks, *track)
+	}
+
+	return tracks, nil
+}
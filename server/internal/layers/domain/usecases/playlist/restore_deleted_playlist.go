@@ -0,0 +1,83 @@
+package playlist_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *PlaylistUsecase) RestoreDeletedPlaylist(
+	ctx context.Context,
+	playlistId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.playlistRepository.GetPlaylist(playlistId)
+	if err == nil {
+		return nil, entities.NewValidationError("Playlist is not deleted")
+	}
+	if !errors.Is(err, repositories.PlaylistNotFoundError) {
+		return nil, entities.NewInternalError(err)
+	}
+
+	revision, err := u.playlistRepository.GetLatestPlaylistRevision(
+		playlistId,
+		getPlaylistRevisionRetentionStart(),
+	)
+	if err != nil {
+		if errors.Is(err, repositories.PlaylistRevisionNotFoundError) {
+			return nil, entities.NewNotFoundError("Deleted playlist not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if revision.Action != entities.PlaylistRevisionDelete {
+		return nil, entities.NewNotFoundError("Deleted playlist not found")
+	}
+
+	if revision.UserId != nil && *revision.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	tracks, err := u.loadPlaylistRevisionTracks(user, *revision)
+	if err != nil {
+		return nil, err
+	}
+
+	trackIds := make([]int, len(tracks))
+
+	for i, track := range tracks {
+		trackIds[i] = track.Id
+	}
+
+	playlist := entities.Playlist{
+		Id: revision.PlaylistId,
+
+		UserId: revision.UserId,
+
+		Name:        revision.Name,
+		Description: revision.Description,
+	}
+
+	if err := u.playlistRepository.RestoreDeletedPlaylist(&playlist, trackIds); err != nil {
+		logger.MainLogger.Error("Couldn't restore playlist in Database", err, playlist)
+		return nil, entities.NewInternalError(errors.New("Failed to restore playlist"))
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(playlist.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	u.coverStorage.LoadPlaylistCoverSignature(&playlist)
+
+	return u.playlistPresenter.ShowPlaylist(ctx, playlist), nil
+}
@@ -0,0 +1,117 @@
+package playlist_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+type RestorePlaylistRevisionParams struct {
+	Id int
+
+	RevisionId int
+
+	DeviceId string
+}
+
+func (u *PlaylistUsecase) RestorePlaylistRevision(
+	ctx context.Context,
+	params RestorePlaylistRevisionParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := u.playlistRepository.GetPlaylist(params.Id)
+	if err != nil {
+		if errors.Is(err, repositories.PlaylistNotFoundError) {
+			return nil, entities.NewNotFoundError("Playlist not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if playlist.UserId != nil && *playlist.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	revision, err := u.playlistRepository.GetPlaylistRevision(params.RevisionId)
+	if err != nil {
+		if errors.Is(err, repositories.PlaylistRevisionNotFoundError) {
+			return nil, entities.NewNotFoundError("Playlist revision not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if revision.PlaylistId != playlist.Id ||
+		revision.CreatedAt.Before(getPlaylistRevisionRetentionStart()) {
+		return nil, entities.NewNotFoundError("Playlist revision not found")
+	}
+
+	return u.applyPlaylistRevision(
+		ctx,
+		user,
+		playlist,
+		*revision,
+		entities.PlaylistRevisionRestore,
+		params.DeviceId,
+	)
+}
+
+func (u *PlaylistUsecase) applyPlaylistRevision(
+	ctx context.Context,
+	user entities.User,
+	playlist *entities.Playlist,
+	revision entities.PlaylistRevision,
+	action entities.PlaylistRevisionAction,
+	deviceId string,
+) (models.APIResponse, error) {
+	tracks, err := u.loadPlaylistRevisionTracks(user, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	restoredTrackIds := make([]int, len(tracks))
+
+	for i, track := range tracks {
+		restoredTrackIds[i] = track.Id
+	}
+
+	if err := u.recordPlaylistRevision(
+		*playlist,
+		restoredTrackIds,
+		action,
+		deviceId,
+	); err != nil {
+		return nil, err
+	}
+
+	playlist.Name = revision.Name
+	playlist.Description = revision.Description
+
+	if err := u.playlistRepository.UpdatePlaylist(playlist); err != nil {
+		logger.MainLogger.Error("Couldn't update playlist in Database", err, *playlist)
+		return nil, entities.NewInternalError(errors.New("Failed to restore playlist revision"))
+	}
+
+	playlist.Tracks = tracks
+
+	if err := u.playlistRepository.SetPlaylistTracks(playlist); err != nil {
+		logger.MainLogger.Error("Couldn't update playlist tracks in Database", err, *playlist)
+		return nil, entities.NewInternalError(errors.New("Failed to restore playlist revision"))
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(playlist.Tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	u.coverStorage.LoadPlaylistCoverSignature(playlist)
+
+	return u.playlistPresenter.ShowPlaylist(ctx, *playlist), nil
+}
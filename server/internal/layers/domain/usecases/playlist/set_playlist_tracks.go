@@ -3,6 +3,7 @@ package playlist_usecase
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/gungun974/Melodink/server/internal/helpers"
 	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
@@ -15,6 +16,8 @@ type SetPlaylistTracksParams struct {
 	Id int
 
 	TrackIds []int
+
+	DeviceId string
 }
 
 func (u *PlaylistUsecase) SetPlaylistTracks(
@@ -56,6 +59,17 @@ func (u *PlaylistUsecase) SetPlaylistTracks(
 		tracks[i] = *track
 	}
 
+	if !slices.Equal(getPlaylistTrackIds(*playlist), params.TrackIds) {
+		if err := u.recordPlaylistRevision(
+			*playlist,
+			params.TrackIds,
+			entities.PlaylistRevisionTracks,
+			params.DeviceId,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	playlist.Tracks = tracks
 
 	if err := u.playlistRepository.SetPlaylistTracks(playlist); err != nil {
@@ -0,0 +1,86 @@
+package playlist_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+type UndoPlaylistParams struct {
+	Id int
+
+	DeviceId string
+}
+
+func (u *PlaylistUsecase) UndoPlaylist(
+	ctx context.Context,
+	params UndoPlaylistParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := u.playlistRepository.GetPlaylist(params.Id)
+	if err != nil {
+		if errors.Is(err, repositories.PlaylistNotFoundError) {
+			return nil, entities.NewNotFoundError("Playlist not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if playlist.UserId != nil && *playlist.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	revisions, err := u.playlistRepository.GetPlaylistRevisions(
+		playlist.Id,
+		getPlaylistRevisionRetentionStart(),
+	)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	revision := findPlaylistUndoRevision(revisions)
+	if revision == nil {
+		return nil, entities.NewNotFoundError("Nothing to undo")
+	}
+
+	return u.applyPlaylistRevision(
+		ctx,
+		user,
+		playlist,
+		*revision,
+		entities.PlaylistRevisionUndo,
+		params.DeviceId,
+	)
+}
+
+// findPlaylistUndoRevision walks the revisions from the newest one, each undo
+// already made cancels the change before it, the first change left is the
+// one to undo
+func findPlaylistUndoRevision(
+	revisions []entities.PlaylistRevision,
+) *entities.PlaylistRevision {
+	undoneCount := 0
+
+	for i, revision := range revisions {
+		if revision.Action == entities.PlaylistRevisionUndo {
+			undoneCount++
+			continue
+		}
+
+		if undoneCount > 0 {
+			undoneCount--
+			continue
+		}
+
+		return &revisions[i]
+	}
+
+	return nil
+}
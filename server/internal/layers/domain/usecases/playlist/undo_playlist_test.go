@@ -0,0 +1,103 @@
+package playlist_usecase
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+// playlistHistory replays what the usecases store, each change saves the
+// state before it and an undo restores the revision found by
+// findPlaylistUndoRevision
+type playlistHistory struct {
+	trackIds []int
+
+	// revisions are kept newest first like GetPlaylistRevisions returns them
+	revisions []entities.PlaylistRevision
+}
+
+func (h *playlistHistory) record(action entities.PlaylistRevisionAction, trackIds []int) {
+	h.revisions = slices.Insert(h.revisions, 0, entities.PlaylistRevision{
+		Id:       len(h.revisions) + 1,
+		Action:   action,
+		TrackIds: slices.Clone(h.trackIds),
+	})
+	h.trackIds = trackIds
+}
+
+func (h *playlistHistory) undo(t *testing.T) {
+	revision := findPlaylistUndoRevision(h.revisions)
+	if revision == nil {
+		t.Fatalf("expected a revision to undo with %v", h.trackIds)
+	}
+
+	h.record(entities.PlaylistRevisionUndo, revision.TrackIds)
+}
+
+func TestUndoPlaylistTwiceGoesBackTwoChanges(t *testing.T) {
+	history := playlistHistory{trackIds: []int{}}
+
+	history.record(entities.PlaylistRevisionTracks, []int{1})
+	history.record(entities.PlaylistRevisionTracks, []int{1, 2})
+	history.record(entities.PlaylistRevisionTracks, []int{1, 2, 3})
+
+	history.undo(t)
+
+	if !slices.Equal(history.trackIds, []int{1, 2}) {
+		t.Fatalf("first undo should restore [1 2], got %v", history.trackIds)
+	}
+
+	history.undo(t)
+
+	if !slices.Equal(history.trackIds, []int{1}) {
+		t.Fatalf("second undo should restore [1], got %v", history.trackIds)
+	}
+
+	history.undo(t)
+
+	if !slices.Equal(history.trackIds, []int{}) {
+		t.Fatalf("third undo should restore [], got %v", history.trackIds)
+	}
+
+	if revision := findPlaylistUndoRevision(history.revisions); revision != nil {
+		t.Fatalf("nothing should be left to undo, got revision %d", revision.Id)
+	}
+}
+
+func TestUndoPlaylistAfterNewChange(t *testing.T) {
+	history := playlistHistory{trackIds: []int{}}
+
+	history.record(entities.PlaylistRevisionTracks, []int{1})
+	history.record(entities.PlaylistRevisionTracks, []int{1, 2})
+
+	history.undo(t)
+
+	history.record(entities.PlaylistRevisionTracks, []int{1, 4})
+
+	history.undo(t)
+
+	if !slices.Equal(history.trackIds, []int{1}) {
+		t.Fatalf("undo should restore [1], got %v", history.trackIds)
+	}
+
+	history.undo(t)
+
+	if !slices.Equal(history.trackIds, []int{}) {
+		t.Fatalf("undo should restore [], got %v", history.trackIds)
+	}
+}
+
+func TestUndoPlaylistUndoesExplicitRestore(t *testing.T) {
+	history := playlistHistory{trackIds: []int{}}
+
+	history.record(entities.PlaylistRevisionTracks, []int{1})
+	history.record(entities.PlaylistRevisionTracks, []int{1, 2})
+	history.record(entities.PlaylistRevisionRestore, []int{})
+
+	history.undo(t)
+
+	if !slices.Equal(history.trackIds, []int{1, 2}) {
+		t.Fatalf("undo should cancel the restore and give [1 2], got %v", history.trackIds)
+	}
+}
@@ -0,0 +1,56 @@
+package scrobbler_usecase
+
+import (
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/scrobblers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+// authenticateScrobbler check the ListenBrainz token or exchange the Last.fm
+// username and password for a session key before the scrobbler is saved
+func (u *ScrobblerUsecase) authenticateScrobbler(
+	scrobbler *entities.Scrobbler,
+	username string,
+	password string,
+) error {
+	var err error
+
+	switch scrobbler.Service {
+	case entities.ScrobblerServiceListenBrainz:
+		if helpers.IsEmptyOrWhitespace(scrobbler.Token) {
+			return entities.NewValidationError("token is required for ListenBrainz")
+		}
+
+		err = u.listenBrainzScrobbler.ValidateToken(*scrobbler)
+	case entities.ScrobblerServiceLastFm:
+		if helpers.IsEmptyOrWhitespace(scrobbler.ApiKey) ||
+			helpers.IsEmptyOrWhitespace(scrobbler.ApiSecret) {
+			return entities.NewValidationError("api_key and api_secret are required for Last.fm")
+		}
+
+		if !helpers.IsEmptyOrWhitespace(username) {
+			scrobbler.SessionKey, err = u.lastFmScrobbler.GetMobileSession(
+				*scrobbler,
+				username,
+				password,
+			)
+		} else if helpers.IsEmptyOrWhitespace(scrobbler.SessionKey) {
+			return entities.NewValidationError(
+				"session_key or username and password are required for Last.fm",
+			)
+		}
+	default:
+		return entities.NewValidationError("Unknown service \"" + string(scrobbler.Service) + "\"")
+	}
+
+	if err != nil {
+		if errors.Is(err, scrobblers.ScrobbleRejectedError) {
+			return entities.NewValidationError("The service refused the credentials")
+		}
+		return entities.NewInternalError(err)
+	}
+
+	return nil
+}
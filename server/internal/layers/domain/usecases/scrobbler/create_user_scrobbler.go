@@ -0,0 +1,68 @@
+package scrobbler_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+type CreateUserScrobblerParams struct {
+	Service entities.ScrobblerService
+	Name    string
+
+	BaseUrl string
+
+	Token string
+
+	ApiKey     string
+	ApiSecret  string
+	SessionKey string
+
+	// Username and Password are only used to get a Last.fm session key
+	Username string
+	Password string
+
+	Enabled bool
+}
+
+func (u *ScrobblerUsecase) CreateUserScrobbler(
+	ctx context.Context,
+	params CreateUserScrobblerParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scrobbler := entities.Scrobbler{
+		UserId: user.Id,
+
+		Service: params.Service,
+		Name:    params.Name,
+
+		BaseUrl: params.BaseUrl,
+
+		Token: params.Token,
+
+		ApiKey:     params.ApiKey,
+		ApiSecret:  params.ApiSecret,
+		SessionKey: params.SessionKey,
+
+		Enabled: params.Enabled,
+	}
+
+	if err := u.authenticateScrobbler(&scrobbler, params.Username, params.Password); err != nil {
+		return nil, err
+	}
+
+	if err := u.scrobblerRepository.CreateScrobbler(&scrobbler); err != nil {
+		logger.MainLogger.Error("Couldn't create scrobbler in Database", err, scrobbler.Service)
+		return nil, entities.NewInternalError(errors.New("Failed to create scrobbler"))
+	}
+
+	return u.scrobblerPresenter.ShowScrobbler(scrobbler), nil
+}
@@ -0,0 +1,41 @@
+package scrobbler_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *ScrobblerUsecase) DeleteUserScrobbler(
+	ctx context.Context,
+	scrobblerId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scrobbler, err := u.scrobblerRepository.GetScrobblerById(scrobblerId)
+	if err != nil {
+		if errors.Is(err, repositories.ScrobblerNotFoundError) {
+			return nil, entities.NewNotFoundError("Scrobbler not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if scrobbler.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if err := u.scrobblerRepository.DeleteScrobbler(scrobbler); err != nil {
+		logger.MainLogger.Error("Couldn't delete scrobbler from Database", err, scrobbler.Id)
+		return nil, entities.NewInternalError(errors.New("Failed to delete scrobbler"))
+	}
+
+	return u.scrobblerPresenter.ShowScrobbler(*scrobbler), nil
+}
@@ -0,0 +1,105 @@
+package scrobbler_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// EditUserScrobblerParams only change the fields that are not nil
+type EditUserScrobblerParams struct {
+	Id int
+
+	Name *string
+
+	BaseUrl *string
+
+	Token *string
+
+	ApiKey     *string
+	ApiSecret  *string
+	SessionKey *string
+
+	Username *string
+	Password *string
+
+	Enabled *bool
+}
+
+func (u *ScrobblerUsecase) EditUserScrobbler(
+	ctx context.Context,
+	params EditUserScrobblerParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scrobbler, err := u.scrobblerRepository.GetScrobblerById(params.Id)
+	if err != nil {
+		if errors.Is(err, repositories.ScrobblerNotFoundError) {
+			return nil, entities.NewNotFoundError("Scrobbler not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if scrobbler.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if params.Name != nil {
+		scrobbler.Name = *params.Name
+	}
+
+	if params.Enabled != nil {
+		scrobbler.Enabled = *params.Enabled
+	}
+
+	credentialsChanged := false
+
+	for _, field := range []struct {
+		value  *string
+		target *string
+	}{
+		{params.BaseUrl, &scrobbler.BaseUrl},
+		{params.Token, &scrobbler.Token},
+		{params.ApiKey, &scrobbler.ApiKey},
+		{params.ApiSecret, &scrobbler.ApiSecret},
+		{params.SessionKey, &scrobbler.SessionKey},
+	} {
+		if field.value != nil && *field.value != *field.target {
+			*field.target = *field.value
+			credentialsChanged = true
+		}
+	}
+
+	username := ""
+	password := ""
+
+	if params.Username != nil {
+		username = *params.Username
+		credentialsChanged = true
+	}
+
+	if params.Password != nil {
+		password = *params.Password
+	}
+
+	if credentialsChanged {
+		if err := u.authenticateScrobbler(scrobbler, username, password); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.scrobblerRepository.UpdateScrobbler(scrobbler); err != nil {
+		logger.MainLogger.Error("Couldn't update scrobbler in Database", err, scrobbler.Id)
+		return nil, entities.NewInternalError(errors.New("Failed to update scrobbler"))
+	}
+
+	return u.scrobblerPresenter.ShowScrobbler(*scrobbler), nil
+}
@@ -0,0 +1,137 @@
+package scrobbler_usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/scrobblers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+const (
+	scrobbleForwarderInterval = 30 * time.Second
+
+	scrobbleForwarderBatchSize = 50
+
+	// With the backoff a scrobble is given up after about a day
+	scrobbleMaxAttempts = 12
+
+	scrobbleRetryMinDelay = 30 * time.Second
+	scrobbleRetryMaxDelay = 6 * time.Hour
+)
+
+// RunScrobbleForwarder submit the queued scrobbles until the context is done
+func (u *ScrobblerUsecase) RunScrobbleForwarder(ctx context.Context) {
+	ticker := time.NewTicker(scrobbleForwarderInterval)
+	defer ticker.Stop()
+
+	for {
+		u.ForwardPendingScrobbles()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ForwardPendingScrobbles submit every scrobble that is due, the failed ones
+// are rescheduled with an exponential backoff
+func (u *ScrobblerUsecase) ForwardPendingScrobbles() {
+	for {
+		scrobbles, err := u.scrobblerRepository.GetDueScrobbles(scrobbleForwarderBatchSize)
+		if err != nil {
+			logger.MainLogger.Error("Couldn't get due scrobbles from Database", err)
+			return
+		}
+
+		if len(scrobbles) == 0 {
+			return
+		}
+
+		loadedScrobblers := map[int]*entities.Scrobbler{}
+
+		for i := range scrobbles {
+			scrobble := &scrobbles[i]
+
+			scrobbler, ok := loadedScrobblers[scrobble.ScrobblerId]
+			if !ok {
+				scrobbler, err = u.scrobblerRepository.GetScrobblerById(scrobble.ScrobblerId)
+				if err != nil {
+					logger.MainLogger.Error("Couldn't get scrobbler from Database", err, scrobble.ScrobblerId)
+					return
+				}
+				loadedScrobblers[scrobble.ScrobblerId] = scrobbler
+			}
+
+			err = u.submitScrobble(*scrobbler, *scrobble)
+
+			if err := u.saveScrobbleResult(scrobble, err); err != nil {
+				logger.MainLogger.Error("Couldn't update scrobble in Database", err, scrobble.Id)
+				return
+			}
+		}
+
+		if len(scrobbles) < scrobbleForwarderBatchSize {
+			return
+		}
+	}
+}
+
+func (u *ScrobblerUsecase) submitScrobble(
+	scrobbler entities.Scrobbler,
+	scrobble entities.Scrobble,
+) error {
+	track, err := u.trackRepository.GetTrack(scrobble.TrackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return errors.Join(scrobblers.ScrobbleRejectedError, err)
+		}
+		return err
+	}
+
+	switch scrobbler.Service {
+	case entities.ScrobblerServiceListenBrainz:
+		return u.listenBrainzScrobbler.SubmitListen(scrobbler, *track, scrobble.ListenedAt)
+	case entities.ScrobblerServiceLastFm:
+		return u.lastFmScrobbler.SubmitScrobble(scrobbler, *track, scrobble.ListenedAt)
+	}
+
+	return errors.Join(
+		scrobblers.ScrobbleRejectedError,
+		errors.New("Unknown service \""+string(scrobbler.Service)+"\""),
+	)
+}
+
+func (u *ScrobblerUsecase) saveScrobbleResult(scrobble *entities.Scrobble, err error) error {
+	applyScrobbleResult(scrobble, err, time.Now())
+
+	return u.scrobblerRepository.UpdateScrobble(scrobble)
+}
+
+// applyScrobbleResult mark the scrobble as submitted, given up or to retry
+// later with an exponential backoff
+func applyScrobbleResult(scrobble *entities.Scrobble, err error, now time.Time) {
+	scrobble.Attempts += 1
+
+	switch {
+	case err == nil:
+		scrobble.SubmittedAt = &now
+		scrobble.LastError = ""
+	case errors.Is(err, scrobblers.ScrobbleRejectedError) || scrobble.Attempts >= scrobbleMaxAttempts:
+		logger.MainLogger.Warn("Scrobble was given up", err, scrobble.Id)
+		scrobble.FailedAt = &now
+		scrobble.LastError = err.Error()
+	default:
+		delay := min(
+			scrobbleRetryMinDelay<<(scrobble.Attempts-1),
+			scrobbleRetryMaxDelay,
+		)
+		scrobble.NextAttemptAt = now.Add(delay)
+		scrobble.LastError = err.Error()
+	}
+}
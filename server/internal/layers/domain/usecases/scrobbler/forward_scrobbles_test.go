@@ -0,0 +1,89 @@
+package scrobbler_usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/data/scrobblers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+func TestApplyScrobbleResultBackoff(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryError := errors.Join(scrobblers.ScrobbleQueryError, errors.New("timeout"))
+
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 0, expected: 30 * time.Second},
+		{attempts: 1, expected: time.Minute},
+		{attempts: 2, expected: 2 * time.Minute},
+		{attempts: 5, expected: 16 * time.Minute},
+		{attempts: 9, expected: 256 * time.Minute},
+		// The delay never goes over 6 hours
+		{attempts: 10, expected: 6 * time.Hour},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected.String(), func(t *testing.T) {
+			scrobble := entities.Scrobble{Attempts: test.attempts}
+
+			applyScrobbleResult(&scrobble, queryError, now)
+
+			if scrobble.Attempts != test.attempts+1 {
+				t.Errorf("expected %d attempts, got %d", test.attempts+1, scrobble.Attempts)
+			}
+
+			if !scrobble.NextAttemptAt.Equal(now.Add(test.expected)) {
+				t.Errorf("expected next attempt in %v, got %v", test.expected, scrobble.NextAttemptAt.Sub(now))
+			}
+
+			if scrobble.FailedAt != nil || scrobble.SubmittedAt != nil {
+				t.Error("scrobble should still be pending")
+			}
+
+			if scrobble.LastError != queryError.Error() {
+				t.Errorf("expected the last error to be kept, got %q", scrobble.LastError)
+			}
+		})
+	}
+}
+
+func TestApplyScrobbleResult(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		attempts int
+		err      error
+
+		submitted bool
+		failed    bool
+	}{
+		{name: "submitted", attempts: 3, err: nil, submitted: true},
+		{name: "rejected", attempts: 0, err: scrobblers.ScrobbleRejectedError, failed: true},
+		{name: "last attempt", attempts: scrobbleMaxAttempts - 1, err: scrobblers.ScrobbleQueryError, failed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scrobble := entities.Scrobble{Attempts: test.attempts, LastError: "previous"}
+
+			applyScrobbleResult(&scrobble, test.err, now)
+
+			if (scrobble.SubmittedAt != nil) != test.submitted {
+				t.Errorf("expected submitted %v", test.submitted)
+			}
+
+			if (scrobble.FailedAt != nil) != test.failed {
+				t.Errorf("expected failed %v", test.failed)
+			}
+
+			if test.submitted && scrobble.LastError != "" {
+				t.Errorf("a submitted scrobble should have no error, got %q", scrobble.LastError)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package scrobbler_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *ScrobblerUsecase) ListUserScrobblers(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scrobblers, err := u.scrobblerRepository.GetAllScrobblersFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.scrobblerPresenter.ShowScrobblers(scrobblers), nil
+}
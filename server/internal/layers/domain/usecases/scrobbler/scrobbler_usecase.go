@@ -0,0 +1,31 @@
+package scrobbler_usecase
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/scrobblers"
+	"github.com/gungun974/Melodink/server/internal/layers/presentation/presenters"
+)
+
+type ScrobblerUsecase struct {
+	scrobblerRepository   repositories.ScrobblerRepository
+	trackRepository       repositories.TrackRepository
+	listenBrainzScrobbler scrobblers.ListenBrainzScrobbler
+	lastFmScrobbler       scrobblers.LastFmScrobbler
+	scrobblerPresenter    presenters.ScrobblerPresenter
+}
+
+func NewScrobblerUsecase(
+	scrobblerRepository repositories.ScrobblerRepository,
+	trackRepository repositories.TrackRepository,
+	listenBrainzScrobbler scrobblers.ListenBrainzScrobbler,
+	lastFmScrobbler scrobblers.LastFmScrobbler,
+	scrobblerPresenter presenters.ScrobblerPresenter,
+) ScrobblerUsecase {
+	return ScrobblerUsecase{
+		scrobblerRepository,
+		trackRepository,
+		listenBrainzScrobbler,
+		lastFmScrobbler,
+		scrobblerPresenter,
+	}
+}
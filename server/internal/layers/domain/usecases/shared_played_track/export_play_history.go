@@ -0,0 +1,69 @@
+package shared_played_track_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+const playHistoryExportPageSize = 500
+
+func (u *SharedPlayedTrackUsecase) ExportPlayHistory(
+	ctx context.Context,
+	format entities.PlayHistoryFormat,
+	filter entities.PlayHistoryFilter,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case entities.PlayHistoryFormatCSV,
+		entities.PlayHistoryFormatJSONL,
+		entities.PlayHistoryFormatListenBrainz:
+	default:
+		return nil, entities.NewValidationError("Unknown play history format")
+	}
+
+	return u.sharedPlayedTrackPresenter.ShowPlayHistoryExport(
+		format,
+		func(write func(entry entities.PlayHistoryEntry) error) error {
+			var after *entities.SharedPlayedTrack
+
+			for {
+				entries, err := u.sharedPlayedTrackRepository.GetPlayHistoryPage(
+					user.Id,
+					filter,
+					after,
+					playHistoryExportPageSize,
+				)
+				if err != nil {
+					logger.MainLogger.Error("Couldn't export play history from Database", err, user.Id)
+					return err
+				}
+
+				for _, entry := range entries {
+					// A ListenBrainz listen needs a track and a real listen
+					if format == entities.PlayHistoryFormatListenBrainz &&
+						(entry.Track == nil || !entry.PlayedTrack.IsScrobblable()) {
+						continue
+					}
+
+					if err := write(entry); err != nil {
+						return err
+					}
+				}
+
+				if len(entries) < playHistoryExportPageSize {
+					return nil
+				}
+
+				after = &entries[len(entries)-1].PlayedTrack
+			}
+		},
+	), nil
+}
@@ -0,0 +1,250 @@
+package shared_played_track_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/Melodink/server/pkgs/listenhistory"
+)
+
+// The report only list the most frequent unmatched entries, a large history
+// can have thousands of them
+const maxListenHistoryUnmatchedEntries = 1000
+
+func (u *SharedPlayedTrackUsecase) ImportListenHistory(
+	ctx context.Context,
+	source string,
+	file io.ReaderAt,
+	size int64,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	listens, err := listenhistory.Parse(listenhistory.Source(source), file, size)
+	if err != nil {
+		if errors.Is(err, listenhistory.ErrUnknownSource) ||
+			errors.Is(err, listenhistory.ErrInvalidHistory) {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	tracks, err := u.trackRepository.GetAllTracksFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	index := newListenHistoryTrackIndex(tracks)
+
+	// Each source has its own device so the imported plays can't collide with
+	// the plays of the user devices or of another source
+	deviceId := fmt.Sprintf("import-%s-%d", source, user.Id)
+
+	playedTracks := []entities.SharedPlayedTrack{}
+
+	unmatchedEntries := map[entities.ListenHistoryUnmatchedEntry]int{}
+
+	for _, listen := range listens {
+		track := index.match(listen)
+		if track == nil {
+			unmatchedEntries[entities.ListenHistoryUnmatchedEntry{
+				Artist: listen.Artist,
+				Title:  listen.Title,
+				Album:  listen.Album,
+			}]++
+			continue
+		}
+
+		playedTracks = append(playedTracks, newImportedPlayedTrack(user.Id, deviceId, *track, listen))
+	}
+
+	importedCount, err := u.sharedPlayedTrackRepository.ImportSharedPlayedTracks(playedTracks)
+	if err != nil {
+		logger.MainLogger.Error("Couldn't import listening history in Database", err, source)
+		return nil, entities.NewInternalError(errors.New("Failed to import listening history"))
+	}
+
+	report := entities.ListenHistoryImportReport{
+		Source: source,
+
+		EntryCount:     len(listens),
+		ImportedCount:  importedCount,
+		DuplicateCount: len(playedTracks) - importedCount,
+		UnmatchedCount: len(listens) - len(playedTracks),
+
+		Unmatched: make([]entities.ListenHistoryUnmatchedEntry, 0, len(unmatchedEntries)),
+	}
+
+	for entry, count := range unmatchedEntries {
+		entry.Count = count
+		report.Unmatched = append(report.Unmatched, entry)
+	}
+
+	slices.SortFunc(report.Unmatched, func(a, b entities.ListenHistoryUnmatchedEntry) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		if c := strings.Compare(a.Artist, b.Artist); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Title, b.Title)
+	})
+
+	if len(report.Unmatched) > maxListenHistoryUnmatchedEntries {
+		report.Unmatched = report.Unmatched[:maxListenHistoryUnmatchedEntries]
+	}
+
+	return u.sharedPlayedTrackPresenter.ShowListenHistoryImportReport(report), nil
+}
+
+// newImportedPlayedTrack assume the whole track was listened when the export
+// doesn't say how long it was played
+func newImportedPlayedTrack(
+	userId int,
+	deviceId string,
+	track entities.Track,
+	listen listenhistory.Listen,
+) entities.SharedPlayedTrack {
+	playedTime := listen.PlayedTime
+	trackEnded := listen.Ended
+
+	if playedTime <= 0 {
+		playedTime = track.Duration
+		trackEnded = true
+	}
+
+	if track.Duration > 0 && playedTime > track.Duration {
+		playedTime = track.Duration
+	}
+
+	return entities.SharedPlayedTrack{
+		InternalDeviceId: importedPlayedTrackId(deviceId, track.Id, listen.ListenedAt),
+
+		UserId:   userId,
+		DeviceId: deviceId,
+
+		TrackId: track.Id,
+
+		StartAt:  listen.ListenedAt,
+		FinishAt: listen.ListenedAt.Add(time.Duration(playedTime) * time.Millisecond),
+
+		BeginAt: 0,
+		EndedAt: playedTime,
+
+		Shuffle: listen.Shuffle,
+
+		TrackEnded:    trackEnded,
+		TrackDuration: track.Duration,
+	}
+}
+
+// importedPlayedTrackId derive a stable id from the play so importing the same
+// export again give the same ids, two tracks played in the same second still
+// get different ids. The id is kept in the 53 bits of a JSON number since it
+// is sent to the clients.
+func importedPlayedTrackId(deviceId string, trackId int, listenedAt time.Time) int {
+	hash := fnv.New64a()
+
+	_, _ = fmt.Fprintf(hash, "%s-%d-%d", deviceId, trackId, listenedAt.UnixMilli())
+
+	return int(hash.Sum64() & (1<<53 - 1))
+}
+
+type listenHistoryTrackIndex struct {
+	byRecordingMbid map[string]*entities.Track
+	byArtistTitle   map[string][]*entities.Track
+}
+
+func getListenHistoryKey(artist string, title string) string {
+	return listenhistory.NormalizeName(artist) + "\x00" + listenhistory.NormalizeName(title)
+}
+
+func newListenHistoryTrackIndex(tracks []entities.Track) listenHistoryTrackIndex {
+	index := listenHistoryTrackIndex{
+		byRecordingMbid: map[string]*entities.Track{},
+		byArtistTitle:   map[string][]*entities.Track{},
+	}
+
+	for i := range tracks {
+		track := &tracks[i]
+
+		if mbid := strings.ToLower(track.Metadata.MusicBrainzRecordingId); mbid != "" {
+			index.byRecordingMbid[mbid] = track
+		}
+
+		artists := slices.Concat(
+			track.Metadata.Artists,
+			[]string{strings.Join(track.Metadata.Artists, ", ")},
+			track.Metadata.AlbumArtists,
+		)
+
+		for _, artist := range track.Artists {
+			artists = append(artists, artist.Name)
+		}
+
+		keys := map[string]bool{}
+
+		for _, artist := range artists {
+			key := getListenHistoryKey(artist, track.Title)
+
+			if strings.HasPrefix(key, "\x00") || keys[key] {
+				continue
+			}
+			keys[key] = true
+
+			index.byArtistTitle[key] = append(index.byArtistTitle[key], track)
+		}
+	}
+
+	return index
+}
+
+// match look for the track by its MusicBrainz recording id then by its
+// artist and title, the album is only used to choose between the candidates
+func (i listenHistoryTrackIndex) match(listen listenhistory.Listen) *entities.Track {
+	if mbid := strings.ToLower(listen.RecordingMbid); mbid != "" {
+		if track, ok := i.byRecordingMbid[mbid]; ok {
+			return track
+		}
+	}
+
+	for _, artist := range []string{listen.Artist, listenhistory.MainArtistName(listen.Artist)} {
+		candidates := i.byArtistTitle[getListenHistoryKey(artist, listen.Title)]
+
+		if len(candidates) == 0 {
+			continue
+		}
+
+		album := listenhistory.NormalizeName(listen.Album)
+
+		if album != "" {
+			for _, track := range candidates {
+				if listenhistory.NormalizeName(track.Metadata.Album) == album {
+					return track
+				}
+
+				for _, trackAlbum := range track.Albums {
+					if listenhistory.NormalizeName(trackAlbum.Name) == album {
+						return track
+					}
+				}
+			}
+		}
+
+		return candidates[0]
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package shared_played_track_usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/pkgs/listenhistory"
+)
+
+func TestNewImportedPlayedTrackIds(t *testing.T) {
+	listenedAt := time.Unix(1700000000, 0)
+
+	first := newImportedPlayedTrack(1, "import-lastfm-1", entities.Track{Id: 1, Duration: 5000},
+		listenhistory.Listen{ListenedAt: listenedAt})
+	// A short track played in the same second
+	second := newImportedPlayedTrack(1, "import-lastfm-1", entities.Track{Id: 2, Duration: 5000},
+		listenhistory.Listen{ListenedAt: listenedAt})
+	otherSource := newImportedPlayedTrack(1, "import-spotify-1", entities.Track{Id: 1, Duration: 5000},
+		listenhistory.Listen{ListenedAt: listenedAt})
+	again := newImportedPlayedTrack(1, "import-lastfm-1", entities.Track{Id: 1, Duration: 5000},
+		listenhistory.Listen{ListenedAt: listenedAt})
+
+	if first.InternalDeviceId == second.InternalDeviceId {
+		t.Error("two tracks played in the same second should have different ids")
+	}
+
+	if first.InternalDeviceId == otherSource.InternalDeviceId {
+		t.Error("the same play of two sources should have different ids")
+	}
+
+	if first.InternalDeviceId != again.InternalDeviceId {
+		t.Error("importing the same play again should give the same id")
+	}
+
+	for _, played := range []entities.SharedPlayedTrack{first, second, otherSource} {
+		if played.InternalDeviceId < 0 || played.InternalDeviceId >= 1<<53 {
+			t.Errorf("id %d doesn't fit in a JSON number", played.InternalDeviceId)
+		}
+	}
+}
+
+func TestNewImportedPlayedTrackPlayedTime(t *testing.T) {
+	listenedAt := time.Unix(1700000000, 0)
+	track := entities.Track{Id: 1, Duration: 200000}
+
+	tests := []struct {
+		name   string
+		listen listenhistory.Listen
+
+		expectedEndedAt int
+		expectedEnded   bool
+	}{
+		{
+			name:            "unknown played time",
+			listen:          listenhistory.Listen{ListenedAt: listenedAt},
+			expectedEndedAt: 200000,
+			expectedEnded:   true,
+		},
+		{
+			name:            "skipped",
+			listen:          listenhistory.Listen{ListenedAt: listenedAt, PlayedTime: 30000},
+			expectedEndedAt: 30000,
+			expectedEnded:   false,
+		},
+		{
+			name:            "longer than the track",
+			listen:          listenhistory.Listen{ListenedAt: listenedAt, PlayedTime: 250000, Ended: true},
+			expectedEndedAt: 200000,
+			expectedEnded:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			played := newImportedPlayedTrack(1, "import-spotify-1", track, test.listen)
+
+			if played.EndedAt != test.expectedEndedAt || played.TrackEnded != test.expectedEnded {
+				t.Fatalf("expected %d %v, got %d %v",
+					test.expectedEndedAt, test.expectedEnded, played.EndedAt, played.TrackEnded)
+			}
+
+			if !played.FinishAt.Equal(listenedAt.Add(time.Duration(test.expectedEndedAt) * time.Millisecond)) {
+				t.Errorf("unexpected finish time %v", played.FinishAt)
+			}
+		})
+	}
+}
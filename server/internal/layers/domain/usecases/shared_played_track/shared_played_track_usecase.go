@@ -7,15 +7,21 @@ import (
 
 type SharedPlayedTrackUsecase struct {
 	sharedPlayedTrackRepository repositories.SharedPlayedTrackRepository
+	scrobblerRepository         repositories.ScrobblerRepository
+	trackRepository             repositories.TrackRepository
 	sharedPlayedTrackPresenter  presenters.SharedPlayedTrackPresenter
 }
 
 func NewSharedPlayedTrackUsecase(
 	sharedPlayedTrackRepository repositories.SharedPlayedTrackRepository,
+	scrobblerRepository repositories.ScrobblerRepository,
+	trackRepository repositories.TrackRepository,
 	sharedPlayedTrackPresenter presenters.SharedPlayedTrackPresenter,
 ) SharedPlayedTrackUsecase {
 	return SharedPlayedTrackUsecase{
 		sharedPlayedTrackRepository,
+		scrobblerRepository,
+		trackRepository,
 		sharedPlayedTrackPresenter,
 	}
 }
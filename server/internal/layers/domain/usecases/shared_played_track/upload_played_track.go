@@ -71,5 +71,11 @@ func (u *SharedPlayedTrackUsecase) UploadPlayedTrack(
 		return nil, entities.NewInternalError(errors.New("Failed to add shared played track"))
 	}
 
+	if newSharedPlayedTrack.IsScrobblable() {
+		if err := u.scrobblerRepository.QueuePlayedTrack(newSharedPlayedTrack); err != nil {
+			logger.MainLogger.Warn("Couldn't queue played track for scrobbling", err, newSharedPlayedTrack.Id)
+		}
+	}
+
 	return u.sharedPlayedTrackPresenter.ShowSharedPlayedTrack(newSharedPlayedTrack), nil
 }
@@ -21,12 +21,16 @@ func (u *SyncUsecase) FetchFullSyncData(
 	var tracks []entities.Track
 	var albums []entities.Album
 	var artists []entities.Artist
+	var genres []entities.Genre
+	var works []entities.Work
 	var playlists []entities.Playlist
 	var sharedPlayedTracks []entities.SharedPlayedTrack
 
 	var errTracks error
 	var errAlbums error
 	var errArtists error
+	var errGenres error
+	var errWorks error
 	var errPlaylists error
 	var errSharedPlayedTracks error
 
@@ -34,7 +38,7 @@ func (u *SyncUsecase) FetchFullSyncData(
 
 	var wg sync.WaitGroup
 
-	wg.Add(5)
+	wg.Add(7)
 
 	go func() {
 		defer wg.Done()
@@ -63,6 +67,26 @@ func (u *SyncUsecase) FetchFullSyncData(
 	go func() {
 		defer wg.Done()
 		artists, errArtists = u.artistRepository.GetAllArtistsFromUser(user.Id)
+		if errArtists != nil {
+			return
+		}
+		for i := range artists {
+			u.coverStorage.LoadArtistCoverSignature(&artists[i])
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		genres, errGenres = u.genreRepository.GetAllGenresFromUser(user.Id)
+		if errGenres != nil {
+			return
+		}
+		errGenres = u.genreRepository.LoadStatsInGenres(user.Id, genres)
+	}()
+
+	go func() {
+		defer wg.Done()
+		works, errWorks = u.workRepository.GetAllWorksFromUser(user.Id)
 	}()
 
 	go func() {
@@ -95,6 +119,14 @@ func (u *SyncUsecase) FetchFullSyncData(
 		return nil, entities.NewInternalError(errArtists)
 	}
 
+	if errGenres != nil {
+		return nil, entities.NewInternalError(errGenres)
+	}
+
+	if errWorks != nil {
+		return nil, entities.NewInternalError(errWorks)
+	}
+
 	if errPlaylists != nil {
 		return nil, entities.NewInternalError(errPlaylists)
 	}
@@ -103,5 +135,15 @@ func (u *SyncUsecase) FetchFullSyncData(
 		return nil, entities.NewInternalError(errSharedPlayedTracks)
 	}
 
-	return u.syncPresenter.ShowFullSync(ctx, tracks, albums, artists, playlists, sharedPlayedTracks, now), nil
+	return u.syncPresenter.ShowFullSync(
+		ctx,
+		tracks,
+		albums,
+		artists,
+		genres,
+		works,
+		playlists,
+		sharedPlayedTracks,
+		now,
+	), nil
 }
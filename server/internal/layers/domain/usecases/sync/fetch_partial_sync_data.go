@@ -22,18 +22,24 @@ func (u *SyncUsecase) FetchPartialSyncData(
 	var tracks []entities.Track
 	var albums []entities.Album
 	var artists []entities.Artist
+	var genres []entities.Genre
+	var works []entities.Work
 	var playlists []entities.Playlist
 	var sharedPlayedTracks []entities.SharedPlayedTrack
 
 	var deletedTracks []int
 	var deletedAlbums []int
 	var deletedArtists []int
+	var deletedGenres []int
+	var deletedWorks []int
 	var deletedPlaylists []int
 	var deletedSharedPlayedTracks []int
 
 	var errTracks error
 	var errAlbums error
 	var errArtists error
+	var errGenres error
+	var errWorks error
 	var errPlaylists error
 	var errSharedPlayedTracks error
 
@@ -41,7 +47,7 @@ func (u *SyncUsecase) FetchPartialSyncData(
 
 	var wg sync.WaitGroup
 
-	wg.Add(5)
+	wg.Add(7)
 
 	go func() {
 		defer wg.Done()
@@ -78,9 +84,34 @@ func (u *SyncUsecase) FetchPartialSyncData(
 		if errArtists != nil {
 			return
 		}
+		for i := range artists {
+			u.coverStorage.LoadArtistCoverSignature(&artists[i])
+		}
 		deletedArtists, errArtists = u.artistRepository.GetAllDeletedArtistsSince(since)
 	}()
 
+	go func() {
+		defer wg.Done()
+		genres, errGenres = u.genreRepository.GetAllGenresFromUserSince(user.Id, since)
+		if errGenres != nil {
+			return
+		}
+		errGenres = u.genreRepository.LoadStatsInGenres(user.Id, genres)
+		if errGenres != nil {
+			return
+		}
+		deletedGenres, errGenres = u.genreRepository.GetAllDeletedGenresSince(since)
+	}()
+
+	go func() {
+		defer wg.Done()
+		works, errWorks = u.workRepository.GetAllWorksFromUserSince(user.Id, since)
+		if errWorks != nil {
+			return
+		}
+		deletedWorks, errWorks = u.workRepository.GetAllDeletedWorksSince(since)
+	}()
+
 	go func() {
 		defer wg.Done()
 		playlists, errPlaylists = u.playlistRepository.GetAllPlaylistsFromUserSince(user.Id, since)
@@ -116,6 +147,14 @@ func (u *SyncUsecase) FetchPartialSyncData(
 		return nil, entities.NewInternalError(errArtists)
 	}
 
+	if errGenres != nil {
+		return nil, entities.NewInternalError(errGenres)
+	}
+
+	if errWorks != nil {
+		return nil, entities.NewInternalError(errWorks)
+	}
+
 	if errPlaylists != nil {
 		return nil, entities.NewInternalError(errPlaylists)
 	}
@@ -129,11 +168,15 @@ func (u *SyncUsecase) FetchPartialSyncData(
 		tracks,
 		albums,
 		artists,
+		genres,
+		works,
 		playlists,
 		sharedPlayedTracks,
 		deletedTracks,
 		deletedAlbums,
 		deletedArtists,
+		deletedGenres,
+		deletedWorks,
 		deletedPlaylists,
 		deletedSharedPlayedTracks,
 		now,
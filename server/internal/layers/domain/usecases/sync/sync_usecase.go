@@ -10,6 +10,8 @@ type SyncUsecase struct {
 	trackRepository             repositories.TrackRepository
 	albumRepository             repositories.AlbumRepository
 	artistRepository            repositories.ArtistRepository
+	genreRepository             repositories.GenreRepository
+	workRepository              repositories.WorkRepository
 	playlistRepository          repositories.PlaylistRepository
 	sharedPlayedTrackRepository repositories.SharedPlayedTrackRepository
 
@@ -22,6 +24,8 @@ func NewSyncUsecase(
 	trackRepository repositories.TrackRepository,
 	albumRepository repositories.AlbumRepository,
 	artistRepository repositories.ArtistRepository,
+	genreRepository repositories.GenreRepository,
+	workRepository repositories.WorkRepository,
 	playlistRepository repositories.PlaylistRepository,
 	sharedPlayedTrackRepository repositories.SharedPlayedTrackRepository,
 	coverStorage storages.CoverStorage,
@@ -31,6 +35,8 @@ func NewSyncUsecase(
 		trackRepository,
 		albumRepository,
 		artistRepository,
+		genreRepository,
+		workRepository,
 		playlistRepository,
 		sharedPlayedTrackRepository,
 		coverStorage,
@@ -4,6 +4,7 @@ import (
 	"github.com/gungun974/Melodink/server/internal/helpers"
 	"github.com/gungun974/Melodink/server/internal/layers/data/scanners"
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
 )
 
 func (u *TrackUsecase) advancedScanTrack(
@@ -107,13 +108,46 @@ func (u *TrackUsecase) advancedScanTrack(
 		onlyReplaceEmptyFields,
 	)
 
+	if (len(newTrack.Metadata.ReleaseTypes) == 0 || !onlyReplaceEmptyFields) &&
+		len(musicBrainzScanResult.ReleaseTypes) != 0 {
+		newTrack.Metadata.ReleaseTypes = musicBrainzScanResult.ReleaseTypes
+	}
+
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.OriginalDate,
+		musicBrainzScanResult.OriginalDate,
+		onlyReplaceEmptyFields,
+	)
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.Label,
+		musicBrainzScanResult.Label,
+		onlyReplaceEmptyFields,
+	)
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.CatalogNumber,
+		musicBrainzScanResult.CatalogNumber,
+		onlyReplaceEmptyFields,
+	)
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.Barcode,
+		musicBrainzScanResult.Barcode,
+		onlyReplaceEmptyFields,
+	)
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.MusicBrainzReleaseGroupId,
+		musicBrainzScanResult.MusicBrainzReleaseGroupId,
+		onlyReplaceEmptyFields,
+	)
+
 	if (len(newTrack.Metadata.Artists) == 0 || !onlyReplaceEmptyFields) &&
 		len(musicBrainzScanResult.Artists) != 0 {
 		newTrack.Metadata.Artists = musicBrainzScanResult.Artists
+		newTrack.Metadata.MusicBrainzArtistIds = musicBrainzScanResult.MusicBrainzArtistIds
 	}
 	if (len(newTrack.Metadata.AlbumArtists) == 0 || !onlyReplaceEmptyFields) &&
 		len(musicBrainzScanResult.AlbumArtists) != 0 {
 		newTrack.Metadata.AlbumArtists = musicBrainzScanResult.AlbumArtists
+		newTrack.Metadata.MusicBrainzAlbumArtistIds = musicBrainzScanResult.MusicBrainzAlbumArtistIds
 	}
 
 	if (len(newTrack.Metadata.ArtistsRoles) == 0 || !onlyReplaceEmptyFields) &&
@@ -121,5 +155,38 @@ func (u *TrackUsecase) advancedScanTrack(
 		newTrack.Metadata.ArtistsRoles = musicBrainzScanResult.ArtistsRoles
 	}
 
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.Composer,
+		musicBrainzScanResult.Composer,
+		onlyReplaceEmptyFields,
+	)
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.Work,
+		musicBrainzScanResult.Work,
+		onlyReplaceEmptyFields,
+	)
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.MovementName,
+		musicBrainzScanResult.MovementName,
+		onlyReplaceEmptyFields,
+	)
+	helpers.CheckAndReplaceEmptyString(
+		&newTrack.Metadata.MusicBrainzWorkId,
+		musicBrainzScanResult.MusicBrainzWorkId,
+		onlyReplaceEmptyFields,
+	)
+
+	for i := range musicBrainzScanResult.MusicBrainzArtists {
+		if err := u.artistRepository.SetMusicBrainzArtist(
+			&musicBrainzScanResult.MusicBrainzArtists[i],
+		); err != nil {
+			logger.MainLogger.Warn(
+				"Couldn't save MusicBrainz artist",
+				err,
+				musicBrainzScanResult.MusicBrainzArtists[i].Id,
+			)
+		}
+	}
+
 	return newTrack, nil
 }
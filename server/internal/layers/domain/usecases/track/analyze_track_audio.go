@@ -0,0 +1,135 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *TrackUsecase) AnalyzeTrackAudio(
+	ctx context.Context,
+	trackId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := u.trackRepository.GetTrack(trackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if track.UserId != nil && *track.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if err := u.analyzeTrackAudio(track, false); err != nil {
+		return nil, entities.NewInternalError(errors.New("Failed to analyze track audio"))
+	}
+
+	track.Scores, err = u.trackRepository.GetAllScoresByTrack(track.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.trackPresenter.ShowTrack(ctx, *track), nil
+}
+
+// AnalyzeTracksAudio start the analysis of every track of the user which was
+// never analyzed in the background, tempo and key from tags are kept.
+func (u *TrackUsecase) AnalyzeTracksAudio(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	started := u.libraryJobs.start("analyze-audio", user.Id, func() {
+		u.analyzeTracksAudio(user.Id)
+	})
+
+	if !started {
+		return models.PlainAPIResponse{
+			Status: http.StatusAccepted,
+			Text:   "Audio analysis is already running",
+		}, nil
+	}
+
+	return models.PlainAPIResponse{
+		Status: http.StatusAccepted,
+		Text:   "Audio analysis started",
+	}, nil
+}
+
+func (u *TrackUsecase) analyzeTracksAudio(userId int) {
+	tracks, err := u.trackRepository.GetAllTracksFromUser(userId)
+	if err != nil {
+		logger.MainLogger.Error("Couldn't get tracks from Database", err, userId)
+		return
+	}
+
+	analyzedCount := 0
+
+	for i := range tracks {
+		if tracks[i].Analysis.Energy != nil {
+			continue
+		}
+
+		if err := u.analyzeTrackAudio(&tracks[i], true); err != nil {
+			logger.MainLogger.Warn("Couldn't analyze track audio", err, tracks[i].Id)
+			continue
+		}
+
+		analyzedCount++
+	}
+
+	logger.MainLogger.Infof("Analyzed audio of %d tracks of user %d", analyzedCount, userId)
+}
+
+// analyzeTrackAudio estimate tempo, key, energy and danceability of the
+// track. When keepTags is true the tempo and key already set are not
+// replaced.
+func (u *TrackUsecase) analyzeTrackAudio(track *entities.Track, keepTags bool) error {
+	result, err := u.audioAnalysisScanner.ScanAudioAnalysis(track.Path)
+	if err != nil {
+		return err
+	}
+
+	if !keepTags || track.Analysis.Bpm == nil {
+		bpm := result.Bpm
+		if bpm > 0 {
+			track.Analysis.Bpm = &bpm
+		} else {
+			track.Analysis.Bpm = nil
+		}
+	}
+
+	if !keepTags || track.Analysis.Key == nil {
+		key := result.Key.Name()
+		camelot := result.Key.Camelot()
+
+		track.Analysis.Key = &key
+		track.Analysis.Camelot = &camelot
+	}
+
+	track.Analysis.Energy = &result.Energy
+	track.Analysis.Danceability = &result.Danceability
+
+	if err := u.trackRepository.UpdateTrack(track); err != nil {
+		logger.MainLogger.Error("Couldn't update track analysis in Database", err, *track)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *TrackUsecase) AnalyzeTrackLoudness(
+	ctx context.Context,
+	trackId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := u.trackRepository.GetTrack(trackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if track.UserId != nil && *track.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if err := u.analyzeTrackLoudness(track); err != nil {
+		return nil, entities.NewInternalError(errors.New("Failed to analyze track loudness"))
+	}
+
+	track.Scores, err = u.trackRepository.GetAllScoresByTrack(track.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.trackPresenter.ShowTrack(ctx, *track), nil
+}
+
+func (u *TrackUsecase) analyzeTrackLoudness(track *entities.Track) error {
+	result, err := u.loudnessScanner.ScanLoudness(track.Path)
+	if err != nil {
+		return err
+	}
+
+	track.Loudness.Integrated = result.Integrated
+	track.Loudness.TruePeak = result.TruePeak
+	track.Loudness.Range = result.Range
+
+	if err := u.trackRepository.UpdateTrack(track); err != nil {
+		logger.MainLogger.Error("Couldn't update track loudness in Database", err, *track)
+		return err
+	}
+
+	return nil
+}
@@ -3,6 +3,9 @@ package track_usecase
 import (
 	"context"
 	"errors"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/gungun974/Melodink/server/internal/helpers"
 	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
@@ -37,7 +40,11 @@ func (u *TrackUsecase) AutoLinkTrack(
 	artists := make([]entities.Artist, len(track.Metadata.Artists))
 
 	for i, targetArtist := range track.Metadata.Artists {
-		artist, err := u.artistRepository.GetArtistByNameOrCreate(targetArtist, user.Id)
+		artist, err := u.getArtistOrCreate(
+			targetArtist,
+			getArtistMusicBrainzId(track.Metadata.Artists, track.Metadata.MusicBrainzArtistIds, i),
+			user.Id,
+		)
 		if err != nil {
 			logger.MainLogger.Error("Couldn't get artist or create new", err, track, targetArtist)
 			return nil, entities.NewInternalError(err)
@@ -53,15 +60,77 @@ func (u *TrackUsecase) AutoLinkTrack(
 		return nil, entities.NewInternalError(errors.New("Failed to set track artists"))
 	}
 
+	//! Genres
+
+	if err := u.linkTrackGenres(track, user.Id); err != nil {
+		return nil, err
+	}
+
+	//! Work
+
+	previousWork := track.Work
+
+	track.Work = nil
+
+	if !helpers.IsEmptyOrWhitespace(track.Metadata.Work) {
+		work, err := u.workRepository.GetWorkByNameOrCreate(
+			strings.TrimSpace(track.Metadata.Work),
+			track.Metadata.MusicBrainzWorkId,
+			user.Id,
+		)
+		if err != nil {
+			logger.MainLogger.Error("Couldn't get work or create new", err, track, track.Metadata.Work)
+			return nil, entities.NewInternalError(err)
+		}
+
+		track.Work = work
+	}
+
+	if err := u.trackRepository.SetTrackWork(track); err != nil {
+		logger.MainLogger.Error("Couldn't set track work", err, track)
+		return nil, entities.NewInternalError(errors.New("Failed to set track work"))
+	}
+
+	if previousWork != nil && (track.Work == nil || track.Work.Id != previousWork.Id) {
+		if _, err := u.workRepository.DeleteWorkIfUnused(previousWork); err != nil {
+			logger.MainLogger.Warn("Couldn't delete unused work", err, previousWork.Id)
+		}
+	}
+
+	//! Role Artists
+
+	roleArtists, err := u.getTrackRoleArtists(*track, user.Id)
+	if err != nil {
+		logger.MainLogger.Error("Couldn't get role artist or create new", err, track)
+		return nil, entities.NewInternalError(err)
+	}
+
+	track.RoleArtists = roleArtists
+
+	if err := u.trackRepository.SetTrackRoleArtists(track); err != nil {
+		logger.MainLogger.Error("Couldn't set track role artists", err, track)
+		return nil, entities.NewInternalError(errors.New("Failed to set track role artists"))
+	}
+
 	//! Album
 
+	previousAlbums := track.Albums
+
 	albums := make([]entities.Album, 0)
 
 	if !helpers.IsEmptyOrWhitespace(track.Metadata.Album) {
 		albumArtists := make([]entities.Artist, len(track.Metadata.AlbumArtists))
 
 		for i, targetArtist := range track.Metadata.AlbumArtists {
-			artist, err := u.artistRepository.GetArtistByNameOrCreate(targetArtist, user.Id)
+			artist, err := u.getArtistOrCreate(
+				targetArtist,
+				getArtistMusicBrainzId(
+					track.Metadata.AlbumArtists,
+					track.Metadata.MusicBrainzAlbumArtistIds,
+					i,
+				),
+				user.Id,
+			)
 			if err != nil {
 				logger.MainLogger.Error(
 					"Couldn't get album artist or create new",
@@ -94,6 +163,8 @@ func (u *TrackUsecase) AutoLinkTrack(
 			return nil, entities.NewInternalError(err)
 		}
 
+		u.applyTrackReleaseInfos(album, *track)
+
 		albums = append(albums, *album)
 	}
 
@@ -104,5 +175,272 @@ func (u *TrackUsecase) AutoLinkTrack(
 		return nil, entities.NewInternalError(errors.New("Failed to set track albums"))
 	}
 
+	//! Album Genres
+
+	refreshedAlbums := map[int]bool{}
+
+	for _, album := range append(previousAlbums, track.Albums...) {
+		if refreshedAlbums[album.Id] {
+			continue
+		}
+
+		refreshedAlbums[album.Id] = true
+
+		if err := u.albumRepository.RefreshAlbumGenres(&album); err != nil {
+			logger.MainLogger.Warn("Couldn't refresh album genres", err, album.Id)
+		}
+	}
+
 	return u.trackPresenter.ShowTrack(ctx, *track), nil
 }
+
+// linkTrackGenres link the genres tag of the track to genres, an alias links
+// the tag value to the genre it's mapped to.
+func (u *TrackUsecase) linkTrackGenres(track *entities.Track, userId int) error {
+	genres := make([]entities.Genre, 0, len(track.Metadata.Genres))
+
+	for _, targetGenre := range track.Metadata.Genres {
+		if helpers.IsEmptyOrWhitespace(targetGenre) {
+			continue
+		}
+
+		genre, err := u.genreRepository.GetGenreByNameOrCreate(
+			strings.TrimSpace(targetGenre),
+			userId,
+		)
+		if err != nil {
+			logger.MainLogger.Error("Couldn't get genre or create new", err, track, targetGenre)
+			return entities.NewInternalError(err)
+		}
+
+		// Two tag values can be aliases of the same genre
+
+		if slices.ContainsFunc(genres, func(added entities.Genre) bool {
+			return added.Id == genre.Id
+		}) {
+			continue
+		}
+
+		genres = append(genres, *genre)
+	}
+
+	track.Genres = genres
+
+	if err := u.trackRepository.SetTrackGenres(track); err != nil {
+		logger.MainLogger.Error("Couldn't set track genres", err, track)
+		return entities.NewInternalError(errors.New("Failed to set track genres"))
+	}
+
+	return nil
+}
+
+// getTrackRoleArtists link the composers tag and the classical roles found
+// by MusicBrainz or in the tags to artists. Composers come from the composer
+// tag only so an edit of it is never overridden by the scanned roles.
+func (u *TrackUsecase) getTrackRoleArtists(
+	track entities.Track,
+	userId int,
+) ([]entities.TrackRoleArtist, error) {
+	rules, err := u.trackRepository.GetTagSplitRules(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	type roleName struct {
+		role string
+		name string
+	}
+
+	roleNames := []roleName{}
+
+	for _, composer := range helpers.SplitTagValue(
+		track.Metadata.Composer,
+		rules.ArtistSeparators,
+		rules.ProtectedNames,
+	) {
+		roleNames = append(roleNames, roleName{entities.TrackArtistRoleComposer, composer})
+	}
+
+	for _, artistRole := range track.Metadata.ArtistsRoles {
+		if artistRole.Role == "" || artistRole.Role == entities.TrackArtistRoleComposer {
+			continue
+		}
+
+		roleNames = append(roleNames, roleName{artistRole.Role, artistRole.Artist})
+	}
+
+	roleArtists := make([]entities.TrackRoleArtist, 0, len(roleNames))
+
+	for _, target := range roleNames {
+		if helpers.IsEmptyOrWhitespace(target.name) {
+			continue
+		}
+
+		artist, err := u.artistRepository.GetArtistByNameOrCreate(
+			strings.TrimSpace(target.name),
+			userId,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.ContainsFunc(roleArtists, func(added entities.TrackRoleArtist) bool {
+			return added.Role == target.role && added.Artist.Id == artist.Id
+		}) {
+			continue
+		}
+
+		roleArtists = append(roleArtists, entities.TrackRoleArtist{
+			Role:   target.role,
+			Artist: *artist,
+		})
+	}
+
+	return roleArtists, nil
+}
+
+// getArtistMusicBrainzId only trust the ids when there is one for each artist
+// name, otherwise names and ids can't be paired.
+func getArtistMusicBrainzId(names []string, musicBrainzIds []string, index int) string {
+	if len(names) != len(musicBrainzIds) {
+		return ""
+	}
+
+	return musicBrainzIds[index]
+}
+
+// realignArtistMusicBrainzIds give the ids of the old artist names to the new
+// ones, the ids are cleared when a new name doesn't have one since
+// getArtistMusicBrainzId pair them by index.
+func realignArtistMusicBrainzIds(
+	oldNames []string,
+	newNames []string,
+	musicBrainzIds []string,
+) []string {
+	if slices.Equal(oldNames, newNames) {
+		return musicBrainzIds
+	}
+
+	if len(oldNames) != len(musicBrainzIds) {
+		return []string{}
+	}
+
+	ids := make(map[string]string, len(oldNames))
+
+	for i, name := range oldNames {
+		ids[strings.ToLower(strings.TrimSpace(name))] = musicBrainzIds[i]
+	}
+
+	newIds := make([]string, 0, len(newNames))
+
+	for _, name := range newNames {
+		id, ok := ids[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return []string{}
+		}
+
+		newIds = append(newIds, id)
+	}
+
+	return newIds
+}
+
+// getArtistOrCreate match the artist by its MusicBrainz id when known so
+// homonymous artists stay apart, otherwise by its name.
+func (u *TrackUsecase) getArtistOrCreate(
+	name string,
+	musicBrainzArtistId string,
+	userId int,
+) (*entities.Artist, error) {
+	if helpers.IsEmptyOrWhitespace(musicBrainzArtistId) {
+		return u.artistRepository.GetArtistByNameOrCreate(name, userId)
+	}
+
+	artist, err := u.artistRepository.GetArtistByMusicBrainzIdOrCreate(
+		name,
+		musicBrainzArtistId,
+		userId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	u.applyMusicBrainzArtist(artist)
+
+	return artist, nil
+}
+
+// applyMusicBrainzArtist fill the sort name, disambiguation and aliases of
+// the artist from the saved release lookups when they are still empty.
+func (u *TrackUsecase) applyMusicBrainzArtist(artist *entities.Artist) {
+	if artist.SortName != "" {
+		return
+	}
+
+	musicBrainzArtist, err := u.artistRepository.GetMusicBrainzArtist(artist.MusicBrainzArtistId)
+	if err != nil {
+		return
+	}
+
+	artist.SortName = musicBrainzArtist.SortName
+
+	if artist.Disambiguation == "" {
+		artist.Disambiguation = musicBrainzArtist.Disambiguation
+	}
+
+	if len(artist.Aliases) == 0 {
+		artist.Aliases = musicBrainzArtist.Aliases
+	}
+
+	if err := u.artistRepository.UpdateArtist(artist); err != nil {
+		logger.MainLogger.Warn("Couldn't update artist MusicBrainz infos", err, artist.Id)
+	}
+}
+
+// applyTrackReleaseInfos fill the empty release infos of the album with the
+// ones read from its track tags or MusicBrainz.
+func (u *TrackUsecase) applyTrackReleaseInfos(album *entities.Album, track entities.Track) {
+	changed := false
+
+	fill := func(field *string, value string) {
+		if *field != "" || helpers.IsEmptyOrWhitespace(value) {
+			return
+		}
+
+		*field = strings.TrimSpace(value)
+		changed = true
+	}
+
+	releaseType, releaseSecondaryTypes := entities.SplitReleaseTypes(track.Metadata.ReleaseTypes)
+
+	if album.ReleaseType == "" && len(album.ReleaseSecondaryTypes) == 0 &&
+		(releaseType != "" || len(releaseSecondaryTypes) != 0) {
+		album.ReleaseType = releaseType
+		album.ReleaseSecondaryTypes = releaseSecondaryTypes
+		changed = true
+	}
+
+	releaseDate := track.Metadata.Date
+
+	if helpers.IsEmptyOrWhitespace(releaseDate) && track.Metadata.Year > 0 {
+		releaseDate = strconv.Itoa(track.Metadata.Year)
+	}
+
+	fill(&album.ReleaseDate, releaseDate)
+	fill(&album.OriginalReleaseDate, track.Metadata.OriginalDate)
+
+	fill(&album.Label, track.Metadata.Label)
+	fill(&album.CatalogNumber, track.Metadata.CatalogNumber)
+	fill(&album.Barcode, track.Metadata.Barcode)
+
+	fill(&album.MusicBrainzReleaseId, track.Metadata.MusicBrainzReleaseId)
+	fill(&album.MusicBrainzReleaseGroupId, track.Metadata.MusicBrainzReleaseGroupId)
+
+	if !changed {
+		return
+	}
+
+	if err := u.albumRepository.UpdateAlbum(album); err != nil {
+		logger.MainLogger.Warn("Couldn't update album release infos", err, album.Id)
+	}
+}
@@ -0,0 +1,257 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+type BulkEditTracksParams struct {
+	TrackIds []int
+	Filter   *entities.TrackFilter
+
+	SetGenres    *[]string
+	AppendGenres []string
+	RemoveGenres []string
+
+	Album        *string
+	AlbumArtists *[]string
+
+	RenumberTracks bool
+	RenumberStart  int
+
+	TitleFind    string
+	TitleReplace string
+
+	Commit bool
+}
+
+func (u *TrackUsecase) BulkEditTracks(
+	ctx context.Context,
+	params BulkEditTracksParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty selection would rewrite the whole library
+	if params.Filter != nil && params.Filter.IsEmpty() {
+		return nil, entities.NewValidationError("filter must have at least one field")
+	}
+
+	if params.Filter == nil && len(params.TrackIds) == 0 {
+		return nil, entities.NewValidationError("track_ids or filter is required")
+	}
+
+	var tracks []entities.Track
+
+	if params.Filter != nil {
+		tracks, err = u.trackRepository.GetAllTracksFromUserByFilter(user.Id, *params.Filter, entities.TrackSort{})
+		if err != nil {
+			return nil, entities.NewInternalError(err)
+		}
+	} else {
+		tracks = make([]entities.Track, 0, len(params.TrackIds))
+
+		for _, trackId := range params.TrackIds {
+			track, err := u.trackRepository.GetTrack(trackId)
+			if err != nil {
+				if errors.Is(err, repositories.TrackNotFoundError) {
+					return nil, entities.NewNotFoundError("Track not found")
+				}
+				return nil, entities.NewInternalError(err)
+			}
+
+			if track.UserId != nil && *track.UserId != user.Id {
+				return nil, entities.NewUnauthorizedError()
+			}
+
+			tracks = append(tracks, *track)
+		}
+	}
+
+	if len(tracks) == 0 {
+		return nil, entities.NewValidationError("No track match the given selection")
+	}
+
+	changedTracks := make([]entities.Track, 0, len(tracks))
+	allChanges := make([]entities.TrackChanges, 0, len(tracks))
+
+	for i, track := range tracks {
+		newTrack := applyBulkEditTrackPatch(track, i, len(tracks), params)
+
+		changes := diffTrackMetadata(track, newTrack)
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		changedTracks = append(changedTracks, newTrack)
+		allChanges = append(allChanges, entities.TrackChanges{
+			TrackId: track.Id,
+			Title:   track.Title,
+
+			Changes: changes,
+		})
+	}
+
+	if !params.Commit || len(changedTracks) == 0 {
+		return u.trackPresenter.ShowTrackChanges(allChanges, false), nil
+	}
+
+	now := time.Now()
+
+	for i := range changedTracks {
+		changedTracks[i].MetadataEditedAt = &now
+	}
+
+	if err := u.trackRepository.UpdateTracks(changedTracks); err != nil {
+		logger.MainLogger.Error("Couldn't bulk update tracks in Database", err)
+		return nil, entities.NewInternalError(errors.New("Failed to update tracks"))
+	}
+
+	for i := range changedTracks {
+		if _, err := u.AutoLinkTrack(ctx, changedTracks[i].Id); err != nil {
+			logger.MainLogger.Warn("Couldn't auto link track after bulk edit", err, changedTracks[i].Id)
+		}
+
+		if err := u.writeTrackTags(&changedTracks[i]); err != nil {
+			logger.MainLogger.Warn("Couldn't write track tags into audio file", err, changedTracks[i].Id)
+		}
+	}
+
+	return u.trackPresenter.ShowTrackChanges(allChanges, true), nil
+}
+
+func applyBulkEditTrackPatch(
+	track entities.Track,
+	position int,
+	total int,
+	params BulkEditTracksParams,
+) entities.Track {
+	newTrack := track
+
+	newTrack.Metadata.Genres = slices.Clone(track.Metadata.Genres)
+	newTrack.Metadata.AlbumArtists = slices.Clone(track.Metadata.AlbumArtists)
+
+	if params.SetGenres != nil {
+		newTrack.Metadata.Genres = slices.Clone(*params.SetGenres)
+	}
+
+	for _, genre := range params.AppendGenres {
+		if !slices.ContainsFunc(newTrack.Metadata.Genres, func(current string) bool {
+			return strings.EqualFold(current, genre)
+		}) {
+			newTrack.Metadata.Genres = append(newTrack.Metadata.Genres, genre)
+		}
+	}
+
+	if len(params.RemoveGenres) != 0 {
+		newTrack.Metadata.Genres = slices.DeleteFunc(
+			newTrack.Metadata.Genres,
+			func(current string) bool {
+				return slices.ContainsFunc(params.RemoveGenres, func(genre string) bool {
+					return strings.EqualFold(current, genre)
+				})
+			},
+		)
+	}
+
+	if params.Album != nil {
+		newTrack.Metadata.Album = *params.Album
+	}
+
+	if params.AlbumArtists != nil {
+		newTrack.Metadata.AlbumArtists = slices.Clone(*params.AlbumArtists)
+		newTrack.Metadata.MusicBrainzAlbumArtistIds = realignArtistMusicBrainzIds(
+			track.Metadata.AlbumArtists,
+			newTrack.Metadata.AlbumArtists,
+			track.Metadata.MusicBrainzAlbumArtistIds,
+		)
+	}
+
+	if params.RenumberTracks {
+		newTrack.Metadata.TrackNumber = params.RenumberStart + position
+		newTrack.Metadata.TotalTracks = params.RenumberStart + total - 1
+	}
+
+	if params.TitleFind != "" {
+		newTrack.Title = strings.ReplaceAll(newTrack.Title, params.TitleFind, params.TitleReplace)
+	}
+
+	return newTrack
+}
+
+func diffTrackMetadata(
+	oldTrack entities.Track,
+	newTrack entities.Track,
+) []entities.TrackFieldChange {
+	changes := []entities.TrackFieldChange{}
+
+	if oldTrack.Title != newTrack.Title {
+		changes = append(changes, entities.TrackFieldChange{
+			Field:  "title",
+			Before: oldTrack.Title,
+			After:  newTrack.Title,
+		})
+	}
+
+	if oldTrack.Metadata.Album != newTrack.Metadata.Album {
+		changes = append(changes, entities.TrackFieldChange{
+			Field:  "album",
+			Before: oldTrack.Metadata.Album,
+			After:  newTrack.Metadata.Album,
+		})
+	}
+
+	if oldTrack.Metadata.TrackNumber != newTrack.Metadata.TrackNumber {
+		changes = append(changes, entities.TrackFieldChange{
+			Field:  "track_number",
+			Before: oldTrack.Metadata.TrackNumber,
+			After:  newTrack.Metadata.TrackNumber,
+		})
+	}
+
+	if oldTrack.Metadata.TotalTracks != newTrack.Metadata.TotalTracks {
+		changes = append(changes, entities.TrackFieldChange{
+			Field:  "total_tracks",
+			Before: oldTrack.Metadata.TotalTracks,
+			After:  newTrack.Metadata.TotalTracks,
+		})
+	}
+
+	if !slices.Equal(oldTrack.Metadata.Genres, newTrack.Metadata.Genres) {
+		changes = append(changes, entities.TrackFieldChange{
+			Field:  "genres",
+			Before: oldTrack.Metadata.Genres,
+			After:  newTrack.Metadata.Genres,
+		})
+	}
+
+	if !slices.Equal(oldTrack.Metadata.Artists, newTrack.Metadata.Artists) {
+		changes = append(changes, entities.TrackFieldChange{
+			Field:  "artists",
+			Before: oldTrack.Metadata.Artists,
+			After:  newTrack.Metadata.Artists,
+		})
+	}
+
+	if !slices.Equal(oldTrack.Metadata.AlbumArtists, newTrack.Metadata.AlbumArtists) {
+		changes = append(changes, entities.TrackFieldChange{
+			Field:  "album_artists",
+			Before: oldTrack.Metadata.AlbumArtists,
+			After:  newTrack.Metadata.AlbumArtists,
+		})
+	}
+
+	return changes
+}
@@ -60,6 +60,18 @@ func (u *TrackUsecase) ChangeTrackAudio(
 		return nil, err
 	}
 
+	if err := u.analyzeTrackLoudness(track); err != nil {
+		logger.MainLogger.Warn("Couldn't analyze track loudness", err, track.Id)
+	}
+
+	if err := u.analyzeTrackAudio(track, true); err != nil {
+		logger.MainLogger.Warn("Couldn't analyze track audio", err, track.Id)
+	}
+
+	if err := u.checkTrackQuality(track); err != nil {
+		logger.MainLogger.Warn("Couldn't check track quality", err, track.Id)
+	}
+
 	track.Scores, err = u.trackRepository.GetAllScoresByTrack(track.Id)
 	if err != nil {
 		return nil, entities.NewInternalError(err)
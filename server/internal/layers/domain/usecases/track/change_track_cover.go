@@ -41,6 +41,7 @@ func (u *TrackUsecase) ChangeTrackCover(
 	}
 
 	track.CoverSignature = u.coverStorage.GetTrackCoverSignature(track)
+	track.CoverPalette = u.coverStorage.GetTrackCoverPalette(track)
 
 	err = u.trackRepository.UpdateTrack(track)
 	if err != nil {
@@ -0,0 +1,192 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/scanners"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/Melodink/server/pkgs/audioanalysis"
+)
+
+// A lossless file with a sharp lowpass under this frequency in Hz was encoded
+// with a lossy codec before.
+const lossyCutoffFrequency = 20500
+
+func (u *TrackUsecase) CheckTrackQuality(
+	ctx context.Context,
+	trackId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := u.trackRepository.GetTrack(trackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if track.UserId != nil && *track.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if err := u.checkTrackQuality(track); err != nil {
+		return nil, entities.NewInternalError(errors.New("Failed to check track quality"))
+	}
+
+	track.Scores, err = u.trackRepository.GetAllScoresByTrack(track.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.trackPresenter.ShowTrack(ctx, *track), nil
+}
+
+// CheckTracksQuality check every track of the user which was never checked.
+func (u *TrackUsecase) CheckTracksQuality(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := u.trackRepository.GetAllTracksFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	checkedTracks := make([]entities.Track, 0, len(tracks))
+
+	for i := range tracks {
+		if tracks[i].QualityCheck.Verdict != nil {
+			continue
+		}
+
+		if err := u.checkTrackQuality(&tracks[i]); err != nil {
+			logger.MainLogger.Warn("Couldn't check track quality", err, tracks[i].Id)
+			continue
+		}
+
+		checkedTracks = append(checkedTracks, tracks[i])
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(checkedTracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.trackPresenter.ShowTracks(ctx, checkedTracks), nil
+}
+
+// ListSuspiciousTracks report the tracks of the user whose quality check
+// found a lossy source or an upsampling.
+func (u *TrackUsecase) ListSuspiciousTracks(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := u.trackRepository.GetSuspiciousTracksFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(tracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.trackPresenter.ShowTracks(ctx, tracks), nil
+}
+
+func (u *TrackUsecase) checkTrackQuality(track *entities.Track) error {
+	result, err := u.spectrumScanner.ScanSpectrum(track.Path, track.SampleRate)
+	if err != nil {
+		return err
+	}
+
+	track.QualityCheck = evaluateTrackQuality(*track, result)
+
+	if err := u.trackRepository.UpdateTrack(track); err != nil {
+		logger.MainLogger.Error("Couldn't update track quality check in Database", err, *track)
+		return err
+	}
+
+	return nil
+}
+
+// evaluateTrackQuality compare what the file claim with what its spectrum and
+// samples really contain.
+func evaluateTrackQuality(
+	track entities.Track,
+	result scanners.SpectrumScanResult,
+) entities.TrackQualityCheck {
+	cutoff := result.Spectrum.CutoffFrequency
+
+	verdict := entities.TrackQualityVerdictGenuine
+
+	check := entities.TrackQualityCheck{
+		CutoffFrequency: &cutoff,
+	}
+
+	lossless := slices.Contains(losslessFileTypes, track.FileType)
+
+	if result.Spectrum.Brickwall && cutoff < lossyCutoffFrequency {
+		bitRate := audioanalysis.EstimateLossyBitRate(cutoff)
+
+		claimedBitRate := 0
+		if track.BitRate != nil {
+			claimedBitRate = *track.BitRate / 1000
+		}
+
+		if lossless || claimedBitRate >= bitRate*3/2 {
+			verdict = entities.TrackQualityVerdictLossyTranscode
+			check.BitRate = &bitRate
+		}
+	}
+
+	sampleRate := track.SampleRate
+
+	if sampleRate > 48000 && cutoff <= 24000 {
+		sampleRate = 48000
+		if cutoff <= 22050 {
+			sampleRate = 44100
+		}
+
+		if verdict == entities.TrackQualityVerdictGenuine {
+			verdict = entities.TrackQualityVerdictUpsampled
+		}
+	}
+
+	check.SampleRate = &sampleRate
+
+	if lossless && track.BitsPerRawSample != nil {
+		bitsPerSample := *track.BitsPerRawSample
+
+		if result.EffectiveBitsPerSample > 0 && result.EffectiveBitsPerSample < bitsPerSample {
+			bitsPerSample = result.EffectiveBitsPerSample
+
+			if verdict == entities.TrackQualityVerdictGenuine {
+				verdict = entities.TrackQualityVerdictUpsampled
+			}
+		}
+
+		check.BitsPerSample = &bitsPerSample
+	}
+
+	check.Verdict = &verdict
+
+	return check
+}
@@ -32,6 +32,15 @@ type EditTrackParams struct {
 
 	Composer string
 
+	// nil classical fields keep their current value
+	Work         *string
+	MovementName *string
+
+	MovementNumber *int
+	MovementTotal  *int
+
+	MusicBrainzWorkId *string
+
 	AcoustID string
 
 	MusicBrainzReleaseId   string
@@ -74,11 +83,27 @@ func (u *TrackUsecase) EditTrack(
 	track.Metadata.Year = params.Year
 
 	track.Metadata.Genres = params.Genres
-	track.Metadata.Lyrics = params.Lyrics
+	applyTrackLyrics(track, params.Lyrics)
 	track.Metadata.Comment = params.Comment
 
 	track.Metadata.Composer = params.Composer
 
+	if params.Work != nil {
+		track.Metadata.Work = *params.Work
+	}
+	if params.MovementName != nil {
+		track.Metadata.MovementName = *params.MovementName
+	}
+	if params.MovementNumber != nil {
+		track.Metadata.MovementNumber = *params.MovementNumber
+	}
+	if params.MovementTotal != nil {
+		track.Metadata.MovementTotal = *params.MovementTotal
+	}
+	if params.MusicBrainzWorkId != nil {
+		track.Metadata.MusicBrainzWorkId = *params.MusicBrainzWorkId
+	}
+
 	track.Metadata.AcoustID = params.AcoustID
 
 	track.Metadata.MusicBrainzReleaseId = params.MusicBrainzReleaseId
@@ -89,11 +114,18 @@ func (u *TrackUsecase) EditTrack(
 		track.DateAdded = *params.DateAdded
 	}
 
+	now := time.Now()
+	track.MetadataEditedAt = &now
+
 	if err := u.trackRepository.UpdateTrack(track); err != nil {
 		logger.MainLogger.Error("Couldn't update track in Database", err, *track)
 		return nil, entities.NewInternalError(errors.New("Failed to update track"))
 	}
 
+	if err := u.writeTrackTags(track); err != nil {
+		logger.MainLogger.Warn("Couldn't write track tags into audio file", err, *track)
+	}
+
 	track.Scores, err = u.trackRepository.GetAllScoresByTrack(track.Id)
 	if err != nil {
 		return nil, entities.NewInternalError(err)
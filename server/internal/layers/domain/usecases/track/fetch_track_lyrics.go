@@ -0,0 +1,224 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/scanners"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/Melodink/server/pkgs/lrc"
+)
+
+func (u *TrackUsecase) FetchTrackLyrics(
+	ctx context.Context,
+	trackId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := u.trackRepository.GetTrack(trackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if track.UserId != nil && *track.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	changed, err := u.fetchTrackLyrics(track)
+	if err != nil {
+		if errors.Is(err, scanners.LyricsNotFoundError) {
+			return nil, entities.NewNotFoundError("No lyrics found for this track")
+		}
+		return nil, entities.NewInternalError(errors.New("Failed to fetch track lyrics"))
+	}
+
+	if changed {
+		if err := u.saveFetchedTrackLyrics(track); err != nil {
+			return nil, entities.NewInternalError(errors.New("Failed to update track"))
+		}
+	}
+
+	return u.trackPresenter.ShowTrackLyrics(*track, entities.TrackLyricsFormatJSON), nil
+}
+
+// FetchMissingTracksLyrics start fetching in the background the lyrics of
+// every track of the user without any lyrics.
+func (u *TrackUsecase) FetchMissingTracksLyrics(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	started := u.libraryJobs.start("fetch-lyrics", user.Id, func() {
+		u.fetchMissingTracksLyrics(user.Id)
+	})
+
+	if !started {
+		return models.PlainAPIResponse{
+			Status: http.StatusAccepted,
+			Text:   "Lyrics fetching is already running",
+		}, nil
+	}
+
+	return models.PlainAPIResponse{
+		Status: http.StatusAccepted,
+		Text:   "Lyrics fetching started",
+	}, nil
+}
+
+func (u *TrackUsecase) fetchMissingTracksLyrics(userId int) {
+	tracks, err := u.trackRepository.GetAllTracksFromUser(userId)
+	if err != nil {
+		logger.MainLogger.Error("Couldn't get tracks from Database", err, userId)
+		return
+	}
+
+	updatedCount := 0
+
+	for i := range tracks {
+		if !helpers.IsEmptyOrWhitespace(tracks[i].Metadata.Lyrics) ||
+			len(tracks[i].Metadata.SyncedLyrics) > 0 {
+			continue
+		}
+
+		changed, err := u.fetchTrackLyrics(&tracks[i])
+		if err != nil {
+			if !errors.Is(err, scanners.LyricsNotFoundError) {
+				logger.MainLogger.Warn("Couldn't fetch track lyrics", err, tracks[i].Id)
+			}
+			continue
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err := u.saveFetchedTrackLyrics(&tracks[i]); err != nil {
+			continue
+		}
+
+		updatedCount++
+	}
+
+	logger.MainLogger.Infof("Fetched lyrics of %d tracks of user %d", updatedCount, userId)
+}
+
+func (u *TrackUsecase) saveFetchedTrackLyrics(track *entities.Track) error {
+	if err := u.trackRepository.UpdateTrack(track); err != nil {
+		logger.MainLogger.Error("Couldn't update track lyrics in Database", err, *track)
+		return err
+	}
+
+	if err := u.writeTrackTags(track); err != nil {
+		logger.MainLogger.Warn("Couldn't write track tags into audio file", err, *track)
+	}
+
+	return nil
+}
+
+// fetchTrackLyrics ask the lyrics provider, or its cache, for the lyrics of
+// the track. Plain lyrics never replace synced ones and lyrics already set are
+// kept when synced ones are found, it return true when the track lyrics
+// changed.
+func (u *TrackUsecase) fetchTrackLyrics(track *entities.Track) (bool, error) {
+	query := scanners.LyricsQuery{
+		Title:    track.Title,
+		Album:    track.Metadata.Album,
+		Duration: track.Duration,
+	}
+
+	if len(track.Metadata.Artists) > 0 {
+		query.Artist = track.Metadata.Artists[0]
+	} else if len(track.Artists) > 0 {
+		query.Artist = track.Artists[0].Name
+	}
+
+	cache, err := u.getLyricsCache(query)
+	if err != nil {
+		return false, err
+	}
+
+	if !cache.Found || cache.Instrumental {
+		return false, scanners.LyricsNotFoundError
+	}
+
+	if lines, ok := lrc.Parse(cache.SyncedLyrics); ok {
+		track.Metadata.SyncedLyrics = convertLrcToTrackLyricsLines(lines)
+
+		if helpers.IsEmptyOrWhitespace(track.Metadata.Lyrics) {
+			track.Metadata.Lyrics = lrc.PlainText(lines)
+		}
+
+		return true, nil
+	}
+
+	if len(track.Metadata.SyncedLyrics) > 0 ||
+		helpers.IsEmptyOrWhitespace(cache.PlainLyrics) ||
+		track.Metadata.Lyrics == cache.PlainLyrics {
+		return false, nil
+	}
+
+	track.Metadata.Lyrics = cache.PlainLyrics
+
+	return true, nil
+}
+
+// getLyricsCache return the cached provider answer for the query, misses
+// are retried after LYRICS_PROVIDER_MISS_RETRY_DAYS.
+func (u *TrackUsecase) getLyricsCache(query scanners.LyricsQuery) (entities.LyricsCache, error) {
+	key := strings.ToLower(strings.Join([]string{
+		strings.TrimSpace(query.Artist),
+		strings.TrimSpace(query.Title),
+		strings.TrimSpace(query.Album),
+		fmt.Sprint(int(math.Round(float64(query.Duration) / 1000))),
+	}, "\x1f"))
+
+	missRetryDays := helpers.GetEnvInt("LYRICS_PROVIDER_MISS_RETRY_DAYS", 30)
+
+	cache, err := u.trackRepository.GetLyricsCache(key)
+	if err == nil {
+		if cache.Found || time.Since(cache.FetchedAt) < time.Duration(missRetryDays)*24*time.Hour {
+			return cache, nil
+		}
+	} else if !errors.Is(err, repositories.LyricsCacheNotFoundError) {
+		return entities.LyricsCache{}, err
+	}
+
+	result, err := u.lyricsScanner.FetchLyrics(query)
+	if err != nil && !errors.Is(err, scanners.LyricsNotFoundError) {
+		return entities.LyricsCache{}, err
+	}
+
+	cache = entities.LyricsCache{
+		Key: key,
+
+		Found:        err == nil,
+		Instrumental: result.Instrumental,
+
+		PlainLyrics:  result.PlainLyrics,
+		SyncedLyrics: result.SyncedLyrics,
+	}
+
+	if err := u.trackRepository.SetLyricsCache(&cache); err != nil {
+		logger.MainLogger.Warn("Couldn't save lyrics cache in Database", err, key)
+	}
+
+	return cache, nil
+}
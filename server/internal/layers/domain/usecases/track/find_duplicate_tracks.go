@@ -0,0 +1,237 @@
+package track_usecase
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/Melodink/server/pkgs/fingerprintmatch"
+)
+
+// Two different encodes of the same recording stay above this similarity
+// while unrelated songs are around 0.5 to 0.6.
+const duplicateFingerprintMinSimilarity = 0.85
+
+// Only tracks whose durations are this close in milliseconds are compared.
+const duplicateMaxDurationDifference = 5000
+
+var losslessFileTypes = []string{"FLAC", "ALAC", "DSF"}
+
+// FindDuplicateTracks group the tracks of the user sharing the same audio
+// file, the same AcoustID or a similar fingerprint.
+func (u *TrackUsecase) FindDuplicateTracks(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := u.trackRepository.GetAllTracksFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	fingerprints, err := u.trackRepository.GetAllTrackFingerprintsFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	groups := findDuplicateGroups(tracks, fingerprints)
+
+	for i := range groups {
+		err = u.trackRepository.LoadAllScoresWithTracks(groups[i].Tracks)
+		if err != nil {
+			return nil, entities.NewInternalError(err)
+		}
+	}
+
+	return u.trackPresenter.ShowTrackDuplicateGroups(ctx, groups), nil
+}
+
+type duplicateLinks struct {
+	parents []int
+	reasons map[int][]entities.TrackDuplicateReason
+}
+
+func (l *duplicateLinks) find(i int) int {
+	for l.parents[i] != i {
+		l.parents[i] = l.parents[l.parents[i]]
+		i = l.parents[i]
+	}
+	return i
+}
+
+func (l *duplicateLinks) link(a int, b int, reason entities.TrackDuplicateReason) {
+	rootA, rootB := l.find(a), l.find(b)
+
+	if rootA != rootB {
+		l.parents[rootB] = rootA
+
+		for _, r := range l.reasons[rootB] {
+			if !slices.Contains(l.reasons[rootA], r) {
+				l.reasons[rootA] = append(l.reasons[rootA], r)
+			}
+		}
+
+		delete(l.reasons, rootB)
+	}
+
+	if !slices.Contains(l.reasons[rootA], reason) {
+		l.reasons[rootA] = append(l.reasons[rootA], reason)
+	}
+}
+
+func findDuplicateGroups(
+	tracks []entities.Track,
+	fingerprints []entities.TrackFingerprint,
+) []entities.TrackDuplicateGroup {
+	links := duplicateLinks{
+		parents: make([]int, len(tracks)),
+		reasons: map[int][]entities.TrackDuplicateReason{},
+	}
+
+	for i := range tracks {
+		links.parents[i] = i
+	}
+
+	linkSameValue := func(value func(track entities.Track) string, reason entities.TrackDuplicateReason) {
+		firsts := map[string]int{}
+
+		for i, track := range tracks {
+			key := value(track)
+			if helpers.IsEmptyOrWhitespace(key) {
+				continue
+			}
+
+			if first, ok := firsts[key]; ok {
+				links.link(first, i, reason)
+				continue
+			}
+
+			firsts[key] = i
+		}
+	}
+
+	linkSameValue(func(track entities.Track) string {
+		return track.FileSignature
+	}, entities.TrackDuplicateReasonFileSignature)
+
+	linkSameValue(func(track entities.Track) string {
+		return track.Metadata.AcoustID
+	}, entities.TrackDuplicateReasonAcoustID)
+
+	indexes := make(map[int]int, len(tracks))
+
+	for i, track := range tracks {
+		indexes[track.Id] = i
+	}
+
+	fingerprinted := make([]entities.TrackFingerprint, 0, len(fingerprints))
+
+	for _, fingerprint := range fingerprints {
+		if _, ok := indexes[fingerprint.TrackId]; ok && len(fingerprint.Fingerprint) > 0 {
+			fingerprinted = append(fingerprinted, fingerprint)
+		}
+	}
+
+	sort.Slice(fingerprinted, func(a, b int) bool {
+		return tracks[indexes[fingerprinted[a].TrackId]].Duration <
+			tracks[indexes[fingerprinted[b].TrackId]].Duration
+	})
+
+	for a := range fingerprinted {
+		trackA := indexes[fingerprinted[a].TrackId]
+
+		for b := a + 1; b < len(fingerprinted); b++ {
+			trackB := indexes[fingerprinted[b].TrackId]
+
+			if tracks[trackB].Duration-tracks[trackA].Duration > duplicateMaxDurationDifference {
+				break
+			}
+
+			if links.find(trackA) == links.find(trackB) {
+				continue
+			}
+
+			similarity := fingerprintmatch.Similarity(
+				fingerprinted[a].Fingerprint,
+				fingerprinted[b].Fingerprint,
+				fingerprintmatch.DefaultMaxOffset,
+			)
+
+			if similarity >= duplicateFingerprintMinSimilarity {
+				links.link(trackA, trackB, entities.TrackDuplicateReasonFingerprint)
+			}
+		}
+	}
+
+	members := map[int][]entities.Track{}
+	roots := []int{}
+
+	for i, track := range tracks {
+		root := links.find(i)
+
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+
+		members[root] = append(members[root], track)
+	}
+
+	groups := []entities.TrackDuplicateGroup{}
+
+	for _, root := range roots {
+		if len(members[root]) < 2 {
+			continue
+		}
+
+		rankTracksByQuality(members[root])
+
+		groups = append(groups, entities.TrackDuplicateGroup{
+			Reasons: links.reasons[root],
+			Tracks:  members[root],
+		})
+	}
+
+	return groups
+}
+
+// rankTracksByQuality put lossless files first then sort by bit depth,
+// sample rate and bitrate. The oldest track win a tie.
+func rankTracksByQuality(tracks []entities.Track) {
+	valueOrZero := func(value *int) int {
+		if value == nil {
+			return 0
+		}
+		return *value
+	}
+
+	sort.SliceStable(tracks, func(a, b int) bool {
+		trackA, trackB := tracks[a], tracks[b]
+
+		losslessA := slices.Contains(losslessFileTypes, trackA.FileType)
+		losslessB := slices.Contains(losslessFileTypes, trackB.FileType)
+
+		if losslessA != losslessB {
+			return losslessA
+		}
+
+		if bitsA, bitsB := valueOrZero(trackA.BitsPerRawSample), valueOrZero(trackB.BitsPerRawSample); bitsA != bitsB {
+			return bitsA > bitsB
+		}
+
+		if trackA.SampleRate != trackB.SampleRate {
+			return trackA.SampleRate > trackB.SampleRate
+		}
+
+		if rateA, rateB := valueOrZero(trackA.BitRate), valueOrZero(trackB.BitRate); rateA != rateB {
+			return rateA > rateB
+		}
+
+		return trackA.Id < trackB.Id
+	})
+}
@@ -0,0 +1,80 @@
+package track_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// ScanTracksFingerprints compute the fingerprint of every track of the user
+// which doesn't have one for its current audio file.
+func (u *TrackUsecase) ScanTracksFingerprints(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := u.trackRepository.GetAllTracksFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	fingerprints, err := u.trackRepository.GetAllTrackFingerprintsFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	fingerprinted := make(map[int]bool, len(fingerprints))
+
+	for _, fingerprint := range fingerprints {
+		fingerprinted[fingerprint.TrackId] = true
+	}
+
+	scannedTracks := make([]entities.Track, 0, len(tracks))
+
+	for i := range tracks {
+		if fingerprinted[tracks[i].Id] {
+			continue
+		}
+
+		if err := u.fingerprintTrack(&tracks[i]); err != nil {
+			logger.MainLogger.Warn("Couldn't fingerprint track", err, tracks[i].Id)
+			continue
+		}
+
+		scannedTracks = append(scannedTracks, tracks[i])
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(scannedTracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.trackPresenter.ShowTracks(ctx, scannedTracks), nil
+}
+
+func (u *TrackUsecase) fingerprintTrack(track *entities.Track) error {
+	rawFingerprint, err := u.acoustIdScanner.ScanAcoustIdRawFingerprint(track.Path)
+	if err != nil {
+		return err
+	}
+
+	fingerprint := entities.TrackFingerprint{
+		TrackId: track.Id,
+
+		FileSignature: track.FileSignature,
+		Fingerprint:   rawFingerprint,
+	}
+
+	if err := u.trackRepository.SetTrackFingerprint(&fingerprint); err != nil {
+		logger.MainLogger.Error("Couldn't save track fingerprint in Database", err, track.Id)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package track_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// GenerateTracksCoverPalette compute the cover palette of every track of the
+// user with a cover but no palette yet.
+func (u *TrackUsecase) GenerateTracksCoverPalette(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks, err := u.trackRepository.GetAllTracksFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	updatedTracks := make([]entities.Track, 0, len(tracks))
+
+	for i := range tracks {
+		if tracks[i].CoverSignature == "" || tracks[i].CoverPalette.BlurHash != "" {
+			continue
+		}
+
+		tracks[i].CoverPalette = u.coverStorage.GetTrackCoverPalette(&tracks[i])
+
+		if tracks[i].CoverPalette.BlurHash == "" {
+			logger.MainLogger.Warn("Couldn't generate track cover palette", tracks[i].Id)
+			continue
+		}
+
+		if err := u.trackRepository.UpdateTrack(&tracks[i]); err != nil {
+			logger.MainLogger.Warn("Couldn't update track cover palette in Database", err, tracks[i].Id)
+			continue
+		}
+
+		updatedTracks = append(updatedTracks, tracks[i])
+	}
+
+	err = u.trackRepository.LoadAllScoresWithTracks(updatedTracks)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.trackPresenter.ShowTracks(ctx, updatedTracks), nil
+}
@@ -0,0 +1,52 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *TrackUsecase) GetTrackCoverVariant(
+	ctx context.Context,
+	trackId int,
+	variant entities.CoverVariant,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := u.trackRepository.GetTrack(trackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if track.UserId != nil && *track.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	image, etag, err := u.coverStorage.GetTrackCoverVariant(track, variant)
+	if err != nil {
+		if errors.Is(err, storages.OriginalCoverNotFoundError) {
+			return nil, entities.NewNotFoundError("No image available for this track")
+		}
+		if errors.Is(err, storages.CoverFormatNotSupportedError) {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	return &models.ImageAPIResponse{
+		MIMEType: variant.Format.MIMEType(),
+		Data:     image,
+		ETag:     etag,
+	}, nil
+}
@@ -12,6 +12,7 @@ import (
 func (u *TrackUsecase) GetTrackLyrics(
 	ctx context.Context,
 	trackId int,
+	format entities.TrackLyricsFormat,
 ) (models.APIResponse, error) {
 	track, err := u.trackRepository.GetTrack(trackId)
 	if err != nil {
@@ -21,7 +22,9 @@ func (u *TrackUsecase) GetTrackLyrics(
 		return nil, entities.NewInternalError(err)
 	}
 
-	return models.PlainAPIResponse{
-		Text: track.Metadata.Lyrics,
-	}, nil
+	if format == entities.TrackLyricsFormatLRC && len(track.Metadata.SyncedLyrics) == 0 {
+		return nil, entities.NewNotFoundError("Track has no synced lyrics")
+	}
+
+	return u.trackPresenter.ShowTrackLyrics(*track, format), nil
 }
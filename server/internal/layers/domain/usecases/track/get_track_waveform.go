@@ -0,0 +1,110 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+var waveformLocks sync.Map
+
+func getWaveformLock(trackId int) *sync.Mutex {
+	actual, _ := waveformLocks.LoadOrStore(trackId, &sync.Mutex{})
+	return actual.(*sync.Mutex)
+}
+
+// getWaveformResolution pick the smallest generated resolution that has at
+// least the requested amount of peaks.
+func getWaveformResolution(requested int) int {
+	for _, resolution := range entities.TrackWaveformResolutions {
+		if resolution >= requested {
+			return resolution
+		}
+	}
+
+	return slices.Max(entities.TrackWaveformResolutions)
+}
+
+func (u *TrackUsecase) GetTrackWaveform(
+	ctx context.Context,
+	trackId int,
+	resolution int,
+	fileSignature *string,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := u.trackRepository.GetTrack(trackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if track.UserId != nil && *track.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	if fileSignature != nil && *fileSignature != track.FileSignature {
+		return nil, entities.NewNotFoundError("Track file have changed")
+	}
+
+	lock := getWaveformLock(track.Id)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	waveform, err := u.transcodeStorage.GetTrackWaveform(track.Id)
+	if err != nil && !errors.Is(err, storages.WaveformNotFoundError) {
+		return nil, entities.NewInternalError(err)
+	}
+
+	if waveform == nil || waveform.Signature != track.FileSignature {
+		peaks, err := u.waveformProcessor.GenerateWaveform(track.Path)
+		if err != nil {
+			return nil, entities.NewInternalError(errors.New("Failed to generate track waveform"))
+		}
+
+		waveform = &entities.TrackWaveform{
+			Signature: track.FileSignature,
+			Peaks:     peaks,
+		}
+
+		if err := u.transcodeStorage.SaveTrackWaveform(track.Id, *waveform); err != nil {
+			logger.MainLogger.Warn("Couldn't save track waveform", err, track.Id)
+		}
+	}
+
+	return u.trackPresenter.ShowTrackWaveform(
+		*waveform,
+		getWaveformResolution(resolution),
+	), nil
+}
+
+func (u *TrackUsecase) GetTrackWaveformSignature(
+	ctx context.Context,
+	trackId int,
+) (models.APIResponse, error) {
+	track, err := u.trackRepository.GetTrack(trackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	return models.PlainAPIResponse{
+		Text: track.FileSignature,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package track_usecase
+
+import (
+	"fmt"
+	"sync"
+)
+
+// libraryJobs run the jobs going through the whole library of a user in the
+// background, a job isn't started again while it's still running.
+type libraryJobs struct {
+	mutex   sync.Mutex
+	running map[string]bool
+}
+
+func newLibraryJobs() *libraryJobs {
+	return &libraryJobs{
+		running: map[string]bool{},
+	}
+}
+
+// start run job in a goroutine and return false when the same job is
+// already running for the user.
+func (j *libraryJobs) start(name string, userId int, job func()) bool {
+	key := fmt.Sprintf("%s-%d", name, userId)
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.running[key] {
+		return false
+	}
+
+	j.running[key] = true
+
+	go func() {
+		defer func() {
+			j.mutex.Lock()
+			delete(j.running, key)
+			j.mutex.Unlock()
+		}()
+
+		job()
+	}()
+
+	return true
+}
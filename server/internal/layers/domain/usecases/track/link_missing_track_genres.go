@@ -0,0 +1,38 @@
+package track_usecase
+
+import (
+	"github.com/gungun974/Melodink/server/internal/logger"
+)
+
+// LinkMissingTrackGenres link the genres tag of the tracks which have no
+// genre yet, the tracks imported before the genres existed only have the tag.
+func (u *TrackUsecase) LinkMissingTrackGenres() {
+	tracks, err := u.trackRepository.GetAllTracksWithUnlinkedGenres()
+	if err != nil {
+		logger.MainLogger.Error("Couldn't get tracks with unlinked genres from Database", err)
+		return
+	}
+
+	refreshedAlbums := map[int]bool{}
+
+	for i := range tracks {
+		track := &tracks[i]
+
+		if err := u.linkTrackGenres(track, *track.UserId); err != nil {
+			logger.MainLogger.Warn("Couldn't link track genres", err, track.Id)
+			continue
+		}
+
+		for _, album := range track.Albums {
+			if refreshedAlbums[album.Id] {
+				continue
+			}
+
+			refreshedAlbums[album.Id] = true
+
+			if err := u.albumRepository.RefreshAlbumGenres(&album); err != nil {
+				logger.MainLogger.Warn("Couldn't refresh album genres", err, album.Id)
+			}
+		}
+	}
+}
@@ -10,13 +10,24 @@ import (
 
 func (u *TrackUsecase) ListUserTracks(
 	ctx context.Context,
+	filter *entities.TrackFilter,
+	sort entities.TrackSort,
 ) (models.APIResponse, error) {
 	user, err := helpers.ExtractCurrentLoggedUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	tracks, err := u.trackRepository.GetAllTracksFromUser(user.Id)
+	var tracks []entities.Track
+
+	if filter == nil && sort.Field == entities.TrackSortDefault {
+		tracks, err = u.trackRepository.GetAllTracksFromUser(user.Id)
+	} else {
+		if filter == nil {
+			filter = &entities.TrackFilter{}
+		}
+		tracks, err = u.trackRepository.GetAllTracksFromUserByFilter(user.Id, *filter, sort)
+	}
 	if err != nil {
 		return nil, entities.NewInternalError(err)
 	}
@@ -0,0 +1,97 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// MergeTracks keep the track trackId and delete its duplicates, playlists,
+// scores and play history of the duplicates are moved to the kept track in a
+// single transaction.
+func (u *TrackUsecase) MergeTracks(
+	ctx context.Context,
+	trackId int,
+	duplicateIds []int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := u.trackRepository.GetTrack(trackId)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if track.UserId != nil && *track.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	duplicates := make([]entities.Track, 0, len(duplicateIds))
+	mergedIds := make([]int, 0, len(duplicateIds))
+
+	// Every id is checked before anything is moved so a bad id can't leave a
+	// half done merge
+	for _, duplicateId := range duplicateIds {
+		if duplicateId == track.Id {
+			return nil, entities.NewValidationError("A track can't be merged with itself")
+		}
+
+		if slices.Contains(mergedIds, duplicateId) {
+			continue
+		}
+
+		duplicate, err := u.trackRepository.GetTrack(duplicateId)
+		if err != nil {
+			if errors.Is(err, repositories.TrackNotFoundError) {
+				return nil, entities.NewNotFoundError("Track not found")
+			}
+			return nil, entities.NewInternalError(err)
+		}
+
+		if duplicate.UserId != nil && *duplicate.UserId != user.Id {
+			return nil, entities.NewUnauthorizedError()
+		}
+
+		duplicates = append(duplicates, *duplicate)
+		mergedIds = append(mergedIds, duplicateId)
+	}
+
+	if err := u.trackRepository.MergeTracks(track.Id, mergedIds); err != nil {
+		logger.MainLogger.Error("Couldn't merge tracks in Database", err, mergedIds)
+		return nil, entities.NewInternalError(errors.New("Failed to merge tracks"))
+	}
+
+	for i := range duplicates {
+		duplicate := &duplicates[i]
+
+		if err := u.trackStorage.RemoveAudioFile(duplicate); err != nil {
+			logger.MainLogger.Warn("Couldn't delete audio file from storage", err, *duplicate)
+		}
+
+		if err := u.coverStorage.RemoveTrackCoverFiles(duplicate); err != nil {
+			logger.MainLogger.Warn("Couldn't delete cover files from storage", err, *duplicate)
+		}
+
+		if err := u.transcodeStorage.RemoveTrackTranscocdeDirectry(duplicate.Id); err != nil {
+			logger.MainLogger.Warn("Couldn't delete transcode files from storage", err, *duplicate)
+		}
+	}
+
+	track.Scores, err = u.trackRepository.GetAllScoresByTrack(track.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	return u.trackPresenter.ShowTrack(ctx, *track), nil
+}
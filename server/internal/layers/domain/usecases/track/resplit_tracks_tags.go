@@ -0,0 +1,108 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// ResplitTracksTags read again the artists, album artists and genres of every
+// user track with the current split rules. Without commit it only return the
+// tracks that would change. The tracks edited by hand are skipped unless
+// includeEdited is set since the resplit would overwrite these edits.
+func (u *TrackUsecase) ResplitTracksTags(
+	ctx context.Context,
+	commit bool,
+	includeEdited bool,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := u.trackRepository.GetTagSplitRules(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	tracks, err := u.trackRepository.GetAllTracksFromUser(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	changedTracks := make([]entities.Track, 0)
+	allChanges := make([]entities.TrackChanges, 0)
+
+	for _, track := range tracks {
+		if track.MetadataEditedAt != nil && !includeEdited {
+			continue
+		}
+
+		artists, albumArtists, genres, err := scanAudioMultiValueTags(track.Path, rules)
+		if err != nil {
+			logger.MainLogger.Warnf("Can't read tags of track %d : %v", track.Id, err)
+			continue
+		}
+
+		newTrack := track
+
+		newTrack.Metadata.Artists = artists
+		newTrack.Metadata.AlbumArtists = albumArtists
+		newTrack.Metadata.Genres = genres
+
+		// The ids are read from the same tags than the names, they keep their
+		// order once the names are split the same way
+		if len(artists) != len(track.Metadata.MusicBrainzArtistIds) {
+			newTrack.Metadata.MusicBrainzArtistIds = realignArtistMusicBrainzIds(
+				track.Metadata.Artists,
+				artists,
+				track.Metadata.MusicBrainzArtistIds,
+			)
+		}
+		if len(albumArtists) != len(track.Metadata.MusicBrainzAlbumArtistIds) {
+			newTrack.Metadata.MusicBrainzAlbumArtistIds = realignArtistMusicBrainzIds(
+				track.Metadata.AlbumArtists,
+				albumArtists,
+				track.Metadata.MusicBrainzAlbumArtistIds,
+			)
+		}
+
+		// The tags of the file are the source of truth again
+		newTrack.MetadataEditedAt = nil
+
+		changes := diffTrackMetadata(track, newTrack)
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		changedTracks = append(changedTracks, newTrack)
+		allChanges = append(allChanges, entities.TrackChanges{
+			TrackId: track.Id,
+			Title:   track.Title,
+
+			Changes: changes,
+		})
+	}
+
+	if !commit || len(changedTracks) == 0 {
+		return u.trackPresenter.ShowTrackChanges(allChanges, false), nil
+	}
+
+	if err := u.trackRepository.UpdateTracks(changedTracks); err != nil {
+		logger.MainLogger.Error("Couldn't update resplit tracks in Database", err)
+		return nil, entities.NewInternalError(errors.New("Failed to update tracks"))
+	}
+
+	for _, track := range changedTracks {
+		if _, err := u.AutoLinkTrack(ctx, track.Id); err != nil {
+			logger.MainLogger.Warn("Couldn't auto link track after resplit", err, track.Id)
+		}
+	}
+
+	return u.trackPresenter.ShowTrackChanges(allChanges, true), nil
+}
@@ -5,16 +5,22 @@ import (
 	"encoding/hex"
 	"errors"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/dhowden/tag"
 	"github.com/gungun974/Melodink/server/internal/helpers"
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/pkgs/audioanalysis"
 	"github.com/gungun974/Melodink/server/pkgs/audiolength"
 	"github.com/gungun974/Melodink/server/pkgs/audioquality"
+	"github.com/gungun974/Melodink/server/pkgs/audiotags"
+	"github.com/gungun974/Melodink/server/pkgs/lrc"
 )
 
 func makeFileSignature(path string) (string, error) {
@@ -34,7 +40,7 @@ func makeFileSignature(path string) (string, error) {
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
-func scanAudio(path string) (entities.Track, error) {
+func scanAudio(path string, rules entities.TagSplitRules) (entities.Track, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return entities.Track{}, err
@@ -50,6 +56,8 @@ func scanAudio(path string) (entities.Track, error) {
 			Path: path,
 		}
 
+		track.Metadata.SyncedLyrics, track.Metadata.Lyrics = scanAudioSyncedLyrics(path, "")
+
 		err = scanAudioFeature(&track)
 		if err != nil {
 			return entities.Track{}, err
@@ -107,29 +115,12 @@ func scanAudio(path string) (entities.Track, error) {
 		}
 	}
 
-	rawArtist := metadata.Artist()
-	rawAlbumArtist := metadata.AlbumArtist()
-	rawGenres := metadata.Genre()
-
-	artists := strings.Split(rawArtist, ",")
-	albumArtists := strings.Split(rawAlbumArtist, ",")
-	genres := strings.Split(rawGenres, ",")
-
-	for i := range artists {
-		artists[i] = strings.TrimSpace(artists[i])
-	}
-
-	for i := range albumArtists {
-		albumArtists[i] = strings.TrimSpace(albumArtists[i])
-	}
-
-	for i := range genres {
-		genres[i] = strings.TrimSpace(genres[i])
+	nativeTags, err := audiotags.ReadTags(path)
+	if err != nil {
+		nativeTags = audiotags.Tags{}
 	}
 
-	artists = helpers.RemoveEmptyStrings(artists)
-	albumArtists = helpers.RemoveEmptyStrings(albumArtists)
-	genres = helpers.RemoveEmptyStrings(genres)
+	artists, albumArtists, genres := splitAudioMultiValueTags(nativeTags, metadata, rules)
 
 	track := entities.Track{
 		Title: metadata.Title(),
@@ -160,10 +151,37 @@ func scanAudio(path string) (entities.Track, error) {
 
 			Artists:      artists,
 			AlbumArtists: albumArtists,
-			Composer:     metadata.Composer(),
+
+			MusicBrainzArtistIds: scanAudioMusicBrainzIds(
+				nativeTags,
+				rawMetadata,
+				"musicbrainz_artistid",
+				"musicbrainz artist id",
+			),
+			MusicBrainzAlbumArtistIds: scanAudioMusicBrainzIds(
+				nativeTags,
+				rawMetadata,
+				"musicbrainz_albumartistid",
+				"musicbrainz album artist id",
+			),
+
+			Composer: metadata.Composer(),
 		},
+
+		Loudness: scanAudioReplayGain(nativeTags, rawMetadata),
+
+		Analysis: scanAudioAnalysisTags(nativeTags, rawMetadata),
 	}
 
+	scanAudioReleaseTags(&track.Metadata, nativeTags, rawMetadata)
+
+	scanAudioClassicalTags(&track.Metadata, nativeTags, rawMetadata)
+
+	track.Metadata.SyncedLyrics, track.Metadata.Lyrics = scanAudioSyncedLyrics(
+		path,
+		track.Metadata.Lyrics,
+	)
+
 	err = scanAudioFeature(&track)
 	if err != nil {
 		return entities.Track{}, err
@@ -172,6 +190,421 @@ func scanAudio(path string) (entities.Track, error) {
 	return track, nil
 }
 
+// scanAudioSyncedLyrics look for timed lyrics in a .lrc sidecar file, then in
+// an ID3 SYLT frame and finally in LRC formatted embedded lyrics. It return
+// the synced lines and the plain lyrics.
+func scanAudioSyncedLyrics(path string, lyrics string) ([]entities.TrackLyricsLine, string) {
+	lines := []entities.TrackLyricsLine{}
+
+	sidecarPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".lrc"
+
+	if data, err := os.ReadFile(sidecarPath); err == nil {
+		if lrcLines, ok := lrc.Parse(string(data)); ok {
+			lines = convertLrcToTrackLyricsLines(lrcLines)
+		}
+	}
+
+	if len(lines) == 0 {
+		if syltLines, err := audiotags.ReadSyncedLyrics(path); err == nil {
+			for _, line := range syltLines {
+				lines = append(lines, entities.TrackLyricsLine{
+					Time: line.Time,
+					Text: strings.TrimSpace(line.Text),
+				})
+			}
+		}
+	}
+
+	if lrcLines, ok := lrc.Parse(lyrics); ok {
+		if len(lines) == 0 {
+			lines = convertLrcToTrackLyricsLines(lrcLines)
+		}
+
+		// Timestamps are not shown to clients reading plain lyrics
+		lyrics = lrc.PlainText(lrcLines)
+	}
+
+	if helpers.IsEmptyOrWhitespace(lyrics) && len(lines) != 0 {
+		lyrics = lrc.PlainText(convertTrackLyricsLinesToLrc(lines))
+	}
+
+	return lines, lyrics
+}
+
+// scanAudioMultiValueTags only read artists, album artists and genres of an
+// audio file with the given split rules.
+func scanAudioMultiValueTags(
+	path string,
+	rules entities.TagSplitRules,
+) ([]string, []string, []string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer file.Close()
+
+	metadata, err := tag.ReadFrom(file)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	nativeTags, err := audiotags.ReadTags(path)
+	if err != nil {
+		nativeTags = audiotags.Tags{}
+	}
+
+	artists, albumArtists, genres := splitAudioMultiValueTags(nativeTags, metadata, rules)
+
+	return artists, albumArtists, genres, nil
+}
+
+func splitAudioMultiValueTags(
+	nativeTags audiotags.Tags,
+	metadata tag.Metadata,
+	rules entities.TagSplitRules,
+) ([]string, []string, []string) {
+	artists := splitTagValues(
+		nativeTags,
+		[]string{"artists", "artist"},
+		metadata.Artist(),
+		rules.ArtistSeparators,
+		rules.ProtectedNames,
+	)
+
+	albumArtists := splitTagValues(
+		nativeTags,
+		[]string{"albumartists", "albumartist", "album artist"},
+		metadata.AlbumArtist(),
+		rules.ArtistSeparators,
+		rules.ProtectedNames,
+	)
+
+	genres := splitTagValues(
+		nativeTags,
+		[]string{"genre"},
+		metadata.Genre(),
+		rules.GenreSeparators,
+		rules.ProtectedNames,
+	)
+
+	return artists, albumArtists, genres
+}
+
+// splitTagValues trust native multi-value tags when the file has them and
+// fallback to split the single value with the separators.
+func splitTagValues(
+	nativeTags audiotags.Tags,
+	keys []string,
+	value string,
+	separators []string,
+	protectedNames []string,
+) []string {
+	for _, key := range keys {
+		values := nativeTags[key]
+
+		if len(values) <= 1 {
+			continue
+		}
+
+		result := make([]string, len(values))
+
+		for i := range values {
+			result[i] = strings.TrimSpace(values[i])
+		}
+
+		return helpers.RemoveEmptyStrings(result)
+	}
+
+	return helpers.SplitTagValue(value, separators, protectedNames)
+}
+
+var musicBrainzIdRegex = regexp.MustCompile(
+	`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`,
+)
+
+// scanAudioMusicBrainzIds read every MusicBrainz id of a tag whatever the
+// separator used by the tagger since ids can't contain one.
+func scanAudioMusicBrainzIds(
+	nativeTags audiotags.Tags,
+	rawMetadata map[string]any,
+	keys ...string,
+) []string {
+	for _, key := range keys {
+		values := nativeTags[key]
+
+		if len(values) == 0 {
+			if data, ok := rawMetadata[key].(string); ok {
+				values = []string{data}
+			}
+		}
+
+		ids := []string{}
+
+		for _, value := range values {
+			for _, id := range musicBrainzIdRegex.FindAllString(value, -1) {
+				ids = append(ids, strings.ToLower(id))
+			}
+		}
+
+		if len(ids) != 0 {
+			return ids
+		}
+	}
+
+	return []string{}
+}
+
+// getAudioTag return the first value of a tag from the native tags with a
+// fallback on dhowden/tag raw metadata.
+func getAudioTag(
+	nativeTags audiotags.Tags,
+	rawMetadata map[string]any,
+	key string,
+) string {
+	if values := nativeTags[key]; len(values) != 0 {
+		return strings.TrimSpace(values[0])
+	}
+
+	if data, ok := rawMetadata[key]; ok {
+		if data, ok := data.(string); ok {
+			return strings.TrimSpace(data)
+		}
+	}
+
+	return ""
+}
+
+// scanAudioReplayGain read loudness already computed by another tool from the
+// ReplayGain tags or the Opus R128 header gains.
+func scanAudioReplayGain(
+	nativeTags audiotags.Tags,
+	rawMetadata map[string]any,
+) entities.TrackLoudness {
+	getTag := func(key string) string {
+		return getAudioTag(nativeTags, rawMetadata, key)
+	}
+
+	loudness := entities.TrackLoudness{}
+
+	if gain, ok := parseReplayGain(getTag("replaygain_track_gain")); ok {
+		integrated := entities.ReplayGainReferenceLoudness - gain
+		loudness.Integrated = &integrated
+	} else if gain, ok := parseR128Gain(getTag("r128_track_gain")); ok {
+		integrated := r128ReferenceLoudness - gain
+		loudness.Integrated = &integrated
+	}
+
+	if peak, ok := parseReplayGainPeak(getTag("replaygain_track_peak")); ok {
+		loudness.TruePeak = &peak
+	}
+
+	if gain, ok := parseReplayGain(getTag("replaygain_album_gain")); ok {
+		integrated := entities.ReplayGainReferenceLoudness - gain
+		loudness.AlbumIntegrated = &integrated
+	} else if gain, ok := parseR128Gain(getTag("r128_album_gain")); ok {
+		integrated := r128ReferenceLoudness - gain
+		loudness.AlbumIntegrated = &integrated
+	}
+
+	if peak, ok := parseReplayGainPeak(getTag("replaygain_album_peak")); ok {
+		loudness.AlbumTruePeak = &peak
+	}
+
+	return loudness
+}
+
+// scanAudioAnalysisTags read the tempo and key set by DJ software.
+func scanAudioAnalysisTags(
+	nativeTags audiotags.Tags,
+	rawMetadata map[string]any,
+) entities.TrackAnalysis {
+	analysis := entities.TrackAnalysis{}
+
+	rawBpm := getAudioTag(nativeTags, rawMetadata, "bpm")
+	if rawBpm == "" {
+		rawBpm = getAudioTag(nativeTags, rawMetadata, "TBPM")
+	}
+
+	if bpm, err := strconv.ParseFloat(rawBpm, 64); err == nil && bpm > 0 && !math.IsInf(bpm, 0) {
+		analysis.Bpm = &bpm
+	}
+
+	for _, tagKey := range []string{"initialkey", "key", "TKEY"} {
+		key, ok := audioanalysis.ParseKey(getAudioTag(nativeTags, rawMetadata, tagKey))
+		if !ok {
+			continue
+		}
+
+		name := key.Name()
+		camelot := key.Camelot()
+
+		analysis.Key = &name
+		analysis.Camelot = &camelot
+
+		break
+	}
+
+	return analysis
+}
+
+// scanAudioReleaseTags read the release infos written by MusicBrainz Picard
+// and most taggers, they are later used to fill the album.
+func scanAudioReleaseTags(
+	metadata *entities.TrackMetadata,
+	nativeTags audiotags.Tags,
+	rawMetadata map[string]any,
+) {
+	getTag := func(keys ...string) string {
+		for _, key := range keys {
+			if value := getAudioTag(nativeTags, rawMetadata, key); value != "" {
+				return value
+			}
+		}
+
+		return ""
+	}
+
+	releaseTypeKeys := []string{"releasetype", "musicbrainz album type", "musicbrainz_albumtype"}
+
+	metadata.ReleaseTypes = splitTagValues(
+		nativeTags,
+		releaseTypeKeys,
+		getTag(releaseTypeKeys...),
+		[]string{";", "/", ","},
+		[]string{},
+	)
+
+	metadata.OriginalDate = getTag("originaldate", "TDOR", "originalyear", "TORY")
+	metadata.Label = getTag("label", "organization", "publisher", "TPUB")
+	metadata.CatalogNumber = getTag("catalognumber")
+	metadata.Barcode = getTag("barcode", "upc", "ean")
+
+	metadata.MusicBrainzReleaseGroupId = getTag(
+		"musicbrainz_releasegroupid",
+		"musicbrainz release group id",
+	)
+}
+
+// scanAudioClassicalTags read the work, the movement and the performers roles
+// of classical music tracks as written by MusicBrainz Picard.
+func scanAudioClassicalTags(
+	metadata *entities.TrackMetadata,
+	nativeTags audiotags.Tags,
+	rawMetadata map[string]any,
+) {
+	getTag := func(keys ...string) string {
+		for _, key := range keys {
+			if value := getAudioTag(nativeTags, rawMetadata, key); value != "" {
+				return value
+			}
+		}
+
+		return ""
+	}
+
+	metadata.Work = getTag("work")
+	metadata.MovementName = getTag("movementname")
+
+	// ID3 MVIN frame hold both the number and the total like "2/4"
+	movementNumber, movementTotal, _ := strings.Cut(getTag("movement", "movementnumber"), "/")
+
+	if number, err := strconv.Atoi(strings.TrimSpace(movementNumber)); err == nil && number > 0 {
+		metadata.MovementNumber = number
+	}
+
+	if movementTotal == "" {
+		movementTotal = getTag("movementtotal")
+	}
+
+	if total, err := strconv.Atoi(strings.TrimSpace(movementTotal)); err == nil && total > 0 {
+		metadata.MovementTotal = total
+	}
+
+	metadata.MusicBrainzWorkId = getTag("musicbrainz_workid", "musicbrainz work id")
+
+	roleTags := []struct {
+		keys []string
+		role string
+	}{
+		{[]string{"conductor"}, entities.TrackArtistRoleConductor},
+		{[]string{"orchestra", "ensemble"}, entities.TrackArtistRoleOrchestra},
+		{[]string{"soloists", "soloist"}, entities.TrackArtistRoleSoloist},
+	}
+
+	for _, roleTag := range roleTags {
+		artists := splitTagValues(
+			nativeTags,
+			roleTag.keys,
+			getTag(roleTag.keys...),
+			[]string{";"},
+			[]string{},
+		)
+
+		for _, artist := range artists {
+			metadata.ArtistsRoles = append(metadata.ArtistsRoles, entities.TrackArtistRole{
+				Type: roleTag.keys[0],
+
+				Role: roleTag.role,
+
+				Artist: artist,
+
+				Attributes: []string{},
+			})
+		}
+	}
+}
+
+// Opus R128 gains are relative to the EBU R128 target.
+const r128ReferenceLoudness = -23.0
+
+// parseReplayGain parse values like "-6.54 dB".
+func parseReplayGain(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+
+	if len(value) >= 2 && strings.EqualFold(value[len(value)-2:], "db") {
+		value = strings.TrimSpace(value[:len(value)-2])
+	}
+
+	if value == "" {
+		return 0, false
+	}
+
+	gain, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsInf(gain, 0) || math.IsNaN(gain) {
+		return 0, false
+	}
+
+	return gain, true
+}
+
+// parseR128Gain parse the Q7.8 fixed point gain of Opus R128 tags.
+func parseR128Gain(value string) (float64, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	gain, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return float64(gain) / 256, true
+}
+
+// parseReplayGainPeak convert a linear sample peak into dBTP.
+func parseReplayGainPeak(value string) (float64, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	peak, err := strconv.ParseFloat(value, 64)
+	if err != nil || peak <= 0 || math.IsInf(peak, 0) || math.IsNaN(peak) {
+		return 0, false
+	}
+
+	return 20 * math.Log10(peak), true
+}
+
 func scanAudioFeature(track *entities.Track) error {
 	signature, err := makeFileSignature(track.Path)
 	if err != nil {
@@ -0,0 +1,80 @@
+package track_usecase
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/pkgs/audiotags"
+)
+
+func TestSplitTagValues(t *testing.T) {
+	rules := entities.NewDefaultTagSplitRules(0)
+
+	tests := []struct {
+		name       string
+		nativeTags audiotags.Tags
+		value      string
+		expected   []string
+	}{
+		{
+			name: "native multi-value tag",
+			nativeTags: audiotags.Tags{
+				"artist": {" Artist A ", "Artist B & C"},
+			},
+			value:    "Artist A; Artist B & C",
+			expected: []string{"Artist A", "Artist B & C"},
+		},
+		{
+			name: "first key with several values wins",
+			nativeTags: audiotags.Tags{
+				"artists": {"Artist A", "Artist B"},
+				"artist":  {"Artist A", "Artist C"},
+			},
+			value:    "Artist A feat. Artist B",
+			expected: []string{"Artist A", "Artist B"},
+		},
+		{
+			name: "single native value fallback to separators",
+			nativeTags: audiotags.Tags{
+				"artist": {"Artist A feat. Artist B"},
+			},
+			value:    "Artist A feat. Artist B",
+			expected: []string{"Artist A", "Artist B"},
+		},
+		{
+			name:       "no native tags fallback to separators",
+			nativeTags: audiotags.Tags{},
+			value:      "Artist A; Artist B / Artist C",
+			expected:   []string{"Artist A", "Artist B", "Artist C"},
+		},
+		{
+			name:       "protected names are kept",
+			nativeTags: audiotags.Tags{},
+			value:      "Earth, Wind & Fire & AC/DC",
+			expected:   []string{"Earth, Wind & Fire", "AC/DC"},
+		},
+		{
+			name:       "comma is not an artist separator",
+			nativeTags: audiotags.Tags{},
+			value:      "Tyler, The Creator",
+			expected:   []string{"Tyler, The Creator"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values := splitTagValues(
+				test.nativeTags,
+				[]string{"artists", "artist"},
+				test.value,
+				rules.ArtistSeparators,
+				rules.ProtectedNames,
+			)
+
+			if !slices.Equal(values, test.expected) {
+				t.Fatalf("expected %q, got %q", test.expected, values)
+			}
+		})
+	}
+}
@@ -34,7 +34,12 @@ func (u *TrackUsecase) ScanTrack(
 		return nil, entities.NewUnauthorizedError()
 	}
 
-	scannedTrack, err := scanAudio(track.Path)
+	rules, err := u.trackRepository.GetTagSplitRules(user.Id)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	scannedTrack, err := scanAudio(track.Path, rules)
 	if err != nil {
 		logger.MainLogger.Error("Failed to scan audio")
 		return nil, err
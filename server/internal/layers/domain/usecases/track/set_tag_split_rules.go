@@ -0,0 +1,44 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+type SetTagSplitRulesParams struct {
+	ArtistSeparators []string
+	GenreSeparators  []string
+
+	ProtectedNames []string
+}
+
+func (u *TrackUsecase) SetTagSplitRules(
+	ctx context.Context,
+	params SetTagSplitRulesParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := entities.TagSplitRules{
+		UserId: user.Id,
+
+		ArtistSeparators: params.ArtistSeparators,
+		GenreSeparators:  params.GenreSeparators,
+
+		ProtectedNames: params.ProtectedNames,
+	}
+
+	if err := u.trackRepository.SetTagSplitRules(&rules); err != nil {
+		logger.MainLogger.Error("Couldn't save tag split rules in Database", err, rules)
+		return nil, entities.NewInternalError(errors.New("Failed to save tag split rules"))
+	}
+
+	return u.trackPresenter.ShowTagSplitRules(rules), nil
+}
@@ -0,0 +1,120 @@
+package track_usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/Melodink/server/pkgs/lrc"
+)
+
+type SetTrackLyricsParams struct {
+	Id int
+
+	Lrc   *string
+	Lines *[]entities.TrackLyricsLine
+}
+
+func (u *TrackUsecase) SetTrackLyrics(
+	ctx context.Context,
+	params SetTrackLyricsParams,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := u.trackRepository.GetTrack(params.Id)
+	if err != nil {
+		if errors.Is(err, repositories.TrackNotFoundError) {
+			return nil, entities.NewNotFoundError("Track not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if track.UserId != nil && *track.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	switch {
+	case params.Lines != nil:
+		lines := slices.Clone(*params.Lines)
+
+		slices.SortStableFunc(lines, func(a entities.TrackLyricsLine, b entities.TrackLyricsLine) int {
+			return cmp.Compare(a.Time, b.Time)
+		})
+
+		track.Metadata.SyncedLyrics = lines
+		track.Metadata.Lyrics = lrc.PlainText(convertTrackLyricsLinesToLrc(lines))
+	case params.Lrc != nil && helpers.IsEmptyOrWhitespace(*params.Lrc):
+		track.Metadata.SyncedLyrics = []entities.TrackLyricsLine{}
+	case params.Lrc != nil:
+		lines, ok := lrc.Parse(*params.Lrc)
+		if !ok {
+			return nil, entities.NewValidationError("Lyrics are not in the LRC format")
+		}
+
+		track.Metadata.SyncedLyrics = convertLrcToTrackLyricsLines(lines)
+		track.Metadata.Lyrics = lrc.PlainText(lines)
+	}
+
+	if err := u.trackRepository.UpdateTrack(track); err != nil {
+		logger.MainLogger.Error("Couldn't update track lyrics in Database", err, *track)
+		return nil, entities.NewInternalError(errors.New("Failed to update track"))
+	}
+
+	if err := u.writeTrackTags(track); err != nil {
+		logger.MainLogger.Warn("Couldn't write track tags into audio file", err, *track)
+	}
+
+	return u.trackPresenter.ShowTrackLyrics(*track, entities.TrackLyricsFormatJSON), nil
+}
+
+// applyTrackLyrics set the plain lyrics of a track, LRC lyrics are turned
+// into synced lyrics and editing the text drop timings that are now wrong.
+func applyTrackLyrics(track *entities.Track, lyrics string) {
+	if lines, ok := lrc.Parse(lyrics); ok {
+		track.Metadata.SyncedLyrics = convertLrcToTrackLyricsLines(lines)
+		track.Metadata.Lyrics = lrc.PlainText(lines)
+		return
+	}
+
+	if lyrics != track.Metadata.Lyrics {
+		track.Metadata.SyncedLyrics = []entities.TrackLyricsLine{}
+	}
+
+	track.Metadata.Lyrics = lyrics
+}
+
+func convertLrcToTrackLyricsLines(lines []lrc.Line) []entities.TrackLyricsLine {
+	result := make([]entities.TrackLyricsLine, len(lines))
+
+	for i, line := range lines {
+		result[i] = entities.TrackLyricsLine{
+			Time: line.Time,
+			Text: strings.TrimSpace(line.Text),
+		}
+	}
+
+	return result
+}
+
+func convertTrackLyricsLinesToLrc(lines []entities.TrackLyricsLine) []lrc.Line {
+	result := make([]lrc.Line, len(lines))
+
+	for i, line := range lines {
+		result[i] = lrc.Line{
+			Time: line.Time,
+			Text: line.Text,
+		}
+	}
+
+	return result
+}
@@ -9,40 +9,69 @@ import (
 )
 
 type TrackUsecase struct {
-	trackRepository    repositories.TrackRepository
-	albumRepository    repositories.AlbumRepository
-	artistRepository   repositories.ArtistRepository
-	trackStorage       storages.TrackStorage
-	coverStorage       storages.CoverStorage
-	transcodeStorage   storages.TranscodeStorage
-	acoustIdScanner    scanners.AcoustIdScanner
-	musicBrainzScanner scanners.MusicBrainzScanner
-	transcodeProcessor processors.TranscodeProcessor
-	trackPresenter     presenters.TrackPresenter
+	trackRepository        repositories.TrackRepository
+	albumRepository        repositories.AlbumRepository
+	artistRepository       repositories.ArtistRepository
+	genreRepository        repositories.GenreRepository
+	workRepository         repositories.WorkRepository
+	trackStorage           storages.TrackStorage
+	coverStorage           storages.CoverStorage
+	transcodeStorage       storages.TranscodeStorage
+	acoustIdScanner        scanners.AcoustIdScanner
+	musicBrainzScanner     scanners.MusicBrainzScanner
+	loudnessScanner        scanners.LoudnessScanner
+	audioAnalysisScanner   scanners.AudioAnalysisScanner
+	lyricsScanner          scanners.LyricsScanner
+	spectrumScanner        scanners.SpectrumScanner
+	coverArtArchiveScanner scanners.CoverArtArchiveScanner
+	transcodeProcessor     processors.TranscodeProcessor
+	tagWriterProcessor     processors.TagWriterProcessor
+	waveformProcessor      processors.WaveformProcessor
+	trackPresenter         presenters.TrackPresenter
+	libraryJobs            *libraryJobs
 }
 
 func NewTrackUsecase(
 	trackRepository repositories.TrackRepository,
 	albumRepository repositories.AlbumRepository,
 	artistRepository repositories.ArtistRepository,
+	genreRepository repositories.GenreRepository,
+	workRepository repositories.WorkRepository,
 	trackStorage storages.TrackStorage,
 	coverStorage storages.CoverStorage,
 	transcodeStorage storages.TranscodeStorage,
 	acoustIdScanner scanners.AcoustIdScanner,
 	musicBrainzScanner scanners.MusicBrainzScanner,
+	loudnessScanner scanners.LoudnessScanner,
+	audioAnalysisScanner scanners.AudioAnalysisScanner,
+	lyricsScanner scanners.LyricsScanner,
+	spectrumScanner scanners.SpectrumScanner,
+	coverArtArchiveScanner scanners.CoverArtArchiveScanner,
 	transcodeProcessor processors.TranscodeProcessor,
+	tagWriterProcessor processors.TagWriterProcessor,
+	waveformProcessor processors.WaveformProcessor,
 	trackPresenter presenters.TrackPresenter,
 ) TrackUsecase {
 	return TrackUsecase{
 		trackRepository,
 		albumRepository,
 		artistRepository,
+		genreRepository,
+		workRepository,
 		trackStorage,
 		coverStorage,
 		transcodeStorage,
 		acoustIdScanner,
 		musicBrainzScanner,
+		loudnessScanner,
+		audioAnalysisScanner,
+		lyricsScanner,
+		spectrumScanner,
+		coverArtArchiveScanner,
 		transcodeProcessor,
+		tagWriterProcessor,
+		waveformProcessor,
 		trackPresenter,
+		newLibraryJobs(),
 	}
 }
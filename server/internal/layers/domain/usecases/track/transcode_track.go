@@ -36,6 +36,16 @@ func getTranscodeLock(trackId int, quality AudioTranscodeQuality) *sync.Mutex {
 	return actual.(*sync.Mutex)
 }
 
+// getTranscodeSignature include the normalisation gain so transcoded files
+// are made again when the loudness or the normalisation mode change.
+func getTranscodeSignature(track entities.Track, gain float64) string {
+	if gain == 0 {
+		return track.FileSignature
+	}
+
+	return fmt.Sprintf("%s@%+.2fdB", track.FileSignature, gain)
+}
+
 func (u *TrackUsecase) TranscodeTrack(
 	ctx context.Context,
 	trackId int,
@@ -59,45 +69,48 @@ func (u *TrackUsecase) TranscodeTrack(
 		return entities.NewInternalError(err)
 	}
 
+	gain := u.transcodeProcessor.GetTrackGain(*track)
+	signature := getTranscodeSignature(*track, gain)
+
 	if quality == AudioTranscodeHigh {
-		if track.TranscodingHighSignature == track.FileSignature {
+		if track.TranscodingHighSignature == signature {
 			if hasFile := u.transcodeStorage.DoTrackHasTranscodedQuality(track.Id, "high.ogg"); hasFile {
 				return nil
 			}
 		}
 
-		if err := u.transcodeProcessor.TranscodeHigh(track.Path, path.Join(transcodingDirectory, "high.ogg")); err != nil &&
+		if err := u.transcodeProcessor.TranscodeHigh(track.Path, path.Join(transcodingDirectory, "high.ogg"), gain); err != nil &&
 			!errors.Is(err, processors.TranscoderKilledError) {
 			return entities.NewInternalError(err)
 		}
 
-		track.TranscodingHighSignature = track.FileSignature
+		track.TranscodingHighSignature = signature
 	} else if quality == AudioTranscodeMedium {
-		if track.TranscodingMediumSignature == track.FileSignature {
+		if track.TranscodingMediumSignature == signature {
 			if hasFile := u.transcodeStorage.DoTrackHasTranscodedQuality(track.Id, "medium.ogg"); hasFile {
 				return nil
 			}
 		}
 
-		if err := u.transcodeProcessor.TranscodeMedium(track.Path, path.Join(transcodingDirectory, "medium.ogg")); err != nil &&
+		if err := u.transcodeProcessor.TranscodeMedium(track.Path, path.Join(transcodingDirectory, "medium.ogg"), gain); err != nil &&
 			!errors.Is(err, processors.TranscoderKilledError) {
 			return entities.NewInternalError(err)
 		}
 
-		track.TranscodingMediumSignature = track.FileSignature
+		track.TranscodingMediumSignature = signature
 	} else if quality == AudioTranscodeLow {
-		if track.TranscodingLowSignature == track.FileSignature {
+		if track.TranscodingLowSignature == signature {
 			if hasFile := u.transcodeStorage.DoTrackHasTranscodedQuality(track.Id, "low.ogg"); hasFile {
 				return nil
 			}
 		}
 
-		if err := u.transcodeProcessor.TranscodeLow(track.Path, path.Join(transcodingDirectory, "low.ogg")); err != nil &&
+		if err := u.transcodeProcessor.TranscodeLow(track.Path, path.Join(transcodingDirectory, "low.ogg"), gain); err != nil &&
 			!errors.Is(err, processors.TranscoderKilledError) {
 			return entities.NewInternalError(err)
 		}
 
-		track.TranscodingLowSignature = track.FileSignature
+		track.TranscodingLowSignature = signature
 	}
 
 	err = u.trackRepository.UpdateTrack(track)
@@ -2,10 +2,13 @@ package track_usecase
 
 import (
 	"context"
+	"errors"
 	"io"
 	"os"
 
 	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/scanners"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	"github.com/gungun974/Melodink/server/internal/logger"
 	"github.com/gungun974/Melodink/server/internal/models"
@@ -28,7 +31,39 @@ func (u *TrackUsecase) UploadTrack(
 		return nil, err
 	}
 
-	track, err := scanAudio(path)
+	track, err := u.importAudioFile(
+		ctx,
+		user.Id,
+		path,
+		"",
+		performAdvancedScan,
+		advancedScanOnlyReplaceEmptyFields,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.trackPresenter.ShowTrack(ctx, *track), nil
+}
+
+// importAudioFile create a pending import track from an audio file in the
+// upload directory. Sidecar covers are searched in sidecarDirectory when it
+// isn't empty.
+func (u *TrackUsecase) importAudioFile(
+	ctx context.Context,
+	userId int,
+	path string,
+	sidecarDirectory string,
+	performAdvancedScan bool,
+	advancedScanOnlyReplaceEmptyFields bool,
+) (*entities.Track, error) {
+	rules, err := u.trackRepository.GetTagSplitRules(userId)
+	if err != nil {
+		os.Remove(path)
+		return nil, entities.NewInternalError(err)
+	}
+
+	track, err := scanAudio(path, rules)
 	if err != nil {
 		logger.MainLogger.Error("Failed to scan audio")
 		os.Remove(path)
@@ -44,7 +79,7 @@ func (u *TrackUsecase) UploadTrack(
 		}
 	}
 
-	track.UserId = &user.Id
+	track.UserId = &userId
 	track.PendingImport = true
 
 	err = u.trackRepository.CreateTrack(&track)
@@ -75,11 +110,12 @@ func (u *TrackUsecase) UploadTrack(
 		return nil, err
 	}
 
-	err = u.coverStorage.GenerateTrackCoverFromAudioFile(&track)
+	err = u.coverStorage.GenerateTrackCover(&track, sidecarDirectory)
 	if err != nil {
-		logger.MainLogger.Warn("Failed to extract cover from audio file", err)
+		logger.MainLogger.Warn("Failed to find a cover for audio file", err)
 	} else {
 		track.CoverSignature = u.coverStorage.GetTrackCoverSignature(&track)
+		track.CoverPalette = u.coverStorage.GetTrackCoverPalette(&track)
 
 		err = u.trackRepository.UpdateTrack(&track)
 		if err != nil {
@@ -87,6 +123,30 @@ func (u *TrackUsecase) UploadTrack(
 		}
 	}
 
+	if performAdvancedScan {
+		if err := u.writeTrackTags(&track); err != nil {
+			logger.MainLogger.Warn("Couldn't write track tags into audio file", err, track)
+		}
+	}
+
+	if track.Loudness.Integrated == nil {
+		if err := u.analyzeTrackLoudness(&track); err != nil {
+			logger.MainLogger.Warn("Couldn't analyze track loudness", err, track.Id)
+		}
+	}
+
+	if err := u.analyzeTrackAudio(&track, true); err != nil {
+		logger.MainLogger.Warn("Couldn't analyze track audio", err, track.Id)
+	}
+
+	if err := u.fingerprintTrack(&track); err != nil {
+		logger.MainLogger.Warn("Couldn't fingerprint track", err, track.Id)
+	}
+
+	if err := u.checkTrackQuality(&track); err != nil {
+		logger.MainLogger.Warn("Couldn't check track quality", err, track.Id)
+	}
+
 	_ = u.TranscodeTrack(ctx, track.Id, AudioTranscodeLow)
 	_ = u.TranscodeTrack(ctx, track.Id, AudioTranscodeMedium)
 	_ = u.TranscodeTrack(ctx, track.Id, AudioTranscodeHigh)
@@ -98,5 +158,57 @@ func (u *TrackUsecase) UploadTrack(
 		return nil, entities.NewInternalError(err)
 	}
 
-	return u.trackPresenter.ShowTrack(ctx, *newTrack), nil
+	if !helpers.IsEmptyOrWhitespace(sidecarDirectory) {
+		for i := range newTrack.Albums {
+			album := &newTrack.Albums[i]
+
+			if u.coverStorage.GetAlbumCoverSignature(album) != "" {
+				continue
+			}
+
+			err := u.coverStorage.GenerateAlbumCoverFromSidecar(album, sidecarDirectory)
+			if err != nil && !errors.Is(err, storages.SidecarCoverNotFoundError) {
+				logger.MainLogger.Warn("Failed to use sidecar cover for album", err, album.Id)
+			}
+		}
+	}
+
+	// The album shows the embedded cover of the track when it has one. The
+	// cover is fetched in the background so uploads don't wait for the Cover
+	// Art Archive, POST /album/covers/fetch fill the ones it missed.
+	if newTrack.CoverSignature == "" &&
+		!helpers.IsEmptyOrWhitespace(newTrack.Metadata.MusicBrainzReleaseId) {
+		for _, album := range newTrack.Albums {
+			go u.fetchMissingAlbumCover(album, newTrack.Metadata.MusicBrainzReleaseId)
+		}
+	}
+
+	return newTrack, nil
+}
+
+// fetchMissingAlbumCover download the front cover of the release on the Cover
+// Art Archive when neither the album nor one of its tracks has a cover.
+func (u *TrackUsecase) fetchMissingAlbumCover(album entities.Album, releaseId string) {
+	if err := u.albumRepository.LoadTracksInAlbum(&album); err != nil {
+		logger.MainLogger.Warn("Couldn't load album tracks", err, album.Id)
+		return
+	}
+
+	u.coverStorage.LoadAlbumCoverSignature(&album)
+
+	if album.CoverSignature != "" {
+		return
+	}
+
+	image, err := u.coverArtArchiveScanner.FetchReleaseFrontCover(releaseId)
+	if err != nil {
+		if !errors.Is(err, scanners.CoverArtArchiveNotFoundError) {
+			logger.MainLogger.Warn("Couldn't fetch album cover", err, album.Id)
+		}
+		return
+	}
+
+	if err := u.coverStorage.UploadCustomAlbumCover(&album, image); err != nil {
+		logger.MainLogger.Warn("Failed to save Cover Art Archive cover", err, album.Id)
+	}
 }
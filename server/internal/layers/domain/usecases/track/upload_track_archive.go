@@ -0,0 +1,84 @@
+package track_usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/logger"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+// UploadTrackArchive import every audio file of a zip archive, images next
+// to them are used as sidecar covers.
+func (u *TrackUsecase) UploadTrackArchive(
+	ctx context.Context,
+	archive io.ReaderAt,
+	size int64,
+	performAdvancedScan bool,
+	advancedScanOnlyReplaceEmptyFields bool,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	root, files, err := u.trackStorage.ExtractAudioArchive(user.Id, archive, size)
+	if err != nil {
+		if errors.Is(err, storages.InvalidAudioArchiveError) ||
+			errors.Is(err, storages.AudioArchiveTooLargeError) {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		logger.MainLogger.Error("Failed to extract uploaded archive", err)
+		return nil, entities.NewInternalError(errors.New("Failed to extract archive"))
+	}
+
+	defer os.RemoveAll(root)
+
+	tracks := []entities.Track{}
+
+	for _, file := range files {
+		if !u.trackStorage.IsSupportedAudioFile(file) {
+			continue
+		}
+
+		track, err := u.importAudioFile(
+			ctx,
+			user.Id,
+			file,
+			filepath.Dir(file),
+			performAdvancedScan,
+			advancedScanOnlyReplaceEmptyFields,
+		)
+		if err != nil {
+			logger.MainLogger.Warn("Failed to import audio file from archive", err, file)
+			continue
+		}
+
+		for i := range track.Artists {
+			artist := &track.Artists[i]
+
+			if u.coverStorage.GetArtistCoverSignature(artist) != "" {
+				continue
+			}
+
+			err := u.coverStorage.GenerateArtistCoverFromSidecar(artist, filepath.Dir(file), root)
+			if err != nil && !errors.Is(err, storages.SidecarCoverNotFoundError) {
+				logger.MainLogger.Warn("Failed to use sidecar cover for artist", err, artist.Id)
+			}
+		}
+
+		tracks = append(tracks, *track)
+	}
+
+	if len(tracks) == 0 {
+		return nil, entities.NewValidationError("Archive doesn't contain any valid audio file")
+	}
+
+	return u.trackPresenter.ShowTracks(ctx, tracks), nil
+}
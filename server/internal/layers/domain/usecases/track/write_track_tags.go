@@ -0,0 +1,39 @@
+package track_usecase
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+// writeTrackTags persist the track metadata inside the audio file when the
+// tag writer is enabled. The file content change so the FileSignature is
+// refreshed and every transcoded version is invalidated.
+func (u *TrackUsecase) writeTrackTags(track *entities.Track) error {
+	if !u.tagWriterProcessor.Enabled {
+		return nil
+	}
+
+	var cover []byte
+
+	if track.UserId != nil {
+		if image, err := u.coverStorage.GetOriginalTrackCover(track); err == nil {
+			cover = image.Bytes()
+		}
+	}
+
+	if err := u.tagWriterProcessor.WriteTrackTags(*track, cover); err != nil {
+		return err
+	}
+
+	signature, err := makeFileSignature(track.Path)
+	if err != nil {
+		return err
+	}
+
+	track.FileSignature = signature
+
+	track.TranscodingLowSignature = ""
+	track.TranscodingMediumSignature = ""
+	track.TranscodingHighSignature = ""
+
+	return u.trackRepository.UpdateTrack(track)
+}
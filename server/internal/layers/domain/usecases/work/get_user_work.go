@@ -0,0 +1,35 @@
+package work_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *WorkUsecase) GetUserWork(
+	ctx context.Context,
+	workId int,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	work, err := u.workRepository.GetWorkById(workId)
+	if err != nil {
+		if errors.Is(err, repositories.WorkNotFoundError) {
+			return nil, entities.NewNotFoundError("Work not found")
+		}
+		return nil, entities.NewInternalError(err)
+	}
+
+	if work.UserId != nil && *work.UserId != user.Id {
+		return nil, entities.NewUnauthorizedError()
+	}
+
+	return u.workPresenter.ShowWork(ctx, *work), nil
+}
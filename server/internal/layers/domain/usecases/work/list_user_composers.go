@@ -0,0 +1,32 @@
+package work_usecase
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/helpers"
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func (u *WorkUsecase) ListUserComposers(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	user, err := helpers.ExtractCurrentLoggedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	composers, err := u.artistRepository.GetAllArtistsWithRoleFromUser(
+		user.Id,
+		entities.TrackArtistRoleComposer,
+	)
+	if err != nil {
+		return nil, entities.NewInternalError(err)
+	}
+
+	for i := range composers {
+		u.coverStorage.LoadArtistCoverSignature(&composers[i])
+	}
+
+	return u.workPresenter.ShowComposers(ctx, composers), nil
+}
@@ -0,0 +1,28 @@
+package work_usecase
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/data/repositories"
+	"github.com/gungun974/Melodink/server/internal/layers/data/storages"
+	"github.com/gungun974/Melodink/server/internal/layers/presentation/presenters"
+)
+
+type WorkUsecase struct {
+	workRepository   repositories.WorkRepository
+	artistRepository repositories.ArtistRepository
+	coverStorage     storages.CoverStorage
+	workPresenter    presenters.WorkPresenter
+}
+
+func NewWorkUsecase(
+	workRepository repositories.WorkRepository,
+	artistRepository repositories.ArtistRepository,
+	coverStorage storages.CoverStorage,
+	workPresenter presenters.WorkPresenter,
+) WorkUsecase {
+	return WorkUsecase{
+		workRepository,
+		artistRepository,
+		coverStorage,
+		workPresenter,
+	}
+}
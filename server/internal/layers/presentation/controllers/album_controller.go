@@ -3,6 +3,10 @@ package controllers
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	album_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/album"
@@ -45,9 +49,27 @@ func (c *AlbumController) GetUserAlbum(
 	return c.albumUsecase.GetUserAlbumById(ctx, id)
 }
 
+func (c *AlbumController) GetUserAlbumWorks(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.albumUsecase.GetUserAlbumWorks(ctx, id)
+}
+
 func (c *AlbumController) GetUserAlbumCover(
 	ctx context.Context,
 	rawId string,
+	queryParams url.Values,
 ) (models.APIResponse, error) {
 	id, err := validator.CoerceAndValidateInt(
 		rawId,
@@ -59,6 +81,15 @@ func (c *AlbumController) GetUserAlbumCover(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
+	variant, err := parseCoverVariant(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if variant != nil {
+		return c.albumUsecase.GetAlbumCoverVariant(ctx, id, *variant)
+	}
+
 	return c.albumUsecase.GetAlbumCover(ctx, id)
 }
 
@@ -222,11 +253,161 @@ func (c *AlbumController) EditAlbum(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
-	return c.albumUsecase.EditAlbum(ctx, album_usecase.EditAlbumParams{
+	params := album_usecase.EditAlbumParams{
 		Id: id,
 
 		Name: name,
-	})
+	}
+
+	if _, ok := bodyData["release_type"]; ok {
+		releaseType, err := validator.ValidateMapString(
+			"release_type",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		releaseType = strings.ToLower(strings.TrimSpace(releaseType))
+
+		if releaseType != "" && !slices.Contains(entities.AlbumPrimaryReleaseTypes, releaseType) {
+			return nil, entities.NewValidationError(
+				"\"release_type\" should be one of " + strings.Join(entities.AlbumPrimaryReleaseTypes, ", "),
+			)
+		}
+
+		params.ReleaseType = &releaseType
+	}
+
+	if rawSecondaryTypes, ok := bodyData["release_secondary_types"]; ok {
+		unknownSecondaryTypes, ok := rawSecondaryTypes.([]any)
+		if !ok {
+			return nil, entities.NewValidationError("\"release_secondary_types\" should be an array")
+		}
+
+		params.ReleaseSecondaryTypes = make([]string, 0, len(unknownSecondaryTypes))
+
+		for _, rawSecondaryType := range unknownSecondaryTypes {
+			secondaryType, ok := rawSecondaryType.(string)
+			if !ok {
+				return nil, entities.NewValidationError(
+					"release_secondary_types should be an array of string",
+				)
+			}
+
+			if _, err := validator.ValidateString(
+				secondaryType,
+				validator.StringValidators{
+					validator.StringMinValidator{Min: 1},
+				},
+			); err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+
+			params.ReleaseSecondaryTypes = append(
+				params.ReleaseSecondaryTypes,
+				strings.ToLower(strings.TrimSpace(secondaryType)),
+			)
+		}
+	}
+
+	if _, ok := bodyData["release_date"]; ok {
+		releaseDate, err := validateMapReleaseDate("release_date", bodyData)
+		if err != nil {
+			return nil, err
+		}
+
+		params.ReleaseDate = &releaseDate
+	}
+
+	if _, ok := bodyData["original_release_date"]; ok {
+		originalReleaseDate, err := validateMapReleaseDate("original_release_date", bodyData)
+		if err != nil {
+			return nil, err
+		}
+
+		params.OriginalReleaseDate = &originalReleaseDate
+	}
+
+	if _, ok := bodyData["label"]; ok {
+		label, err := validator.ValidateMapString(
+			"label",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.Label = &label
+	}
+
+	if _, ok := bodyData["catalog_number"]; ok {
+		catalogNumber, err := validator.ValidateMapString(
+			"catalog_number",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.CatalogNumber = &catalogNumber
+	}
+
+	if _, ok := bodyData["barcode"]; ok {
+		barcode, err := validator.ValidateMapString(
+			"barcode",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.Barcode = &barcode
+	}
+
+	if _, ok := bodyData["music_brainz_release_id"]; ok {
+		musicBrainzReleaseId, err := validator.ValidateMapString(
+			"music_brainz_release_id",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.MusicBrainzReleaseId = &musicBrainzReleaseId
+	}
+
+	if _, ok := bodyData["music_brainz_release_group_id"]; ok {
+		musicBrainzReleaseGroupId, err := validator.ValidateMapString(
+			"music_brainz_release_group_id",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.MusicBrainzReleaseGroupId = &musicBrainzReleaseGroupId
+	}
+
+	return c.albumUsecase.EditAlbum(ctx, params)
 }
 
 func (c *AlbumController) AddAlbumTracks(
@@ -383,3 +564,124 @@ func (c *AlbumController) DeleteAlbum(
 
 	return c.albumUsecase.DeleteAlbum(ctx, id)
 }
+
+func (c *AlbumController) AnalyzeAlbumLoudness(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.albumUsecase.AnalyzeAlbumLoudness(ctx, id)
+}
+
+func (c *AlbumController) FetchAlbumCover(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.albumUsecase.FetchAlbumCover(ctx, id)
+}
+
+func (c *AlbumController) FillMissingAlbumsCovers(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.albumUsecase.FillMissingAlbumsCovers(ctx)
+}
+
+func (c *AlbumController) MergeAlbums(
+	ctx context.Context,
+	rawId string,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	rawDuplicateIds, ok := bodyData["duplicate_ids"]
+	if !ok {
+		return nil, entities.NewValidationError("missing key \"duplicate_ids\"")
+	}
+
+	unknownDuplicateIds, ok := rawDuplicateIds.([]any)
+	if !ok {
+		return nil, entities.NewValidationError("\"duplicate_ids\" should be an array")
+	}
+
+	duplicateIds := make([]int, len(unknownDuplicateIds))
+
+	for i, duplicateId := range unknownDuplicateIds {
+		id, err := validator.CoerceAndValidateInt(
+			duplicateId,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		duplicateIds[i] = id
+	}
+
+	return c.albumUsecase.MergeAlbums(ctx, id, duplicateIds)
+}
+
+// Release dates follow MusicBrainz and can be partial
+var releaseDateLayouts = []string{"2006", "2006-01", "2006-01-02"}
+
+func validateMapReleaseDate(key string, bodyData map[string]any) (string, error) {
+	value, err := validator.ValidateMapString(
+		key,
+		bodyData,
+		validator.StringValidators{
+			validator.StringMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return "", entities.NewValidationError(err.Error())
+	}
+
+	if value != "" && !isValidReleaseDate(value) {
+		return "", entities.NewValidationError(
+			key + " should be empty or formatted as YYYY, YYYY-MM or YYYY-MM-DD",
+		)
+	}
+
+	return value, nil
+}
+
+func isValidReleaseDate(value string) bool {
+	for _, layout := range releaseDateLayouts {
+		if len(value) != len(layout) {
+			continue
+		}
+
+		if _, err := time.Parse(layout, value); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
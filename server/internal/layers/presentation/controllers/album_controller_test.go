@@ -0,0 +1,30 @@
+package controllers
+
+import "testing"
+
+func TestIsValidReleaseDate(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{value: "2004", expected: true},
+		{value: "2004-05", expected: true},
+		{value: "2004-05-12", expected: true},
+		{value: "2004-5", expected: false},
+		{value: "2004-05-1", expected: false},
+		{value: "04", expected: false},
+		{value: "2004-13", expected: false},
+		{value: "2004-02-30", expected: false},
+		{value: " 2004", expected: false},
+		{value: "2004/05/12", expected: false},
+		{value: "May 2004", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if isValidReleaseDate(test.value) != test.expected {
+				t.Fatalf("expected %v", test.expected)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package controllers
 
 import (
 	"context"
+	"net/http"
+	"net/url"
 
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	artist_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/artist"
@@ -64,6 +66,7 @@ func (c *ArtistController) GetUserArtistAlbums(
 func (c *ArtistController) GetUserArtistCover(
 	ctx context.Context,
 	rawId string,
+	queryParams url.Values,
 ) (models.APIResponse, error) {
 	id, err := validator.CoerceAndValidateInt(
 		rawId,
@@ -75,6 +78,15 @@ func (c *ArtistController) GetUserArtistCover(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
+	variant, err := parseCoverVariant(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if variant != nil {
+		return c.artistUsecase.GetArtistCoverVariant(ctx, id, *variant)
+	}
+
 	return c.artistUsecase.GetArtistCover(ctx, id)
 }
 
@@ -142,11 +154,87 @@ func (c *ArtistController) EditArtist(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
-	return c.artistUsecase.EditArtist(ctx, artist_usecase.EditArtistParams{
+	params := artist_usecase.EditArtistParams{
 		Id: id,
 
 		Name: name,
-	})
+	}
+
+	if _, ok := bodyData["sort_name"]; ok {
+		sortName, err := validator.ValidateMapString(
+			"sort_name",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.SortName = &sortName
+	}
+
+	if _, ok := bodyData["disambiguation"]; ok {
+		disambiguation, err := validator.ValidateMapString(
+			"disambiguation",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.Disambiguation = &disambiguation
+	}
+
+	if rawAliases, ok := bodyData["aliases"]; ok {
+		unknownAliases, ok := rawAliases.([]any)
+		if !ok {
+			return nil, entities.NewValidationError("\"aliases\" should be an array")
+		}
+
+		params.Aliases = make([]string, 0, len(unknownAliases))
+
+		for _, rawAlias := range unknownAliases {
+			alias, ok := rawAlias.(string)
+			if !ok {
+				return nil, entities.NewValidationError(
+					"aliases should be an array of string",
+				)
+			}
+
+			if _, err := validator.ValidateString(
+				alias,
+				validator.StringValidators{
+					validator.StringMinValidator{Min: 1},
+				},
+			); err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+
+			params.Aliases = append(params.Aliases, alias)
+		}
+	}
+
+	if _, ok := bodyData["music_brainz_artist_id"]; ok {
+		musicBrainzArtistId, err := validator.ValidateMapString(
+			"music_brainz_artist_id",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.MusicBrainzArtistId = &musicBrainzArtistId
+	}
+
+	return c.artistUsecase.EditArtist(ctx, params)
 }
 
 func (c *ArtistController) DeleteArtist(
@@ -165,3 +253,100 @@ func (c *ArtistController) DeleteArtist(
 
 	return c.artistUsecase.DeleteArtist(ctx, id)
 }
+
+func (c *ArtistController) ChangeArtistCover(
+	ctx context.Context,
+	r *http.Request,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	file, handler, err := r.FormFile("image")
+	if err == nil {
+		defer file.Close()
+
+		if err := checkIfFileIsImageFile(file, handler); err != nil {
+			_ = r.MultipartForm.RemoveAll()
+			return nil, err
+		}
+	} else {
+		return nil, entities.NewValidationError("File can't be open")
+	}
+
+	defer func() {
+		_ = r.MultipartForm.RemoveAll()
+	}()
+
+	return c.artistUsecase.ChangeArtistCover(ctx,
+		id,
+		file,
+	)
+}
+
+func (c *ArtistController) DeleteArtistCover(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.artistUsecase.DeleteArtistCover(ctx, id)
+}
+
+func (c *ArtistController) MergeArtists(
+	ctx context.Context,
+	rawId string,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	rawDuplicateIds, ok := bodyData["duplicate_ids"]
+	if !ok {
+		return nil, entities.NewValidationError("missing key \"duplicate_ids\"")
+	}
+
+	unknownDuplicateIds, ok := rawDuplicateIds.([]any)
+	if !ok {
+		return nil, entities.NewValidationError("\"duplicate_ids\" should be an array")
+	}
+
+	duplicateIds := make([]int, len(unknownDuplicateIds))
+
+	for i, duplicateId := range unknownDuplicateIds {
+		id, err := validator.CoerceAndValidateInt(
+			duplicateId,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		duplicateIds[i] = id
+	}
+
+	return c.artistUsecase.MergeArtists(ctx, id, duplicateIds)
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/validator"
+)
+
+// parseCoverVariant read the size and format query parameters, it return nil
+// when none of them is given so the original cover is sent.
+func parseCoverVariant(queryParams url.Values) (*entities.CoverVariant, error) {
+	rawSize := strings.TrimSpace(queryParams.Get("size"))
+	rawFormat := strings.ToLower(strings.TrimSpace(queryParams.Get("format")))
+
+	if rawSize == "" && rawFormat == "" {
+		return nil, nil
+	}
+
+	variant := entities.CoverVariant{
+		Format: entities.CoverFormatWebP,
+	}
+
+	if rawSize != "" {
+		size, err := validator.CoerceAndValidateInt(
+			rawSize,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: entities.CoverVariantMinSize},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		if size > entities.CoverVariantMaxSize {
+			return nil, entities.NewValidationError(
+				fmt.Sprintf("size should be at most %d", entities.CoverVariantMaxSize),
+			)
+		}
+
+		variant.Size = size
+	}
+
+	switch rawFormat {
+	case "":
+	case "jpg", string(entities.CoverFormatJPEG):
+		variant.Format = entities.CoverFormatJPEG
+	case string(entities.CoverFormatPNG):
+		variant.Format = entities.CoverFormatPNG
+	case string(entities.CoverFormatWebP):
+		variant.Format = entities.CoverFormatWebP
+	case string(entities.CoverFormatAVIF):
+		variant.Format = entities.CoverFormatAVIF
+	default:
+		return nil, entities.NewValidationError("format should be jpeg, png, webp or avif")
+	}
+
+	return &variant, nil
+}
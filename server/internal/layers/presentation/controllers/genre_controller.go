@@ -0,0 +1,320 @@
+package controllers
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	genre_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/genre"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/validator"
+)
+
+type GenreController struct {
+	genreUsecase genre_usecase.GenreUsecase
+}
+
+func NewGenreController(
+	genreUsecase genre_usecase.GenreUsecase,
+) GenreController {
+	return GenreController{
+		genreUsecase,
+	}
+}
+
+func (c *GenreController) ListUserGenres(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.genreUsecase.ListUserGenres(ctx)
+}
+
+func (c *GenreController) GetUserGenre(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.genreUsecase.GetUserGenre(ctx, id)
+}
+
+func (c *GenreController) GetUserGenreAlbums(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.genreUsecase.GetUserGenreAlbums(ctx, id)
+}
+
+func (c *GenreController) GetUserGenreTracks(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.genreUsecase.GetUserGenreTracks(ctx, id)
+}
+
+func (c *GenreController) GetUserGenreCover(
+	ctx context.Context,
+	rawId string,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	variant, err := parseCoverVariant(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if variant != nil {
+		return c.genreUsecase.GetGenreCoverVariant(ctx, id, *variant)
+	}
+
+	return c.genreUsecase.GetGenreCover(ctx, id)
+}
+
+func (c *GenreController) GetCompressedUserGenreCover(
+	ctx context.Context,
+	rawId string,
+	quality string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.genreUsecase.GetCompressedGenreCover(ctx, id, quality)
+}
+
+func (c *GenreController) CreateGenre(
+	ctx context.Context,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	name, err := validator.ValidateMapString(
+		"name",
+		bodyData,
+		validator.StringValidators{
+			validator.StringMinValidator{Min: 1},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	params := genre_usecase.CreateGenreParams{
+		Name: strings.TrimSpace(name),
+	}
+
+	if rawParentId, ok := bodyData["parent_id"]; ok && rawParentId != nil {
+		parentId, err := validator.CoerceAndValidateInt(
+			rawParentId,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.ParentId = &parentId
+	}
+
+	if rawAliases, ok := bodyData["aliases"]; ok {
+		params.Aliases, err = parseGenreAliases(rawAliases)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.genreUsecase.CreateGenre(ctx, params)
+}
+
+func (c *GenreController) EditGenre(
+	ctx context.Context,
+	rawId string,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	name, err := validator.ValidateMapString(
+		"name",
+		bodyData,
+		validator.StringValidators{
+			validator.StringMinValidator{Min: 1},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	params := genre_usecase.EditGenreParams{
+		Id: id,
+
+		Name: strings.TrimSpace(name),
+	}
+
+	if rawParentId, ok := bodyData["parent_id"]; ok {
+		if rawParentId == nil {
+			params.RemoveParent = true
+		} else {
+			parentId, err := validator.CoerceAndValidateInt(
+				rawParentId,
+				validator.IntValidators{
+					validator.IntMinValidator{Min: 0},
+				},
+			)
+			if err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+
+			params.ParentId = &parentId
+		}
+	}
+
+	if rawAliases, ok := bodyData["aliases"]; ok {
+		params.Aliases, err = parseGenreAliases(rawAliases)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.genreUsecase.EditGenre(ctx, params)
+}
+
+func parseGenreAliases(rawAliases any) ([]string, error) {
+	unknownAliases, ok := rawAliases.([]any)
+	if !ok {
+		return nil, entities.NewValidationError("\"aliases\" should be an array")
+	}
+
+	aliases := make([]string, 0, len(unknownAliases))
+
+	for _, rawAlias := range unknownAliases {
+		alias, ok := rawAlias.(string)
+		if !ok {
+			return nil, entities.NewValidationError(
+				"aliases should be an array of string",
+			)
+		}
+
+		if _, err := validator.ValidateString(
+			alias,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 1},
+			},
+		); err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		aliases = append(aliases, alias)
+	}
+
+	return aliases, nil
+}
+
+func (c *GenreController) DeleteGenre(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.genreUsecase.DeleteGenre(ctx, id)
+}
+
+func (c *GenreController) MergeGenres(
+	ctx context.Context,
+	rawId string,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	rawDuplicateIds, ok := bodyData["duplicate_ids"]
+	if !ok {
+		return nil, entities.NewValidationError("missing key \"duplicate_ids\"")
+	}
+
+	unknownDuplicateIds, ok := rawDuplicateIds.([]any)
+	if !ok {
+		return nil, entities.NewValidationError("\"duplicate_ids\" should be an array")
+	}
+
+	duplicateIds := make([]int, len(unknownDuplicateIds))
+
+	for i, duplicateId := range unknownDuplicateIds {
+		id, err := validator.CoerceAndValidateInt(
+			duplicateId,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		duplicateIds[i] = id
+	}
+
+	return c.genreUsecase.MergeGenres(ctx, id, duplicateIds)
+}
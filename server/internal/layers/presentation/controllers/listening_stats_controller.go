@@ -0,0 +1,210 @@
+package controllers
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	listening_stats_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/listening_stats"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/validator"
+)
+
+const (
+	defaultListeningTopLimit = 10
+	maxListeningTopLimit     = 100
+
+	// No time zone is further than 14 hours from UTC
+	maxListeningUtcOffset = 14 * 60
+)
+
+type ListeningStatsController struct {
+	listeningStatsUsecase listening_stats_usecase.ListeningStatsUsecase
+}
+
+func NewListeningStatsController(
+	listeningStatsUsecase listening_stats_usecase.ListeningStatsUsecase,
+) ListeningStatsController {
+	return ListeningStatsController{
+		listeningStatsUsecase,
+	}
+}
+
+func (c *ListeningStatsController) GetUserTopListened(
+	ctx context.Context,
+	rawCategory string,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	period, err := parseListeningPeriod(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultListeningTopLimit
+
+	if rawLimit := strings.TrimSpace(queryParams.Get("limit")); rawLimit != "" {
+		limit, err = validator.CoerceAndValidateInt(
+			rawLimit,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 1},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		limit = min(limit, maxListeningTopLimit)
+	}
+
+	return c.listeningStatsUsecase.GetUserTopListened(
+		ctx,
+		entities.ListeningCategory(rawCategory),
+		period,
+		limit,
+	)
+}
+
+func (c *ListeningStatsController) GetUserListeningTimeline(
+	ctx context.Context,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	period, err := parseListeningPeriod(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := entities.ListeningIntervalDay
+
+	if rawInterval := strings.TrimSpace(queryParams.Get("interval")); rawInterval != "" {
+		interval = entities.ListeningInterval(rawInterval)
+	}
+
+	switch interval {
+	case entities.ListeningIntervalDay,
+		entities.ListeningIntervalMonth:
+	default:
+		return nil, entities.NewValidationError("Unknown interval \"" + string(interval) + "\"")
+	}
+
+	return c.listeningStatsUsecase.GetUserListeningTimeline(ctx, period, interval)
+}
+
+func (c *ListeningStatsController) GetUserListeningHeatmap(
+	ctx context.Context,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	period, err := parseListeningPeriod(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.listeningStatsUsecase.GetUserListeningHeatmap(ctx, period)
+}
+
+func (c *ListeningStatsController) GetUserListeningSummary(
+	ctx context.Context,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	period, err := parseListeningPeriod(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.listeningStatsUsecase.GetUserListeningSummary(ctx, period)
+}
+
+func (c *ListeningStatsController) GetUserListeningStreaks(
+	ctx context.Context,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	period, err := parseListeningPeriod(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.listeningStatsUsecase.GetUserListeningStreaks(ctx, period)
+}
+
+func (c *ListeningStatsController) GetUserYearInReview(
+	ctx context.Context,
+	rawYear string,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	year, err := validator.CoerceAndValidateInt(
+		rawYear,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 1},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	utcOffset, err := parseListeningUtcOffset(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.listeningStatsUsecase.GetUserYearInReview(ctx, year, utcOffset)
+}
+
+func parseListeningPeriod(queryParams url.Values) (entities.ListeningPeriod, error) {
+	period := entities.ListeningPeriod{
+		To: time.Now(),
+	}
+
+	if rawFrom := strings.TrimSpace(queryParams.Get("from")); rawFrom != "" {
+		from, err := time.Parse(time.RFC3339, rawFrom)
+		if err != nil {
+			return period, entities.NewValidationError(err.Error())
+		}
+		period.From = from
+	}
+
+	if rawTo := strings.TrimSpace(queryParams.Get("to")); rawTo != "" {
+		to, err := time.Parse(time.RFC3339, rawTo)
+		if err != nil {
+			return period, entities.NewValidationError(err.Error())
+		}
+		period.To = to
+	}
+
+	if !period.From.Before(period.To) {
+		return period, entities.NewValidationError("from should be before to")
+	}
+
+	utcOffset, err := parseListeningUtcOffset(queryParams)
+	if err != nil {
+		return period, err
+	}
+
+	period.UtcOffset = utcOffset
+
+	return period, nil
+}
+
+func parseListeningUtcOffset(queryParams url.Values) (int, error) {
+	rawUtcOffset := strings.TrimSpace(queryParams.Get("utc_offset"))
+
+	if rawUtcOffset == "" {
+		return 0, nil
+	}
+
+	utcOffset, err := validator.CoerceAndValidateInt(
+		rawUtcOffset,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: -maxListeningUtcOffset},
+		},
+	)
+	if err != nil {
+		return 0, entities.NewValidationError(err.Error())
+	}
+
+	if utcOffset > maxListeningUtcOffset {
+		return 0, entities.NewValidationError("utc_offset should be in minutes between -840 and 840")
+	}
+
+	return utcOffset, nil
+}
@@ -3,6 +3,8 @@ package controllers
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	playlist_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/playlist"
@@ -92,6 +94,11 @@ func (c *PlaylistController) EditPlaylist(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
+	deviceId, err := validateOptionalMapString("device_id", bodyData)
+	if err != nil {
+		return nil, err
+	}
+
 	name, err := validator.ValidateMapString(
 		"name",
 		bodyData,
@@ -119,6 +126,8 @@ func (c *PlaylistController) EditPlaylist(
 
 		Name:        name,
 		Description: description,
+
+		DeviceId: getPlaylistRevisionDeviceId(deviceId),
 	})
 }
 
@@ -232,6 +241,7 @@ func (c *PlaylistController) DeletePlaylistCover(
 func (c *PlaylistController) DeletePlaylist(
 	ctx context.Context,
 	rawId string,
+	queryParams url.Values,
 ) (models.APIResponse, error) {
 	id, err := validator.CoerceAndValidateInt(
 		rawId,
@@ -243,7 +253,11 @@ func (c *PlaylistController) DeletePlaylist(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
-	return c.playlistUsecase.DeletePlaylist(ctx, id)
+	return c.playlistUsecase.DeletePlaylist(
+		ctx,
+		id,
+		strings.TrimSpace(queryParams.Get("device_id")),
+	)
 }
 
 func (c *PlaylistController) SetPlaylistTracks(
@@ -261,6 +275,11 @@ func (c *PlaylistController) SetPlaylistTracks(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
+	deviceId, err := validateOptionalMapString("device_id", bodyData)
+	if err != nil {
+		return nil, err
+	}
+
 	rawTrackIds, ok := bodyData["track_ids"]
 	if !ok {
 		return nil, entities.NewValidationError("missing key \"track_ids\"")
@@ -290,12 +309,15 @@ func (c *PlaylistController) SetPlaylistTracks(
 		Id: id,
 
 		TrackIds: trackIds,
+
+		DeviceId: getPlaylistRevisionDeviceId(deviceId),
 	})
 }
 
 func (c *PlaylistController) GetPlaylistCover(
 	ctx context.Context,
 	rawId string,
+	queryParams url.Values,
 ) (models.APIResponse, error) {
 	id, err := validator.CoerceAndValidateInt(
 		rawId,
@@ -307,6 +329,15 @@ func (c *PlaylistController) GetPlaylistCover(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
+	variant, err := parseCoverVariant(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if variant != nil {
+		return c.playlistUsecase.GetPlaylistCoverVariant(ctx, id, *variant)
+	}
+
 	return c.playlistUsecase.GetPlaylistCover(ctx, id)
 }
 
@@ -327,3 +358,113 @@ func (c *PlaylistController) GetCompressedPlaylistCover(
 
 	return c.playlistUsecase.GetCompressedPlaylistCover(ctx, id, quality)
 }
+
+func (c *PlaylistController) ListPlaylistRevisions(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.playlistUsecase.ListPlaylistRevisions(ctx, id)
+}
+
+func (c *PlaylistController) RestorePlaylistRevision(
+	ctx context.Context,
+	rawId string,
+	rawRevisionId string,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	revisionId, err := validator.CoerceAndValidateInt(
+		rawRevisionId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.playlistUsecase.RestorePlaylistRevision(
+		ctx,
+		playlist_usecase.RestorePlaylistRevisionParams{
+			Id: id,
+
+			RevisionId: revisionId,
+
+			DeviceId: strings.TrimSpace(queryParams.Get("device_id")),
+		},
+	)
+}
+
+func (c *PlaylistController) UndoPlaylist(
+	ctx context.Context,
+	rawId string,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.playlistUsecase.UndoPlaylist(ctx, playlist_usecase.UndoPlaylistParams{
+		Id: id,
+
+		DeviceId: strings.TrimSpace(queryParams.Get("device_id")),
+	})
+}
+
+func (c *PlaylistController) ListDeletedPlaylists(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.playlistUsecase.ListDeletedPlaylists(ctx)
+}
+
+func (c *PlaylistController) RestoreDeletedPlaylist(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.playlistUsecase.RestoreDeletedPlaylist(ctx, id)
+}
+
+// getPlaylistRevisionDeviceId return the optional "device_id" of a body, it
+// is recorded in the revisions to tell which device changed the playlist
+func getPlaylistRevisionDeviceId(deviceId *string) string {
+	if deviceId == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(*deviceId)
+}
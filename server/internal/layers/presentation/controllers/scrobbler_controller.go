@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	scrobbler_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/scrobbler"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/validator"
+)
+
+type ScrobblerController struct {
+	scrobblerUsecase scrobbler_usecase.ScrobblerUsecase
+}
+
+func NewScrobblerController(
+	scrobblerUsecase scrobbler_usecase.ScrobblerUsecase,
+) ScrobblerController {
+	return ScrobblerController{
+		scrobblerUsecase,
+	}
+}
+
+func (c *ScrobblerController) ListUserScrobblers(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.scrobblerUsecase.ListUserScrobblers(ctx)
+}
+
+func (c *ScrobblerController) CreateUserScrobbler(
+	ctx context.Context,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	service, err := validator.ValidateMapString(
+		"service",
+		bodyData,
+		validator.StringValidators{
+			validator.StringMinValidator{Min: 1},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	params := scrobbler_usecase.CreateUserScrobblerParams{
+		Service: entities.ScrobblerService(service),
+
+		Enabled: true,
+	}
+
+	for _, field := range []struct {
+		key    string
+		target *string
+	}{
+		{"name", &params.Name},
+		{"base_url", &params.BaseUrl},
+		{"token", &params.Token},
+		{"api_key", &params.ApiKey},
+		{"api_secret", &params.ApiSecret},
+		{"session_key", &params.SessionKey},
+		{"username", &params.Username},
+		{"password", &params.Password},
+	} {
+		value, err := validateOptionalMapString(field.key, bodyData)
+		if err != nil {
+			return nil, err
+		}
+
+		if value != nil {
+			*field.target = *value
+		}
+	}
+
+	if _, ok := bodyData["enabled"]; ok {
+		params.Enabled, err = validator.ValidateMapBool(
+			"enabled",
+			bodyData,
+			validator.BoolValidators{},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+	}
+
+	return c.scrobblerUsecase.CreateUserScrobbler(ctx, params)
+}
+
+func (c *ScrobblerController) EditUserScrobbler(
+	ctx context.Context,
+	rawId string,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	params := scrobbler_usecase.EditUserScrobblerParams{
+		Id: id,
+	}
+
+	for _, field := range []struct {
+		key    string
+		target **string
+	}{
+		{"name", &params.Name},
+		{"base_url", &params.BaseUrl},
+		{"token", &params.Token},
+		{"api_key", &params.ApiKey},
+		{"api_secret", &params.ApiSecret},
+		{"session_key", &params.SessionKey},
+		{"username", &params.Username},
+		{"password", &params.Password},
+	} {
+		*field.target, err = validateOptionalMapString(field.key, bodyData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := bodyData["enabled"]; ok {
+		enabled, err := validator.ValidateMapBool(
+			"enabled",
+			bodyData,
+			validator.BoolValidators{},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.Enabled = &enabled
+	}
+
+	return c.scrobblerUsecase.EditUserScrobbler(ctx, params)
+}
+
+func (c *ScrobblerController) DeleteUserScrobbler(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.scrobblerUsecase.DeleteUserScrobbler(ctx, id)
+}
+
+func (c *ScrobblerController) RetryUserScrobblerFailedScrobbles(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.scrobblerUsecase.RetryUserScrobblerFailedScrobbles(ctx, id)
+}
+
+// RunScrobbleForwarder is started with the server and keep submitting the
+// queued scrobbles in the background
+func (c *ScrobblerController) RunScrobbleForwarder(ctx context.Context) {
+	c.scrobblerUsecase.RunScrobbleForwarder(ctx)
+}
+
+func validateOptionalMapString(key string, bodyData map[string]any) (*string, error) {
+	if _, ok := bodyData[key]; !ok {
+		return nil, nil
+	}
+
+	value, err := validator.ValidateMapString(
+		key,
+		bodyData,
+		validator.StringValidators{
+			validator.StringMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return &value, nil
+}
@@ -2,6 +2,9 @@ package controllers
 
 import (
 	"context"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
@@ -193,3 +196,63 @@ func (c *SharedPlayedTrackController) DeletePlayedTrack(
 
 	return c.sharedPlayedTrackUsecase.DeletePlayedTrack(ctx, id)
 }
+
+func (c *SharedPlayedTrackController) ImportListenHistory(
+	ctx context.Context,
+	r *http.Request,
+) (models.APIResponse, error) {
+	file, handler, err := r.FormFile("file")
+	if err != nil {
+		return nil, entities.NewValidationError("File can't be open")
+	}
+	defer file.Close()
+
+	defer func() {
+		_ = r.MultipartForm.RemoveAll()
+	}()
+
+	source := strings.ToLower(strings.TrimSpace(r.FormValue("source")))
+
+	return c.sharedPlayedTrackUsecase.ImportListenHistory(ctx, source, file, handler.Size)
+}
+
+func (c *SharedPlayedTrackController) ExportPlayHistory(
+	ctx context.Context,
+	queryParams url.Values,
+) (models.APIResponse, error) {
+	format := entities.PlayHistoryFormatJSONL
+
+	if rawFormat := strings.TrimSpace(queryParams.Get("format")); rawFormat != "" {
+		format = entities.PlayHistoryFormat(strings.ToLower(rawFormat))
+	}
+
+	filter := entities.PlayHistoryFilter{
+		DeviceId: strings.TrimSpace(queryParams.Get("device_id")),
+	}
+
+	for _, field := range []struct {
+		key    string
+		target **time.Time
+	}{
+		{"from", &filter.From},
+		{"to", &filter.To},
+	} {
+		raw := strings.TrimSpace(queryParams.Get(field.key))
+		if raw == "" {
+			continue
+		}
+
+		value, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		*field.target = &value
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, entities.NewValidationError("from should be before to")
+	}
+
+	return c.sharedPlayedTrackUsecase.ExportPlayHistory(ctx, format, filter)
+}
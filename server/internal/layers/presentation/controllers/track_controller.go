@@ -3,15 +3,21 @@ package controllers
 import (
 	"context"
 	"io"
+	"math"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gabriel-vasile/mimetype"
+	"github.com/gungun974/Melodink/server/internal/helpers"
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	track_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/track"
 	"github.com/gungun974/Melodink/server/internal/logger"
 	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/Melodink/server/pkgs/audioanalysis"
 	"github.com/gungun974/validator"
 )
 
@@ -56,6 +62,36 @@ func (c *TrackController) UploadAudio(
 	)
 }
 
+func (c *TrackController) UploadAudioArchive(
+	ctx context.Context,
+	r *http.Request,
+	performAdvancedScan bool,
+	advancedScanOnlyReplaceEmptyFields bool,
+) (models.APIResponse, error) {
+	file, handler, err := r.FormFile("archive")
+	if err == nil {
+		defer file.Close()
+
+		if err := checkIfFileIsZipFile(file, handler); err != nil {
+			_ = r.MultipartForm.RemoveAll()
+			return nil, err
+		}
+	} else {
+		return nil, entities.NewValidationError("File can't be open")
+	}
+
+	defer func() {
+		_ = r.MultipartForm.RemoveAll()
+	}()
+
+	return c.trackUsecase.UploadTrackArchive(ctx,
+		file,
+		handler.Size,
+		performAdvancedScan,
+		advancedScanOnlyReplaceEmptyFields,
+	)
+}
+
 func (c *TrackController) ScanTrack(
 	ctx context.Context,
 	rawId string,
@@ -82,8 +118,77 @@ func (c *TrackController) ScanTrack(
 
 func (c *TrackController) ListUserTracks(
 	ctx context.Context,
+	queryParams url.Values,
 ) (models.APIResponse, error) {
-	return c.trackUsecase.ListUserTracks(ctx)
+	filter := entities.TrackFilter{}
+	filtered := false
+
+	if genre := strings.TrimSpace(queryParams.Get("genre")); genre != "" {
+		filter.Genre = &genre
+		filtered = true
+	}
+
+	floatFilters := []struct {
+		key   string
+		value **float64
+	}{
+		{"bpm_min", &filter.MinBpm},
+		{"bpm_max", &filter.MaxBpm},
+		{"energy_min", &filter.MinEnergy},
+		{"energy_max", &filter.MaxEnergy},
+		{"danceability_min", &filter.MinDanceability},
+		{"danceability_max", &filter.MaxDanceability},
+	}
+
+	for _, floatFilter := range floatFilters {
+		value, err := parseQueryFloat(queryParams, floatFilter.key)
+		if err != nil {
+			return nil, err
+		}
+
+		if value != nil {
+			*floatFilter.value = value
+			filtered = true
+		}
+	}
+
+	if rawKey := strings.TrimSpace(queryParams.Get("key")); rawKey != "" {
+		key, ok := audioanalysis.ParseKey(rawKey)
+		if !ok {
+			return nil, entities.NewValidationError("key should be a musical key like \"Am\" or a Camelot code like \"8A\"")
+		}
+
+		if strings.TrimSpace(queryParams.Get("harmonic")) == "true" {
+			filter.Camelots = audioanalysis.CompatibleCamelots(key.Camelot())
+		} else {
+			filter.Camelots = []string{key.Camelot()}
+		}
+
+		filtered = true
+	}
+
+	sort := entities.TrackSort{
+		Field:      entities.TrackSortField(strings.TrimSpace(queryParams.Get("sort"))),
+		Descending: strings.TrimSpace(queryParams.Get("order")) == "desc",
+	}
+
+	switch sort.Field {
+	case entities.TrackSortDefault,
+		entities.TrackSortTitle,
+		entities.TrackSortDateAdded,
+		entities.TrackSortBpm,
+		entities.TrackSortCamelot,
+		entities.TrackSortEnergy,
+		entities.TrackSortDanceability:
+	default:
+		return nil, entities.NewValidationError("Unknown sort \"" + string(sort.Field) + "\"")
+	}
+
+	if !filtered && sort.Field == entities.TrackSortDefault {
+		return c.trackUsecase.ListUserTracks(ctx, nil, sort)
+	}
+
+	return c.trackUsecase.ListUserTracks(ctx, &filter, sort)
 }
 
 func (c *TrackController) ListPendingImportTracks(
@@ -98,6 +203,104 @@ func (c *TrackController) ImportPendingTracks(
 	return c.trackUsecase.ImportPendingTracks(ctx)
 }
 
+func (c *TrackController) AnalyzeTrackLoudness(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.trackUsecase.AnalyzeTrackLoudness(ctx, id)
+}
+
+func (c *TrackController) AnalyzeTrackAudio(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.trackUsecase.AnalyzeTrackAudio(ctx, id)
+}
+
+func (c *TrackController) AnalyzeTracksAudio(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.trackUsecase.AnalyzeTracksAudio(ctx)
+}
+
+func (c *TrackController) CheckTrackQuality(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.trackUsecase.CheckTrackQuality(ctx, id)
+}
+
+func (c *TrackController) CheckTracksQuality(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.trackUsecase.CheckTracksQuality(ctx)
+}
+
+func (c *TrackController) ListSuspiciousTracks(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.trackUsecase.ListSuspiciousTracks(ctx)
+}
+
+func (c *TrackController) GenerateTracksCoverPalette(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.trackUsecase.GenerateTracksCoverPalette(ctx)
+}
+
+func (c *TrackController) FetchTrackLyrics(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.trackUsecase.FetchTrackLyrics(ctx, id)
+}
+
+func (c *TrackController) FetchMissingTracksLyrics(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.trackUsecase.FetchMissingTracksLyrics(ctx)
+}
+
 func (c *TrackController) GetTrack(
 	ctx context.Context,
 	rawId string,
@@ -118,6 +321,7 @@ func (c *TrackController) GetTrack(
 func (c *TrackController) GetCover(
 	ctx context.Context,
 	rawId string,
+	queryParams url.Values,
 ) (models.APIResponse, error) {
 	id, err := validator.CoerceAndValidateInt(
 		rawId,
@@ -129,6 +333,15 @@ func (c *TrackController) GetCover(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
+	variant, err := parseCoverVariant(queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if variant != nil {
+		return c.trackUsecase.GetTrackCoverVariant(ctx, id, *variant)
+	}
+
 	return c.trackUsecase.GetTrackCover(ctx, id)
 }
 
@@ -226,6 +439,56 @@ func (c *TrackController) GetTrackCoverSignature(
 	return c.trackUsecase.GetTrackCoverSignature(ctx, id)
 }
 
+func (c *TrackController) GetTrackWaveform(
+	ctx context.Context,
+	rawId string,
+	rawResolution string,
+	fileSignature *string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	resolution := 1024
+
+	if !helpers.IsEmptyOrWhitespace(rawResolution) {
+		resolution, err = validator.CoerceAndValidateInt(
+			rawResolution,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 1},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+	}
+
+	return c.trackUsecase.GetTrackWaveform(ctx, id, resolution, fileSignature)
+}
+
+func (c *TrackController) GetTrackWaveformSignature(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.trackUsecase.GetTrackWaveformSignature(ctx, id)
+}
+
 func (c *TrackController) GetTrackFileExtension(
 	ctx context.Context,
 	rawId string,
@@ -246,6 +509,45 @@ func (c *TrackController) GetTrackFileExtension(
 func (c *TrackController) GetTrackLyrics(
 	ctx context.Context,
 	rawId string,
+	rawFormat string,
+	accept string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	format := entities.TrackLyricsFormatPlain
+
+	switch entities.TrackLyricsFormat(strings.TrimSpace(rawFormat)) {
+	case entities.TrackLyricsFormatPlain:
+	case entities.TrackLyricsFormatLRC:
+		format = entities.TrackLyricsFormatLRC
+	case entities.TrackLyricsFormatJSON:
+		format = entities.TrackLyricsFormatJSON
+	case "":
+		switch {
+		case strings.Contains(accept, "application/json"):
+			format = entities.TrackLyricsFormatJSON
+		case strings.Contains(accept, "lrc"):
+			format = entities.TrackLyricsFormatLRC
+		}
+	default:
+		return nil, entities.NewValidationError("format should be plain, lrc or json")
+	}
+
+	return c.trackUsecase.GetTrackLyrics(ctx, id, format)
+}
+
+func (c *TrackController) SetTrackLyrics(
+	ctx context.Context,
+	rawId string,
+	bodyData map[string]any,
 ) (models.APIResponse, error) {
 	id, err := validator.CoerceAndValidateInt(
 		rawId,
@@ -257,7 +559,69 @@ func (c *TrackController) GetTrackLyrics(
 		return nil, entities.NewValidationError(err.Error())
 	}
 
-	return c.trackUsecase.GetTrackLyrics(ctx, id)
+	params := track_usecase.SetTrackLyricsParams{
+		Id: id,
+	}
+
+	if rawLines, ok := bodyData["lines"]; ok {
+		unknownLines, ok := rawLines.([]any)
+		if !ok {
+			return nil, entities.NewValidationError("\"lines\" should be an array")
+		}
+
+		lines := make([]entities.TrackLyricsLine, len(unknownLines))
+
+		for i, unknownLine := range unknownLines {
+			lineData, ok := unknownLine.(map[string]any)
+			if !ok {
+				return nil, entities.NewValidationError("\"lines\" should be an array of object")
+			}
+
+			lineTime, err := validator.ValidateMapInt(
+				"time",
+				lineData,
+				validator.IntValidators{
+					validator.IntMinValidator{Min: 0},
+				},
+			)
+			if err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+
+			text, err := validator.ValidateMapString(
+				"text",
+				lineData,
+				validator.StringValidators{
+					validator.StringMinValidator{Min: 0},
+				},
+			)
+			if err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+
+			lines[i] = entities.TrackLyricsLine{
+				Time: time.Duration(lineTime) * time.Millisecond,
+				Text: text,
+			}
+		}
+
+		params.Lines = &lines
+	} else {
+		lrc, err := validator.ValidateMapString(
+			"lrc",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.Lrc = &lrc
+	}
+
+	return c.trackUsecase.SetTrackLyrics(ctx, params)
 }
 
 func (c *TrackController) EditTrack(
@@ -478,7 +842,7 @@ func (c *TrackController) EditTrack(
 		dateAdded = &date
 	}
 
-	return c.trackUsecase.EditTrack(ctx, track_usecase.EditTrackParams{
+	params := track_usecase.EditTrackParams{
 		Id: id,
 
 		Title: title,
@@ -505,9 +869,333 @@ func (c *TrackController) EditTrack(
 		MusicBrainzRecordingId: musicBrainzRecordingId,
 
 		DateAdded: dateAdded,
+	}
+
+	if _, ok := bodyData["work"]; ok {
+		work, err := validator.ValidateMapString(
+			"work",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.Work = &work
+	}
+
+	if _, ok := bodyData["movement_name"]; ok {
+		movementName, err := validator.ValidateMapString(
+			"movement_name",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.MovementName = &movementName
+	}
+
+	if _, ok := bodyData["movement_number"]; ok {
+		movementNumber, err := validator.ValidateMapInt(
+			"movement_number",
+			bodyData,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.MovementNumber = &movementNumber
+	}
+
+	if _, ok := bodyData["movement_total"]; ok {
+		movementTotal, err := validator.ValidateMapInt(
+			"movement_total",
+			bodyData,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.MovementTotal = &movementTotal
+	}
+
+	if _, ok := bodyData["music_brainz_work_id"]; ok {
+		musicBrainzWorkId, err := validator.ValidateMapString(
+			"music_brainz_work_id",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.MusicBrainzWorkId = &musicBrainzWorkId
+	}
+
+	return c.trackUsecase.EditTrack(ctx, params)
+}
+
+func (c *TrackController) BulkEditTracks(
+	ctx context.Context,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	params := track_usecase.BulkEditTracksParams{
+		RenumberStart: 1,
+	}
+
+	if rawFilter, ok := bodyData["filter"]; ok {
+		filterData, ok := rawFilter.(map[string]any)
+		if !ok {
+			return nil, entities.NewValidationError("\"filter\" should be an object")
+		}
+
+		filter := entities.TrackFilter{}
+
+		if _, ok := filterData["album"]; ok {
+			album, err := validator.ValidateMapString(
+				"album",
+				filterData,
+				validator.StringValidators{
+					validator.StringMinValidator{Min: 0},
+				},
+			)
+			if err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+			filter.Album = &album
+		}
+
+		if _, ok := filterData["album_id"]; ok {
+			albumId, err := validator.ValidateMapInt(
+				"album_id",
+				filterData,
+				validator.IntValidators{
+					validator.IntMinValidator{Min: 0},
+				},
+			)
+			if err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+			filter.AlbumId = &albumId
+		}
+
+		if _, ok := filterData["artist_id"]; ok {
+			artistId, err := validator.ValidateMapInt(
+				"artist_id",
+				filterData,
+				validator.IntValidators{
+					validator.IntMinValidator{Min: 0},
+				},
+			)
+			if err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+			filter.ArtistId = &artistId
+		}
+
+		if _, ok := filterData["genre"]; ok {
+			genre, err := validator.ValidateMapString(
+				"genre",
+				filterData,
+				validator.StringValidators{
+					validator.StringMinValidator{Min: 1},
+				},
+			)
+			if err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+			filter.Genre = &genre
+		}
+
+		params.Filter = &filter
+	} else {
+		rawTrackIds, ok := bodyData["track_ids"]
+		if !ok {
+			return nil, entities.NewValidationError("missing key \"track_ids\" or \"filter\"")
+		}
+
+		unknownTrackIds, ok := rawTrackIds.([]any)
+		if !ok {
+			return nil, entities.NewValidationError("\"track_ids\" should be an array")
+		}
+
+		params.TrackIds = make([]int, len(unknownTrackIds))
+
+		for i, trackId := range unknownTrackIds {
+			id, err := validator.CoerceAndValidateInt(
+				trackId,
+				validator.IntValidators{
+					validator.IntMinValidator{Min: 0},
+				},
+			)
+			if err != nil {
+				return nil, entities.NewValidationError(err.Error())
+			}
+			params.TrackIds[i] = id
+		}
+	}
+
+	if _, ok := bodyData["set_genres"]; ok {
+		genres, err := validateMapStringArray("set_genres", bodyData)
+		if err != nil {
+			return nil, err
+		}
+		params.SetGenres = &genres
+	}
+
+	if _, ok := bodyData["append_genres"]; ok {
+		genres, err := validateMapStringArray("append_genres", bodyData)
+		if err != nil {
+			return nil, err
+		}
+		params.AppendGenres = genres
+	}
+
+	if _, ok := bodyData["remove_genres"]; ok {
+		genres, err := validateMapStringArray("remove_genres", bodyData)
+		if err != nil {
+			return nil, err
+		}
+		params.RemoveGenres = genres
+	}
+
+	if _, ok := bodyData["album"]; ok {
+		album, err := validator.ValidateMapString(
+			"album",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		params.Album = &album
+	}
+
+	if _, ok := bodyData["album_artists"]; ok {
+		albumArtists, err := validateMapStringArray("album_artists", bodyData)
+		if err != nil {
+			return nil, err
+		}
+		params.AlbumArtists = &albumArtists
+	}
+
+	if rawRenumber, ok := bodyData["renumber_tracks"]; ok {
+		renumber, ok := rawRenumber.(bool)
+		if !ok {
+			return nil, entities.NewValidationError("\"renumber_tracks\" should be a boolean")
+		}
+		params.RenumberTracks = renumber
+	}
+
+	if _, ok := bodyData["renumber_start"]; ok {
+		renumberStart, err := validator.ValidateMapInt(
+			"renumber_start",
+			bodyData,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 1},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		params.RenumberStart = renumberStart
+	}
+
+	if _, ok := bodyData["title_find"]; ok {
+		titleFind, err := validator.ValidateMapString(
+			"title_find",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 1},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		titleReplace, err := validator.ValidateMapString(
+			"title_replace",
+			bodyData,
+			validator.StringValidators{
+				validator.StringMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+
+		params.TitleFind = titleFind
+		params.TitleReplace = titleReplace
+	}
+
+	if rawCommit, ok := bodyData["commit"]; ok {
+		commit, ok := rawCommit.(bool)
+		if !ok {
+			return nil, entities.NewValidationError("\"commit\" should be a boolean")
+		}
+		params.Commit = commit
+	}
+
+	return c.trackUsecase.BulkEditTracks(ctx, params)
+}
+
+func (c *TrackController) GetTagSplitRules(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.trackUsecase.GetTagSplitRules(ctx)
+}
+
+func (c *TrackController) SetTagSplitRules(
+	ctx context.Context,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	artistSeparators, err := validateMapStringArray("artist_separators", bodyData)
+	if err != nil {
+		return nil, err
+	}
+
+	genreSeparators, err := validateMapStringArray("genre_separators", bodyData)
+	if err != nil {
+		return nil, err
+	}
+
+	protectedNames, err := validateMapStringArray("protected_names", bodyData)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.trackUsecase.SetTagSplitRules(ctx, track_usecase.SetTagSplitRulesParams{
+		ArtistSeparators: artistSeparators,
+		GenreSeparators:  genreSeparators,
+
+		ProtectedNames: protectedNames,
 	})
 }
 
+func (c *TrackController) ResplitTracksTags(
+	ctx context.Context,
+	commit bool,
+	includeEdited bool,
+) (models.APIResponse, error) {
+	return c.trackUsecase.ResplitTracksTags(ctx, commit, includeEdited)
+}
+
 func (c *TrackController) SetTrackScore(
 	ctx context.Context,
 	rawId string,
@@ -743,8 +1431,6 @@ func checkIfFileIsAudioFile(file io.ReadSeeker, handler *multipart.FileHeader) e
 		return entities.NewValidationError("File is too big")
 	}
 
-	validMimeType := false
-
 	mtype, err := mimetype.DetectReader(file)
 	if err != nil {
 		return entities.NewValidationError("File type is unknown")
@@ -754,27 +1440,31 @@ func checkIfFileIsAudioFile(file io.ReadSeeker, handler *multipart.FileHeader) e
 		return entities.NewInternalError(err)
 	}
 
-	for _, mimeType := range []string{
-		"audio/mpeg",
-		"video/mp4",
-		"audio/mp4",
-		"audio/ogg",
-		"audio/vorbis",
-		"audio/aac",
-		"audio/wav",
-		"audio/flac",
-		"audio/x-flac",
-		"audio/x-m4a",
-	} {
-		if mimeType == mtype.String() {
-			validMimeType = true
-			break
-		}
+	if !helpers.IsSupportedAudioMimeType(mtype.String()) {
+		logger.MainLogger.Warnf("Can't process %s", mtype.String())
+		return entities.NewValidationError("File is not a valid audio file")
 	}
 
-	if !validMimeType {
+	return nil
+}
+
+func checkIfFileIsZipFile(file io.ReadSeeker, handler *multipart.FileHeader) error {
+	if handler.Size > 16*1024*1024*1024 {
+		return entities.NewValidationError("File is too big")
+	}
+
+	mtype, err := mimetype.DetectReader(file)
+	if err != nil {
+		return entities.NewValidationError("File type is unknown")
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return entities.NewInternalError(err)
+	}
+
+	if mtype.String() != "application/zip" {
 		logger.MainLogger.Warnf("Can't process %s", mtype.String())
-		return entities.NewValidationError("File is not a valid audio file")
+		return entities.NewValidationError("File is not a valid zip archive")
 	}
 
 	return nil
@@ -818,3 +1508,98 @@ func checkIfFileIsImageFile(file io.ReadSeeker, handler *multipart.FileHeader) e
 
 	return nil
 }
+
+func parseQueryFloat(queryParams url.Values, key string) (*float64, error) {
+	rawValue := strings.TrimSpace(queryParams.Get(key))
+
+	if rawValue == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(rawValue, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, entities.NewValidationError(key + " should be a number")
+	}
+
+	return &value, nil
+}
+
+func validateMapStringArray(key string, bodyData map[string]any) ([]string, error) {
+	rawValues, ok := bodyData[key].([]any)
+	if !ok {
+		return nil, entities.NewValidationError(
+			key + " should be an array",
+		)
+	}
+
+	values := make([]string, 0, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		value, ok := rawValue.(string)
+
+		if !ok {
+			return nil, entities.NewValidationError(
+				key + " should be an array of string",
+			)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func (c *TrackController) ScanTracksFingerprints(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.trackUsecase.ScanTracksFingerprints(ctx)
+}
+
+func (c *TrackController) FindDuplicateTracks(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.trackUsecase.FindDuplicateTracks(ctx)
+}
+
+func (c *TrackController) MergeTracks(
+	ctx context.Context,
+	rawId string,
+	bodyData map[string]any,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	rawDuplicateIds, ok := bodyData["duplicate_ids"]
+	if !ok {
+		return nil, entities.NewValidationError("missing key \"duplicate_ids\"")
+	}
+
+	unknownDuplicateIds, ok := rawDuplicateIds.([]any)
+	if !ok {
+		return nil, entities.NewValidationError("\"duplicate_ids\" should be an array")
+	}
+
+	duplicateIds := make([]int, len(unknownDuplicateIds))
+
+	for i, duplicateId := range unknownDuplicateIds {
+		id, err := validator.CoerceAndValidateInt(
+			duplicateId,
+			validator.IntValidators{
+				validator.IntMinValidator{Min: 0},
+			},
+		)
+		if err != nil {
+			return nil, entities.NewValidationError(err.Error())
+		}
+		duplicateIds[i] = id
+	}
+
+	return c.trackUsecase.MergeTracks(ctx, id, duplicateIds)
+}
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	work_usecase "github.com/gungun974/Melodink/server/internal/layers/domain/usecases/work"
+	"github.com/gungun974/Melodink/server/internal/models"
+	"github.com/gungun974/validator"
+)
+
+type WorkController struct {
+	workUsecase work_usecase.WorkUsecase
+}
+
+func NewWorkController(
+	workUsecase work_usecase.WorkUsecase,
+) WorkController {
+	return WorkController{
+		workUsecase,
+	}
+}
+
+func (c *WorkController) ListUserWorks(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.workUsecase.ListUserWorks(ctx)
+}
+
+func (c *WorkController) GetUserWork(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.workUsecase.GetUserWork(ctx, id)
+}
+
+func (c *WorkController) GetUserWorkTracks(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.workUsecase.GetUserWorkTracks(ctx, id)
+}
+
+func (c *WorkController) ListUserComposers(
+	ctx context.Context,
+) (models.APIResponse, error) {
+	return c.workUsecase.ListUserComposers(ctx)
+}
+
+func (c *WorkController) GetUserComposerWorks(
+	ctx context.Context,
+	rawId string,
+) (models.APIResponse, error) {
+	id, err := validator.CoerceAndValidateInt(
+		rawId,
+		validator.IntValidators{
+			validator.IntMinValidator{Min: 0},
+		},
+	)
+	if err != nil {
+		return nil, entities.NewValidationError(err.Error())
+	}
+
+	return c.workUsecase.GetUserComposerWorks(ctx, id)
+}
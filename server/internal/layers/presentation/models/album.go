@@ -13,9 +13,24 @@ type AlbumViewModel struct {
 
 	Name string `json:"name"`
 
+	ReleaseType           string   `json:"release_type"`
+	ReleaseSecondaryTypes []string `json:"release_secondary_types"`
+
+	ReleaseDate         string `json:"release_date"`
+	OriginalReleaseDate string `json:"original_release_date"`
+
+	Label         string `json:"label"`
+	CatalogNumber string `json:"catalog_number"`
+	Barcode       string `json:"barcode"`
+
+	MusicBrainzReleaseId      string `json:"music_brainz_release_id"`
+	MusicBrainzReleaseGroupId string `json:"music_brainz_release_group_id"`
+
 	Artists []int `json:"artists"`
+	Genres  []int `json:"genres"`
 
-	CoverSignature string `json:"cover_signature"`
+	CoverSignature string                `json:"cover_signature"`
+	CoverPalette   CoverPaletteViewModel `json:"cover_palette"`
 }
 
 func ConvertToAlbumsViewModel(
@@ -41,6 +56,12 @@ func ConvertToAlbumViewModel(
 		artists[i] = artist.Id
 	}
 
+	genres := make([]int, len(album.Genres))
+
+	for i, genre := range album.Genres {
+		genres[i] = genre.Id
+	}
+
 	return AlbumViewModel{
 		Id: album.Id,
 
@@ -48,8 +69,23 @@ func ConvertToAlbumViewModel(
 
 		Name: album.Name,
 
+		ReleaseType:           album.ReleaseType,
+		ReleaseSecondaryTypes: album.ReleaseSecondaryTypes,
+
+		ReleaseDate:         album.ReleaseDate,
+		OriginalReleaseDate: album.OriginalReleaseDate,
+
+		Label:         album.Label,
+		CatalogNumber: album.CatalogNumber,
+		Barcode:       album.Barcode,
+
+		MusicBrainzReleaseId:      album.MusicBrainzReleaseId,
+		MusicBrainzReleaseGroupId: album.MusicBrainzReleaseGroupId,
+
 		Artists: artists,
+		Genres:  genres,
 
 		CoverSignature: album.CoverSignature,
+		CoverPalette:   ConvertToCoverPaletteViewModel(album.CoverPalette),
 	}
 }
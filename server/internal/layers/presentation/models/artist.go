@@ -12,6 +12,15 @@ type ArtistViewModel struct {
 	UserId *int `json:"user_id"`
 
 	Name string `json:"name"`
+
+	SortName       string `json:"sort_name"`
+	Disambiguation string `json:"disambiguation"`
+
+	Aliases []string `json:"aliases"`
+
+	MusicBrainzArtistId string `json:"music_brainz_artist_id"`
+
+	CoverSignature string `json:"cover_signature"`
 }
 
 func ConvertToArtistsViewModel(
@@ -37,5 +46,14 @@ func ConvertToArtistViewModel(
 		UserId: artist.UserId,
 
 		Name: artist.Name,
+
+		SortName:       artist.SortName,
+		Disambiguation: artist.Disambiguation,
+
+		Aliases: artist.Aliases,
+
+		MusicBrainzArtistId: artist.MusicBrainzArtistId,
+
+		CoverSignature: artist.CoverSignature,
 	}
 }
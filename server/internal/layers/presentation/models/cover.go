@@ -0,0 +1,23 @@
+package view_models
+
+import "github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+
+type CoverPaletteViewModel struct {
+	Dominant string `json:"dominant"`
+	Vibrant  string `json:"vibrant"`
+	Muted    string `json:"muted"`
+
+	BlurHash string `json:"blurhash"`
+}
+
+func ConvertToCoverPaletteViewModel(
+	palette entities.CoverPalette,
+) CoverPaletteViewModel {
+	return CoverPaletteViewModel{
+		Dominant: palette.Dominant,
+		Vibrant:  palette.Vibrant,
+		Muted:    palette.Muted,
+
+		BlurHash: palette.BlurHash,
+	}
+}
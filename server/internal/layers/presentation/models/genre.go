@@ -0,0 +1,65 @@
+package view_models
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type GenreViewModel struct {
+	Id int `json:"id"`
+
+	UserId *int `json:"user_id"`
+
+	Name string `json:"name"`
+
+	ParentId *int `json:"parent_id"`
+
+	Aliases []string `json:"aliases"`
+
+	TrackCount int `json:"track_count"`
+	AlbumCount int `json:"album_count"`
+
+	CoverSignature string `json:"cover_signature"`
+}
+
+func ConvertToGenresViewModel(
+	ctx context.Context,
+	genres []entities.Genre,
+) []GenreViewModel {
+	genresViewModels := make([]GenreViewModel, len(genres))
+
+	for i, genre := range genres {
+		genresViewModels[i] = ConvertToGenreViewModel(ctx, genre)
+	}
+
+	return genresViewModels
+}
+
+func ConvertToGenreViewModel(
+	ctx context.Context,
+	genre entities.Genre,
+) GenreViewModel {
+	aliases := genre.Aliases
+
+	if aliases == nil {
+		aliases = []string{}
+	}
+
+	return GenreViewModel{
+		Id: genre.Id,
+
+		UserId: genre.UserId,
+
+		Name: genre.Name,
+
+		ParentId: genre.ParentId,
+
+		Aliases: aliases,
+
+		TrackCount: genre.TrackCount,
+		AlbumCount: genre.AlbumCount,
+
+		CoverSignature: genre.CoverSignature,
+	}
+}
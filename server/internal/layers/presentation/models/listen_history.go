@@ -0,0 +1,49 @@
+package view_models
+
+import "github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+
+type ListenHistoryImportReportViewModel struct {
+	Source string `json:"source"`
+
+	EntryCount     int `json:"entry_count"`
+	ImportedCount  int `json:"imported_count"`
+	DuplicateCount int `json:"duplicate_count"`
+	UnmatchedCount int `json:"unmatched_count"`
+
+	Unmatched []ListenHistoryUnmatchedEntryViewModel `json:"unmatched"`
+}
+
+type ListenHistoryUnmatchedEntryViewModel struct {
+	Artist string `json:"artist"`
+	Title  string `json:"title"`
+	Album  string `json:"album"`
+
+	Count int `json:"count"`
+}
+
+func ConvertToListenHistoryImportReportViewModel(
+	report entities.ListenHistoryImportReport,
+) ListenHistoryImportReportViewModel {
+	unmatchedViewModels := make([]ListenHistoryUnmatchedEntryViewModel, len(report.Unmatched))
+
+	for i, entry := range report.Unmatched {
+		unmatchedViewModels[i] = ListenHistoryUnmatchedEntryViewModel{
+			Artist: entry.Artist,
+			Title:  entry.Title,
+			Album:  entry.Album,
+
+			Count: entry.Count,
+		}
+	}
+
+	return ListenHistoryImportReportViewModel{
+		Source: report.Source,
+
+		EntryCount:     report.EntryCount,
+		ImportedCount:  report.ImportedCount,
+		DuplicateCount: report.DuplicateCount,
+		UnmatchedCount: report.UnmatchedCount,
+
+		Unmatched: unmatchedViewModels,
+	}
+}
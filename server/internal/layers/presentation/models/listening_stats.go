@@ -0,0 +1,200 @@
+package view_models
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type ListeningCountViewModel struct {
+	Id int `json:"id"`
+
+	PlayCount    int `json:"play_count"`
+	ListenedTime int `json:"listened_time"`
+}
+
+func ConvertToListeningCountsViewModel(
+	counts []entities.ListeningCount,
+) []ListeningCountViewModel {
+	countsViewModels := make([]ListeningCountViewModel, len(counts))
+
+	for i, count := range counts {
+		countsViewModels[i] = ListeningCountViewModel{
+			Id: count.Id,
+
+			PlayCount:    count.PlayCount,
+			ListenedTime: count.ListenedTime,
+		}
+	}
+
+	return countsViewModels
+}
+
+type ListeningTimeBucketViewModel struct {
+	Date string `json:"date"`
+
+	PlayCount    int `json:"play_count"`
+	ListenedTime int `json:"listened_time"`
+}
+
+func ConvertToListeningTimeBucketsViewModel(
+	buckets []entities.ListeningTimeBucket,
+) []ListeningTimeBucketViewModel {
+	bucketsViewModels := make([]ListeningTimeBucketViewModel, len(buckets))
+
+	for i, bucket := range buckets {
+		bucketsViewModels[i] = ConvertToListeningTimeBucketViewModel(bucket)
+	}
+
+	return bucketsViewModels
+}
+
+func ConvertToListeningTimeBucketViewModel(
+	bucket entities.ListeningTimeBucket,
+) ListeningTimeBucketViewModel {
+	return ListeningTimeBucketViewModel{
+		Date: bucket.Date,
+
+		PlayCount:    bucket.PlayCount,
+		ListenedTime: bucket.ListenedTime,
+	}
+}
+
+type ListeningHeatmapCellViewModel struct {
+	Weekday int `json:"weekday"`
+	Hour    int `json:"hour"`
+
+	PlayCount    int `json:"play_count"`
+	ListenedTime int `json:"listened_time"`
+}
+
+func ConvertToListeningHeatmapViewModel(
+	cells []entities.ListeningHeatmapCell,
+) []ListeningHeatmapCellViewModel {
+	cellsViewModels := make([]ListeningHeatmapCellViewModel, len(cells))
+
+	for i, cell := range cells {
+		cellsViewModels[i] = ListeningHeatmapCellViewModel{
+			Weekday: cell.Weekday,
+			Hour:    cell.Hour,
+
+			PlayCount:    cell.PlayCount,
+			ListenedTime: cell.ListenedTime,
+		}
+	}
+
+	return cellsViewModels
+}
+
+type ListeningSummaryViewModel struct {
+	PlayCount    int `json:"play_count"`
+	ListenedTime int `json:"listened_time"`
+
+	TrackCount  int `json:"track_count"`
+	AlbumCount  int `json:"album_count"`
+	ArtistCount int `json:"artist_count"`
+
+	SkipCount      int `json:"skip_count"`
+	CompletedCount int `json:"completed_count"`
+
+	SkipRate       float64 `json:"skip_rate"`
+	CompletionRate float64 `json:"completion_rate"`
+}
+
+func ConvertToListeningSummaryViewModel(
+	summary entities.ListeningSummary,
+) ListeningSummaryViewModel {
+	return ListeningSummaryViewModel{
+		PlayCount:    summary.PlayCount,
+		ListenedTime: summary.ListenedTime,
+
+		TrackCount:  summary.TrackCount,
+		AlbumCount:  summary.AlbumCount,
+		ArtistCount: summary.ArtistCount,
+
+		SkipCount:      summary.SkipCount,
+		CompletedCount: summary.CompletedCount,
+
+		SkipRate:       summary.SkipRate(),
+		CompletionRate: summary.CompletionRate(),
+	}
+}
+
+type ListeningStreakViewModel struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	Days int `json:"days"`
+}
+
+func ConvertToListeningStreakViewModel(
+	streak *entities.ListeningStreak,
+) *ListeningStreakViewModel {
+	if streak == nil {
+		return nil
+	}
+
+	return &ListeningStreakViewModel{
+		From: streak.From,
+		To:   streak.To,
+
+		Days: streak.Days,
+	}
+}
+
+type ListeningStreaksViewModel struct {
+	Current *ListeningStreakViewModel `json:"current"`
+	Longest *ListeningStreakViewModel `json:"longest"`
+}
+
+func ConvertToListeningStreaksViewModel(
+	streaks entities.ListeningStreaks,
+) ListeningStreaksViewModel {
+	return ListeningStreaksViewModel{
+		Current: ConvertToListeningStreakViewModel(streaks.Current),
+		Longest: ConvertToListeningStreakViewModel(streaks.Longest),
+	}
+}
+
+type ListeningYearInReviewViewModel struct {
+	Year int `json:"year"`
+
+	Summary ListeningSummaryViewModel `json:"summary"`
+
+	TopTracks  []ListeningCountViewModel `json:"top_tracks"`
+	TopAlbums  []ListeningCountViewModel `json:"top_albums"`
+	TopArtists []ListeningCountViewModel `json:"top_artists"`
+	TopGenres  []ListeningCountViewModel `json:"top_genres"`
+
+	Months  []ListeningTimeBucketViewModel  `json:"months"`
+	Heatmap []ListeningHeatmapCellViewModel `json:"heatmap"`
+
+	BusiestDay    *ListeningTimeBucketViewModel `json:"busiest_day"`
+	LongestStreak *ListeningStreakViewModel     `json:"longest_streak"`
+}
+
+func ConvertToListeningYearInReviewViewModel(
+	review entities.ListeningYearInReview,
+) ListeningYearInReviewViewModel {
+	var busiestDay *ListeningTimeBucketViewModel
+
+	if review.BusiestDay != nil {
+		bucket := ConvertToListeningTimeBucketViewModel(*review.BusiestDay)
+		busiestDay = &bucket
+	}
+
+	return ListeningYearInReviewViewModel{
+		Year: review.Year,
+
+		Summary: ConvertToListeningSummaryViewModel(review.Summary),
+
+		TopTracks:  ConvertToListeningCountsViewModel(review.TopTracks),
+		TopAlbums:  ConvertToListeningCountsViewModel(review.TopAlbums),
+		TopArtists: ConvertToListeningCountsViewModel(review.TopArtists),
+		TopGenres:  ConvertToListeningCountsViewModel(review.TopGenres),
+
+		Months:  ConvertToListeningTimeBucketsViewModel(review.Months),
+		Heatmap: ConvertToListeningHeatmapViewModel(review.Heatmap),
+
+		BusiestDay:    busiestDay,
+		LongestStreak: ConvertToListeningStreakViewModel(review.LongestStreak),
+	}
+}
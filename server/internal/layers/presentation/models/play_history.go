@@ -0,0 +1,200 @@
+package view_models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type PlayHistoryEntryViewModel struct {
+	Id       int    `json:"id"`
+	DeviceId string `json:"device_id"`
+
+	StartAt  string `json:"start_at"`
+	FinishAt string `json:"finish_at"`
+
+	BeginAt      int `json:"begin_at"`
+	EndedAt      int `json:"ended_at"`
+	ListenedTime int `json:"listened_time"`
+
+	Shuffle    bool `json:"shuffle"`
+	TrackEnded bool `json:"track_ended"`
+
+	TrackDuration int `json:"track_duration"`
+
+	TrackId int `json:"track_id"`
+
+	Title        string   `json:"title"`
+	Artists      []string `json:"artists"`
+	Album        string   `json:"album"`
+	AlbumArtists []string `json:"album_artists"`
+	TrackNumber  int      `json:"track_number"`
+
+	MusicBrainzRecordingId string `json:"musicbrainz_recording_id"`
+}
+
+func ConvertToPlayHistoryEntryViewModel(
+	entry entities.PlayHistoryEntry,
+) PlayHistoryEntryViewModel {
+	playedTrack := entry.PlayedTrack
+
+	viewModel := PlayHistoryEntryViewModel{
+		Id:       playedTrack.Id,
+		DeviceId: playedTrack.DeviceId,
+
+		StartAt:  playedTrack.StartAt.UTC().Format(time.RFC3339),
+		FinishAt: playedTrack.FinishAt.UTC().Format(time.RFC3339),
+
+		BeginAt:      playedTrack.BeginAt,
+		EndedAt:      playedTrack.EndedAt,
+		ListenedTime: max(playedTrack.EndedAt-playedTrack.BeginAt, 0),
+
+		Shuffle:    playedTrack.Shuffle,
+		TrackEnded: playedTrack.TrackEnded,
+
+		TrackDuration: playedTrack.TrackDuration,
+
+		TrackId: playedTrack.TrackId,
+
+		Artists:      []string{},
+		AlbumArtists: []string{},
+	}
+
+	if track := entry.Track; track != nil {
+		viewModel.Title = track.Title
+		viewModel.Artists = track.Metadata.Artists
+		viewModel.Album = track.Metadata.Album
+		viewModel.AlbumArtists = track.Metadata.AlbumArtists
+		viewModel.TrackNumber = track.Metadata.TrackNumber
+
+		viewModel.MusicBrainzRecordingId = track.Metadata.MusicBrainzRecordingId
+	}
+
+	return viewModel
+}
+
+var PlayHistoryCSVHeader = []string{
+	"id",
+	"device_id",
+	"start_at",
+	"finish_at",
+	"begin_at",
+	"ended_at",
+	"listened_time",
+	"shuffle",
+	"track_ended",
+	"track_duration",
+	"track_id",
+	"title",
+	"artists",
+	"album",
+	"album_artists",
+	"track_number",
+	"musicbrainz_recording_id",
+}
+
+// ToCSVRecord follows the order of PlayHistoryCSVHeader
+func (v PlayHistoryEntryViewModel) ToCSVRecord() []string {
+	return []string{
+		strconv.Itoa(v.Id),
+		v.DeviceId,
+		v.StartAt,
+		v.FinishAt,
+		strconv.Itoa(v.BeginAt),
+		strconv.Itoa(v.EndedAt),
+		strconv.Itoa(v.ListenedTime),
+		strconv.FormatBool(v.Shuffle),
+		strconv.FormatBool(v.TrackEnded),
+		strconv.Itoa(v.TrackDuration),
+		strconv.Itoa(v.TrackId),
+		v.Title,
+		strings.Join(v.Artists, ", "),
+		v.Album,
+		strings.Join(v.AlbumArtists, ", "),
+		strconv.Itoa(v.TrackNumber),
+		v.MusicBrainzRecordingId,
+	}
+}
+
+type ListenBrainzListenViewModel struct {
+	ListenedAt int64 `json:"listened_at"`
+
+	TrackMetadata ListenBrainzTrackMetadataViewModel `json:"track_metadata"`
+}
+
+type ListenBrainzTrackMetadataViewModel struct {
+	ArtistName  string `json:"artist_name"`
+	TrackName   string `json:"track_name"`
+	ReleaseName string `json:"release_name,omitempty"`
+
+	AdditionalInfo ListenBrainzAdditionalInfoViewModel `json:"additional_info"`
+}
+
+type ListenBrainzAdditionalInfoViewModel struct {
+	RecordingMbid    string   `json:"recording_mbid,omitempty"`
+	ReleaseMbid      string   `json:"release_mbid,omitempty"`
+	ReleaseGroupMbid string   `json:"release_group_mbid,omitempty"`
+	TrackMbid        string   `json:"track_mbid,omitempty"`
+	ArtistMbids      []string `json:"artist_mbids,omitempty"`
+	WorkMbids        []string `json:"work_mbids,omitempty"`
+
+	TrackNumber int `json:"tracknumber,omitempty"`
+	DurationMs  int `json:"duration_ms,omitempty"`
+
+	MediaPlayer      string `json:"media_player"`
+	SubmissionClient string `json:"submission_client"`
+}
+
+func ConvertToListenBrainzListenViewModel(
+	entry entities.PlayHistoryEntry,
+) ListenBrainzListenViewModel {
+	viewModel := ListenBrainzListenViewModel{
+		ListenedAt: entry.PlayedTrack.StartAt.Unix(),
+
+		TrackMetadata: ListenBrainzTrackMetadataViewModel{
+			AdditionalInfo: ListenBrainzAdditionalInfoViewModel{
+				MediaPlayer:      "Melodink",
+				SubmissionClient: "Melodink",
+			},
+		},
+	}
+
+	track := entry.Track
+	if track == nil {
+		return viewModel
+	}
+
+	artistMbids := []string{}
+
+	for _, mbid := range track.Metadata.MusicBrainzArtistIds {
+		if strings.TrimSpace(mbid) != "" {
+			artistMbids = append(artistMbids, mbid)
+		}
+	}
+
+	workMbids := []string{}
+
+	if track.Metadata.MusicBrainzWorkId != "" {
+		workMbids = append(workMbids, track.Metadata.MusicBrainzWorkId)
+	}
+
+	metadata := &viewModel.TrackMetadata
+
+	metadata.ArtistName = strings.Join(track.Metadata.Artists, ", ")
+	metadata.TrackName = track.Title
+	metadata.ReleaseName = track.Metadata.Album
+
+	metadata.AdditionalInfo.RecordingMbid = track.Metadata.MusicBrainzRecordingId
+	metadata.AdditionalInfo.ReleaseMbid = track.Metadata.MusicBrainzReleaseId
+	metadata.AdditionalInfo.ReleaseGroupMbid = track.Metadata.MusicBrainzReleaseGroupId
+	metadata.AdditionalInfo.TrackMbid = track.Metadata.MusicBrainzTrackId
+	metadata.AdditionalInfo.ArtistMbids = artistMbids
+	metadata.AdditionalInfo.WorkMbids = workMbids
+
+	metadata.AdditionalInfo.TrackNumber = track.Metadata.TrackNumber
+	metadata.AdditionalInfo.DurationMs = track.Duration
+
+	return viewModel
+}
@@ -14,7 +14,8 @@ type PlaylistViewModel struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 
-	CoverSignature string `json:"cover_signature"`
+	CoverSignature string                `json:"cover_signature"`
+	CoverPalette   CoverPaletteViewModel `json:"cover_palette"`
 
 	Tracks []int `json:"tracks"`
 }
@@ -51,6 +52,7 @@ func ConvertToPlaylistViewModel(
 		Description: playlist.Description,
 
 		CoverSignature: playlist.CoverSignature,
+		CoverPalette:   ConvertToCoverPaletteViewModel(playlist.CoverPalette),
 
 		Tracks: tracks,
 	}
@@ -0,0 +1,63 @@
+package view_models
+
+import (
+	"time"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type PlaylistRevisionViewModel struct {
+	Id int `json:"id"`
+
+	PlaylistId int  `json:"playlist_id"`
+	UserId     *int `json:"user_id"`
+
+	DeviceId string `json:"device_id"`
+	Action   string `json:"action"`
+
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	Tracks []int `json:"tracks"`
+
+	AddedTracks   []int `json:"added_tracks"`
+	RemovedTracks []int `json:"removed_tracks"`
+
+	CreatedAt string `json:"created_at"`
+}
+
+func ConvertToPlaylistRevisionViewModels(
+	revisions []entities.PlaylistRevision,
+) []PlaylistRevisionViewModel {
+	revisionsViewModels := make([]PlaylistRevisionViewModel, len(revisions))
+
+	for i, revision := range revisions {
+		revisionsViewModels[i] = ConvertToPlaylistRevisionViewModel(revision)
+	}
+
+	return revisionsViewModels
+}
+
+func ConvertToPlaylistRevisionViewModel(
+	revision entities.PlaylistRevision,
+) PlaylistRevisionViewModel {
+	return PlaylistRevisionViewModel{
+		Id: revision.Id,
+
+		PlaylistId: revision.PlaylistId,
+		UserId:     revision.UserId,
+
+		DeviceId: revision.DeviceId,
+		Action:   string(revision.Action),
+
+		Name:        revision.Name,
+		Description: revision.Description,
+
+		Tracks: revision.TrackIds,
+
+		AddedTracks:   revision.AddedTrackIds,
+		RemovedTracks: revision.RemovedTrackIds,
+
+		CreatedAt: revision.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,65 @@
+package view_models
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+// ScrobblerViewModel never contains the secrets of the scrobbler, only if
+// they are set
+type ScrobblerViewModel struct {
+	Id int `json:"id"`
+
+	Service string `json:"service"`
+	Name    string `json:"name"`
+
+	BaseUrl string `json:"base_url"`
+
+	ApiKey string `json:"api_key"`
+
+	Authenticated bool `json:"authenticated"`
+
+	Enabled bool `json:"enabled"`
+
+	PendingCount int `json:"pending_count"`
+	FailedCount  int `json:"failed_count"`
+}
+
+func ConvertToScrobblersViewModel(
+	scrobblers []entities.Scrobbler,
+) []ScrobblerViewModel {
+	scrobblersViewModels := make([]ScrobblerViewModel, len(scrobblers))
+
+	for i, scrobbler := range scrobblers {
+		scrobblersViewModels[i] = ConvertToScrobblerViewModel(scrobbler)
+	}
+
+	return scrobblersViewModels
+}
+
+func ConvertToScrobblerViewModel(
+	scrobbler entities.Scrobbler,
+) ScrobblerViewModel {
+	authenticated := scrobbler.Token != ""
+
+	if scrobbler.Service == entities.ScrobblerServiceLastFm {
+		authenticated = scrobbler.SessionKey != ""
+	}
+
+	return ScrobblerViewModel{
+		Id: scrobbler.Id,
+
+		Service: string(scrobbler.Service),
+		Name:    scrobbler.Name,
+
+		BaseUrl: scrobbler.BaseUrl,
+
+		ApiKey: scrobbler.ApiKey,
+
+		Authenticated: authenticated,
+
+		Enabled: scrobbler.Enabled,
+
+		PendingCount: scrobbler.PendingCount,
+		FailedCount:  scrobbler.FailedCount,
+	}
+}
@@ -0,0 +1,23 @@
+package view_models
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type TagSplitRulesViewModel struct {
+	ArtistSeparators []string `json:"artist_separators"`
+	GenreSeparators  []string `json:"genre_separators"`
+
+	ProtectedNames []string `json:"protected_names"`
+}
+
+func ConvertToTagSplitRulesViewModel(
+	rules entities.TagSplitRules,
+) TagSplitRulesViewModel {
+	return TagSplitRulesViewModel{
+		ArtistSeparators: rules.ArtistSeparators,
+		GenreSeparators:  rules.GenreSeparators,
+
+		ProtectedNames: rules.ProtectedNames,
+	}
+}
@@ -19,11 +19,16 @@ type TrackViewModel struct {
 	TagsFormat string `json:"tags_format"`
 	FileType   string `json:"file_type"`
 
-	FileSignature  string `json:"file_signature"`
-	CoverSignature string `json:"cover_signature"`
+	FileSignature  string                `json:"file_signature"`
+	CoverSignature string                `json:"cover_signature"`
+	CoverPalette   CoverPaletteViewModel `json:"cover_palette"`
 
 	Albums  []int `json:"albums"`
 	Artists []int `json:"artists"`
+	Genres  []int `json:"genres"`
+
+	Work  *int                       `json:"work"`
+	Roles []TrackRoleArtistViewModel `json:"roles"`
 
 	TrackNumber int `json:"track_number"`
 	DiscNumber  int `json:"disc_number"`
@@ -34,6 +39,12 @@ type TrackViewModel struct {
 	BitRate          *int `json:"bit_rate"`
 	BitsPerRawSample *int `json:"bits_per_raw_sample"`
 
+	Loudness TrackLoudnessViewModel `json:"loudness"`
+
+	Analysis TrackAnalysisViewModel `json:"analysis"`
+
+	QualityCheck TrackQualityCheckViewModel `json:"quality_check"`
+
 	Score float64 `json:"score"`
 
 	DateAdded string `json:"date_added"`
@@ -56,7 +67,66 @@ type TrackMetadataViewModel struct {
 	MusicBrainzTrackId     string `json:"music_brainz_track_id"`
 	MusicBrainzRecordingId string `json:"music_brainz_recording_id"`
 
+	ReleaseTypes  []string `json:"release_types"`
+	OriginalDate  string   `json:"original_date"`
+	Label         string   `json:"label"`
+	CatalogNumber string   `json:"catalog_number"`
+	Barcode       string   `json:"barcode"`
+
+	MusicBrainzReleaseGroupId string `json:"music_brainz_release_group_id"`
+
+	MusicBrainzArtistIds      []string `json:"music_brainz_artist_ids"`
+	MusicBrainzAlbumArtistIds []string `json:"music_brainz_album_artist_ids"`
+
+	ArtistsRoles []TrackArtistRoleViewModel `json:"artists_roles"`
+
 	Composer string `json:"composer"`
+
+	Work         string `json:"work"`
+	MovementName string `json:"movement_name"`
+
+	MovementNumber int `json:"movement_number"`
+	MovementTotal  int `json:"movement_total"`
+
+	MusicBrainzWorkId string `json:"music_brainz_work_id"`
+}
+
+type TrackRoleArtistViewModel struct {
+	Artist int    `json:"artist"`
+	Role   string `json:"role"`
+}
+
+type TrackLoudnessViewModel struct {
+	Integrated *float64 `json:"integrated"`
+	TruePeak   *float64 `json:"true_peak"`
+	Range      *float64 `json:"range"`
+
+	AlbumIntegrated *float64 `json:"album_integrated"`
+	AlbumTruePeak   *float64 `json:"album_true_peak"`
+	AlbumRange      *float64 `json:"album_range"`
+
+	TrackGain *float64 `json:"track_gain"`
+	AlbumGain *float64 `json:"album_gain"`
+}
+
+type TrackAnalysisViewModel struct {
+	Bpm *float64 `json:"bpm"`
+
+	Key     *string `json:"key"`
+	Camelot *string `json:"camelot"`
+
+	Energy       *float64 `json:"energy"`
+	Danceability *float64 `json:"danceability"`
+}
+
+type TrackQualityCheckViewModel struct {
+	Verdict *string `json:"verdict"`
+
+	CutoffFrequency *int `json:"cutoff_frequency"`
+
+	SampleRate    *int `json:"sample_rate"`
+	BitsPerSample *int `json:"bits_per_sample"`
+	BitRate       *int `json:"bit_rate"`
 }
 
 func ConvertToTrackViewModels(
@@ -88,6 +158,27 @@ func ConvertToTrackViewModel(
 		artists[i] = artist.Id
 	}
 
+	genres := make([]int, len(track.Genres))
+
+	for i, genre := range track.Genres {
+		genres[i] = genre.Id
+	}
+
+	var work *int
+
+	if track.Work != nil {
+		work = &track.Work.Id
+	}
+
+	roles := make([]TrackRoleArtistViewModel, len(track.RoleArtists))
+
+	for i, roleArtist := range track.RoleArtists {
+		roles[i] = TrackRoleArtistViewModel{
+			Artist: roleArtist.Artist.Id,
+			Role:   roleArtist.Role,
+		}
+	}
+
 	metadata := track.Metadata
 
 	if metadata.Genres == nil {
@@ -107,11 +198,16 @@ func ConvertToTrackViewModel(
 
 		FileSignature:  track.FileSignature,
 		CoverSignature: track.CoverSignature,
+		CoverPalette:   ConvertToCoverPaletteViewModel(track.CoverPalette),
 
 		DateAdded: track.DateAdded.UTC().Format(time.RFC3339),
 
 		Albums:  albums,
 		Artists: artists,
+		Genres:  genres,
+
+		Work:  work,
+		Roles: roles,
 
 		TrackNumber: metadata.TrackNumber,
 		DiscNumber:  metadata.DiscNumber,
@@ -133,19 +229,66 @@ func ConvertToTrackViewModel(
 			MusicBrainzTrackId:     metadata.MusicBrainzTrackId,
 			MusicBrainzRecordingId: metadata.MusicBrainzRecordingId,
 
+			ReleaseTypes:  metadata.ReleaseTypes,
+			OriginalDate:  metadata.OriginalDate,
+			Label:         metadata.Label,
+			CatalogNumber: metadata.CatalogNumber,
+			Barcode:       metadata.Barcode,
+
+			MusicBrainzReleaseGroupId: metadata.MusicBrainzReleaseGroupId,
+
+			MusicBrainzArtistIds:      metadata.MusicBrainzArtistIds,
+			MusicBrainzAlbumArtistIds: metadata.MusicBrainzAlbumArtistIds,
+
+			ArtistsRoles: ConvertToTrackArtistsRolesViewModel(metadata.ArtistsRoles),
+
 			Composer: metadata.Composer,
+
+			Work:         metadata.Work,
+			MovementName: metadata.MovementName,
+
+			MovementNumber: metadata.MovementNumber,
+			MovementTotal:  metadata.MovementTotal,
+
+			MusicBrainzWorkId: metadata.MusicBrainzWorkId,
 		},
 
 		SampleRate:       track.SampleRate,
 		BitRate:          track.BitRate,
 		BitsPerRawSample: track.BitsPerRawSample,
 
+		Loudness: TrackLoudnessViewModel{
+			Integrated: track.Loudness.Integrated,
+			TruePeak:   track.Loudness.TruePeak,
+			Range:      track.Loudness.Range,
+
+			AlbumIntegrated: track.Loudness.AlbumIntegrated,
+			AlbumTruePeak:   track.Loudness.AlbumTruePeak,
+			AlbumRange:      track.Loudness.AlbumRange,
+
+			TrackGain: track.Loudness.TrackGain(),
+			AlbumGain: track.Loudness.AlbumGain(),
+		},
+
+		Analysis: TrackAnalysisViewModel{
+			Bpm: track.Analysis.Bpm,
+
+			Key:     track.Analysis.Key,
+			Camelot: track.Analysis.Camelot,
+
+			Energy:       track.Analysis.Energy,
+			Danceability: track.Analysis.Danceability,
+		},
+
+		QualityCheck: ConvertToTrackQualityCheckViewModel(track.QualityCheck),
+
 		Score: getTrackScore(ctx, track),
 	}
 }
 
 type TrackArtistRoleViewModel struct {
 	Type string `json:"type"`
+	Role string `json:"role"`
 
 	Artist string `json:"artist"`
 
@@ -169,6 +312,7 @@ func ConvertToTrackArtistRoleViewModel(
 ) TrackArtistRoleViewModel {
 	return TrackArtistRoleViewModel{
 		Type: artistRole.Type,
+		Role: artistRole.Role,
 
 		Artist: artistRole.Artist,
 
@@ -193,3 +337,24 @@ func getTrackScore(
 
 	return 0.0
 }
+
+func ConvertToTrackQualityCheckViewModel(
+	check entities.TrackQualityCheck,
+) TrackQualityCheckViewModel {
+	var verdict *string
+
+	if check.Verdict != nil {
+		value := string(*check.Verdict)
+		verdict = &value
+	}
+
+	return TrackQualityCheckViewModel{
+		Verdict: verdict,
+
+		CutoffFrequency: check.CutoffFrequency,
+
+		SampleRate:    check.SampleRate,
+		BitsPerSample: check.BitsPerSample,
+		BitRate:       check.BitRate,
+	}
+}
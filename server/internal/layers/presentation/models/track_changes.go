@@ -0,0 +1,58 @@
+package view_models
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type TrackFieldChangeViewModel struct {
+	Field string `json:"field"`
+
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+type TrackChangesViewModel struct {
+	TrackId int    `json:"track_id"`
+	Title   string `json:"title"`
+
+	Changes []TrackFieldChangeViewModel `json:"changes"`
+}
+
+type TrackBulkEditViewModel struct {
+	Committed bool `json:"committed"`
+
+	Tracks []TrackChangesViewModel `json:"tracks"`
+}
+
+func ConvertToTrackBulkEditViewModel(
+	trackChanges []entities.TrackChanges,
+	committed bool,
+) TrackBulkEditViewModel {
+	tracksViewModels := make([]TrackChangesViewModel, len(trackChanges))
+
+	for i, track := range trackChanges {
+		changesViewModels := make([]TrackFieldChangeViewModel, len(track.Changes))
+
+		for j, change := range track.Changes {
+			changesViewModels[j] = TrackFieldChangeViewModel{
+				Field: change.Field,
+
+				Before: change.Before,
+				After:  change.After,
+			}
+		}
+
+		tracksViewModels[i] = TrackChangesViewModel{
+			TrackId: track.TrackId,
+			Title:   track.Title,
+
+			Changes: changesViewModels,
+		}
+	}
+
+	return TrackBulkEditViewModel{
+		Committed: committed,
+
+		Tracks: tracksViewModels,
+	}
+}
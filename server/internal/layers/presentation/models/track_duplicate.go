@@ -0,0 +1,36 @@
+package view_models
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type TrackDuplicateGroupViewModel struct {
+	Reasons []string `json:"reasons"`
+
+	Tracks []TrackViewModel `json:"tracks"`
+}
+
+func ConvertToTrackDuplicateGroupsViewModel(
+	ctx context.Context,
+	groups []entities.TrackDuplicateGroup,
+) []TrackDuplicateGroupViewModel {
+	groupsViewModels := make([]TrackDuplicateGroupViewModel, len(groups))
+
+	for i, group := range groups {
+		reasons := make([]string, len(group.Reasons))
+
+		for j, reason := range group.Reasons {
+			reasons[j] = string(reason)
+		}
+
+		groupsViewModels[i] = TrackDuplicateGroupViewModel{
+			Reasons: reasons,
+
+			Tracks: ConvertToTrackViewModels(ctx, group.Tracks),
+		}
+	}
+
+	return groupsViewModels
+}
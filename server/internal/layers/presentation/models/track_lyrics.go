@@ -0,0 +1,54 @@
+package view_models
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	"github.com/gungun974/Melodink/server/pkgs/lrc"
+)
+
+type TrackLyricsLineViewModel struct {
+	// Time is in milliseconds
+	Time int64  `json:"time"`
+	Text string `json:"text"`
+}
+
+type TrackLyricsViewModel struct {
+	Synced bool `json:"synced"`
+
+	Text  string                     `json:"text"`
+	Lines []TrackLyricsLineViewModel `json:"lines"`
+}
+
+func ConvertToTrackLyricsViewModel(
+	track entities.Track,
+) TrackLyricsViewModel {
+	lines := make([]TrackLyricsLineViewModel, len(track.Metadata.SyncedLyrics))
+
+	for i, line := range track.Metadata.SyncedLyrics {
+		lines[i] = TrackLyricsLineViewModel{
+			Time: line.Time.Milliseconds(),
+			Text: line.Text,
+		}
+	}
+
+	return TrackLyricsViewModel{
+		Synced: len(lines) != 0,
+
+		Text:  track.Metadata.Lyrics,
+		Lines: lines,
+	}
+}
+
+func ConvertToTrackLyricsLRC(
+	track entities.Track,
+) string {
+	lines := make([]lrc.Line, len(track.Metadata.SyncedLyrics))
+
+	for i, line := range track.Metadata.SyncedLyrics {
+		lines[i] = lrc.Line{
+			Time: line.Time,
+			Text: line.Text,
+		}
+	}
+
+	return lrc.Format(lines)
+}
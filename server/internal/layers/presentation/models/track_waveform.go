@@ -0,0 +1,30 @@
+package view_models
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type TrackWaveformViewModel struct {
+	Signature string `json:"signature"`
+
+	Resolution int   `json:"resolution"`
+	Peaks      []int `json:"peaks"`
+}
+
+func ConvertToTrackWaveformViewModel(
+	waveform entities.TrackWaveform,
+	resolution int,
+) TrackWaveformViewModel {
+	peaks := waveform.Peaks[resolution]
+
+	if peaks == nil {
+		peaks = []int{}
+	}
+
+	return TrackWaveformViewModel{
+		Signature: waveform.Signature,
+
+		Resolution: resolution,
+		Peaks:      peaks,
+	}
+}
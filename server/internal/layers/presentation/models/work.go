@@ -0,0 +1,94 @@
+package view_models
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+)
+
+type WorkViewModel struct {
+	Id int `json:"id"`
+
+	UserId *int `json:"user_id"`
+
+	Name string `json:"name"`
+
+	MusicBrainzWorkId string `json:"music_brainz_work_id"`
+
+	Composers []int `json:"composers"`
+
+	TrackCount int `json:"track_count"`
+}
+
+func ConvertToWorksViewModel(
+	ctx context.Context,
+	works []entities.Work,
+) []WorkViewModel {
+	worksViewModels := make([]WorkViewModel, len(works))
+
+	for i, work := range works {
+		worksViewModels[i] = ConvertToWorkViewModel(ctx, work)
+	}
+
+	return worksViewModels
+}
+
+func ConvertToWorkViewModel(
+	ctx context.Context,
+	work entities.Work,
+) WorkViewModel {
+	composers := make([]int, len(work.Composers))
+
+	for i, composer := range work.Composers {
+		composers[i] = composer.Id
+	}
+
+	return WorkViewModel{
+		Id: work.Id,
+
+		UserId: work.UserId,
+
+		Name: work.Name,
+
+		MusicBrainzWorkId: work.MusicBrainzWorkId,
+
+		Composers: composers,
+
+		TrackCount: work.TrackCount,
+	}
+}
+
+type AlbumWorkGroupViewModel struct {
+	Work *WorkViewModel `json:"work"`
+
+	Tracks []int `json:"tracks"`
+}
+
+func ConvertToAlbumWorkGroupsViewModel(
+	ctx context.Context,
+	groups []entities.AlbumWorkGroup,
+) []AlbumWorkGroupViewModel {
+	groupsViewModels := make([]AlbumWorkGroupViewModel, len(groups))
+
+	for i, group := range groups {
+		var work *WorkViewModel
+
+		if group.Work != nil {
+			workViewModel := ConvertToWorkViewModel(ctx, *group.Work)
+			work = &workViewModel
+		}
+
+		tracks := make([]int, len(group.Tracks))
+
+		for j, track := range group.Tracks {
+			tracks[j] = track.Id
+		}
+
+		groupsViewModels[i] = AlbumWorkGroupViewModel{
+			Work:   work,
+			Tracks: tracks,
+		}
+	}
+
+	return groupsViewModels
+}
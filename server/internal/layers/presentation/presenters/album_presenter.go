@@ -31,3 +31,12 @@ func (p *AlbumPresenter) ShowAlbum(
 		Data: view_models.ConvertToAlbumViewModel(ctx, album),
 	}
 }
+
+func (p *AlbumPresenter) ShowAlbumWorks(
+	ctx context.Context,
+	groups []entities.AlbumWorkGroup,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToAlbumWorkGroupsViewModel(ctx, groups),
+	}
+}
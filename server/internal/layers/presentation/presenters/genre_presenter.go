@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	view_models "github.com/gungun974/Melodink/server/internal/layers/presentation/models"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func NewGenrePresenter() GenrePresenter {
+	return GenrePresenter{}
+}
+
+type GenrePresenter struct{}
+
+func (p *GenrePresenter) ShowGenres(
+	ctx context.Context,
+	genres []entities.Genre,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToGenresViewModel(ctx, genres),
+	}
+}
+
+func (p *GenrePresenter) ShowGenre(
+	ctx context.Context,
+	genre entities.Genre,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToGenreViewModel(ctx, genre),
+	}
+}
+
+func (p *GenrePresenter) ShowGenreAlbums(
+	ctx context.Context,
+	genre entities.Genre,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToAlbumsViewModel(ctx, genre.Albums),
+	}
+}
+
+func (p *GenrePresenter) ShowGenreTracks(
+	ctx context.Context,
+	genre entities.Genre,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToTrackViewModels(ctx, genre.Tracks),
+	}
+}
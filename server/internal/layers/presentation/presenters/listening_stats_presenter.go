@@ -0,0 +1,61 @@
+package presenters
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	view_models "github.com/gungun974/Melodink/server/internal/layers/presentation/models"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func NewListeningStatsPresenter() ListeningStatsPresenter {
+	return ListeningStatsPresenter{}
+}
+
+type ListeningStatsPresenter struct{}
+
+func (p *ListeningStatsPresenter) ShowListeningCounts(
+	counts []entities.ListeningCount,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToListeningCountsViewModel(counts),
+	}
+}
+
+func (p *ListeningStatsPresenter) ShowListeningTimeline(
+	buckets []entities.ListeningTimeBucket,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToListeningTimeBucketsViewModel(buckets),
+	}
+}
+
+func (p *ListeningStatsPresenter) ShowListeningHeatmap(
+	cells []entities.ListeningHeatmapCell,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToListeningHeatmapViewModel(cells),
+	}
+}
+
+func (p *ListeningStatsPresenter) ShowListeningSummary(
+	summary entities.ListeningSummary,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToListeningSummaryViewModel(summary),
+	}
+}
+
+func (p *ListeningStatsPresenter) ShowListeningStreaks(
+	streaks entities.ListeningStreaks,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToListeningStreaksViewModel(streaks),
+	}
+}
+
+func (p *ListeningStatsPresenter) ShowYearInReview(
+	review entities.ListeningYearInReview,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToListeningYearInReviewViewModel(review),
+	}
+}
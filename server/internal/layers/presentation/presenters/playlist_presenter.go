@@ -31,3 +31,11 @@ func (p *PlaylistPresenter) ShowPlaylist(
 		Data: view_models.ConvertToPlaylistViewModel(ctx, playlist),
 	}
 }
+
+func (p *PlaylistPresenter) ShowPlaylistRevisions(
+	revisions []entities.PlaylistRevision,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToPlaylistRevisionViewModels(revisions),
+	}
+}
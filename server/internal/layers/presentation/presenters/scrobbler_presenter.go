@@ -0,0 +1,29 @@
+package presenters
+
+import (
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	view_models "github.com/gungun974/Melodink/server/internal/layers/presentation/models"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func NewScrobblerPresenter() ScrobblerPresenter {
+	return ScrobblerPresenter{}
+}
+
+type ScrobblerPresenter struct{}
+
+func (p *ScrobblerPresenter) ShowScrobblers(
+	scrobblers []entities.Scrobbler,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToScrobblersViewModel(scrobblers),
+	}
+}
+
+func (p *ScrobblerPresenter) ShowScrobbler(
+	scrobbler entities.Scrobbler,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToScrobblerViewModel(scrobbler),
+	}
+}
@@ -1,6 +1,11 @@
 package presenters
 
 import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	view_models "github.com/gungun974/Melodink/server/internal/layers/presentation/models"
 	"github.com/gungun974/Melodink/server/internal/models"
@@ -38,3 +43,120 @@ func (p *SharedPlayedTrackPresenter) ShowSharedPlayedTrack(
 		Data: view_models.ConvertToSharedPlayedTrackViewModel(playedTrack),
 	}
 }
+
+func (p *SharedPlayedTrackPresenter) ShowListenHistoryImportReport(
+	report entities.ListenHistoryImportReport,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToListenHistoryImportReportViewModel(report),
+	}
+}
+
+type playHistoryEncoder interface {
+	Encode(entry entities.PlayHistoryEntry) error
+	Close() error
+}
+
+type csvPlayHistoryEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *csvPlayHistoryEncoder) Encode(entry entities.PlayHistoryEntry) error {
+	return e.writer.Write(view_models.ConvertToPlayHistoryEntryViewModel(entry).ToCSVRecord())
+}
+
+func (e *csvPlayHistoryEncoder) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type jsonlPlayHistoryEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *jsonlPlayHistoryEncoder) Encode(entry entities.PlayHistoryEntry) error {
+	return e.encoder.Encode(view_models.ConvertToPlayHistoryEntryViewModel(entry))
+}
+
+func (e *jsonlPlayHistoryEncoder) Close() error {
+	return nil
+}
+
+type listenBrainzPlayHistoryEncoder struct {
+	writer io.Writer
+	count  int
+}
+
+func (e *listenBrainzPlayHistoryEncoder) Encode(entry entities.PlayHistoryEntry) error {
+	data, err := json.Marshal(view_models.ConvertToListenBrainzListenViewModel(entry))
+	if err != nil {
+		return err
+	}
+
+	separator := ","
+	if e.count == 0 {
+		separator = "["
+	}
+	e.count++
+
+	_, err = e.writer.Write(append([]byte(separator), data...))
+	return err
+}
+
+func (e *listenBrainzPlayHistoryEncoder) Close() error {
+	end := "]"
+	if e.count == 0 {
+		end = "[]"
+	}
+
+	_, err := e.writer.Write([]byte(end))
+	return err
+}
+
+// ShowPlayHistoryExport stream the entries given by export while the client
+// read the response
+func (p *SharedPlayedTrackPresenter) ShowPlayHistoryExport(
+	format entities.PlayHistoryFormat,
+	export func(write func(entry entities.PlayHistoryEntry) error) error,
+) models.APIResponse {
+	reader, writer := io.Pipe()
+
+	buffer := bufio.NewWriter(writer)
+
+	var encoder playHistoryEncoder
+	var mimeType string
+
+	switch format {
+	case entities.PlayHistoryFormatCSV:
+		csvWriter := csv.NewWriter(buffer)
+
+		// Nothing is sent before the first flush, a failure is returned by Close
+		_ = csvWriter.Write(view_models.PlayHistoryCSVHeader)
+
+		encoder = &csvPlayHistoryEncoder{writer: csvWriter}
+		mimeType = "text/csv; charset=utf-8"
+	case entities.PlayHistoryFormatListenBrainz:
+		encoder = &listenBrainzPlayHistoryEncoder{writer: buffer}
+		mimeType = "application/json"
+	default:
+		encoder = &jsonlPlayHistoryEncoder{encoder: json.NewEncoder(buffer)}
+		mimeType = "application/x-ndjson"
+	}
+
+	go func() {
+		err := export(encoder.Encode)
+		if err == nil {
+			err = encoder.Close()
+		}
+		if err == nil {
+			err = buffer.Flush()
+		}
+
+		_ = writer.CloseWithError(err)
+	}()
+
+	return models.ReaderAPIResponse{
+		MIMEType: mimeType,
+		Reader:   reader,
+	}
+}
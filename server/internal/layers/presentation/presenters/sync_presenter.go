@@ -30,6 +30,8 @@ type SyncViewModel struct {
 	Tracks             []view_models.TrackViewModel             `json:"tracks"`
 	Albums             []view_models.AlbumViewModel             `json:"albums"`
 	Artists            []view_models.ArtistViewModel            `json:"artists"`
+	Genres             []view_models.GenreViewModel             `json:"genres"`
+	Works              []view_models.WorkViewModel              `json:"works"`
 	Playlists          []view_models.PlaylistViewModel          `json:"playlists"`
 	SharedPlayedTracks []view_models.SharedPlayedTrackViewModel `json:"shared_played_tracks"`
 }
@@ -38,6 +40,8 @@ type SyncDeleteViewModel struct {
 	Tracks             []int `json:"tracks"`
 	Albums             []int `json:"albums"`
 	Artists            []int `json:"artists"`
+	Genres             []int `json:"genres"`
+	Works              []int `json:"works"`
 	Playlists          []int `json:"playlists"`
 	SharedPlayedTracks []int `json:"shared_played_tracks"`
 }
@@ -47,6 +51,8 @@ func (p *SyncPresenter) ShowFullSync(
 	tracks []entities.Track,
 	albums []entities.Album,
 	artists []entities.Artist,
+	genres []entities.Genre,
+	works []entities.Work,
 	playlists []entities.Playlist,
 	sharedPlayedTracks []entities.SharedPlayedTrack,
 
@@ -58,6 +64,8 @@ func (p *SyncPresenter) ShowFullSync(
 				Tracks:             view_models.ConvertToTrackViewModels(ctx, tracks),
 				Albums:             view_models.ConvertToAlbumsViewModel(ctx, albums),
 				Artists:            view_models.ConvertToArtistsViewModel(ctx, artists),
+				Genres:             view_models.ConvertToGenresViewModel(ctx, genres),
+				Works:              view_models.ConvertToWorksViewModel(ctx, works),
 				Playlists:          view_models.ConvertToPlaylistViewModels(ctx, playlists),
 				SharedPlayedTracks: view_models.ConvertToSharedPlayedTracksViewModel(sharedPlayedTracks),
 			},
@@ -71,12 +79,16 @@ func (p *SyncPresenter) ShowPartialSync(
 	tracks []entities.Track,
 	albums []entities.Album,
 	artists []entities.Artist,
+	genres []entities.Genre,
+	works []entities.Work,
 	playlists []entities.Playlist,
 	sharedPlayedTracks []entities.SharedPlayedTrack,
 
 	deletedTracks []int,
 	deletedAlbums []int,
 	deletedArtists []int,
+	deletedGenres []int,
+	deletedWorks []int,
 	deletedPlaylists []int,
 	deletedSharedPlayedTracks []int,
 
@@ -88,6 +100,8 @@ func (p *SyncPresenter) ShowPartialSync(
 				Tracks:             view_models.ConvertToTrackViewModels(ctx, tracks),
 				Albums:             view_models.ConvertToAlbumsViewModel(ctx, albums),
 				Artists:            view_models.ConvertToArtistsViewModel(ctx, artists),
+				Genres:             view_models.ConvertToGenresViewModel(ctx, genres),
+				Works:              view_models.ConvertToWorksViewModel(ctx, works),
 				Playlists:          view_models.ConvertToPlaylistViewModels(ctx, playlists),
 				SharedPlayedTracks: view_models.ConvertToSharedPlayedTracksViewModel(sharedPlayedTracks),
 			},
@@ -95,6 +109,8 @@ func (p *SyncPresenter) ShowPartialSync(
 				Tracks:             deletedTracks,
 				Albums:             deletedAlbums,
 				Artists:            deletedArtists,
+				Genres:             deletedGenres,
+				Works:              deletedWorks,
 				Playlists:          deletedPlaylists,
 				SharedPlayedTracks: deletedSharedPlayedTracks,
 			},
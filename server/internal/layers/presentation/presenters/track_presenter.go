@@ -2,6 +2,8 @@ package presenters
 
 import (
 	"context"
+	"io"
+	"strings"
 
 	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
 	view_models "github.com/gungun974/Melodink/server/internal/layers/presentation/models"
@@ -31,3 +33,62 @@ func (p *TrackPresenter) ShowTrack(
 		Data: view_models.ConvertToTrackViewModel(ctx, track),
 	}
 }
+
+func (p *TrackPresenter) ShowTrackDuplicateGroups(
+	ctx context.Context,
+	groups []entities.TrackDuplicateGroup,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToTrackDuplicateGroupsViewModel(ctx, groups),
+	}
+}
+
+func (p *TrackPresenter) ShowTrackChanges(
+	trackChanges []entities.TrackChanges,
+	committed bool,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToTrackBulkEditViewModel(trackChanges, committed),
+	}
+}
+
+func (p *TrackPresenter) ShowTagSplitRules(
+	rules entities.TagSplitRules,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToTagSplitRulesViewModel(rules),
+	}
+}
+
+func (p *TrackPresenter) ShowTrackWaveform(
+	waveform entities.TrackWaveform,
+	resolution int,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToTrackWaveformViewModel(waveform, resolution),
+	}
+}
+
+func (p *TrackPresenter) ShowTrackLyrics(
+	track entities.Track,
+	format entities.TrackLyricsFormat,
+) models.APIResponse {
+	switch format {
+	case entities.TrackLyricsFormatJSON:
+		return models.JsonAPIResponse{
+			Data: view_models.ConvertToTrackLyricsViewModel(track),
+		}
+	case entities.TrackLyricsFormatLRC:
+		lyrics := view_models.ConvertToTrackLyricsLRC(track)
+
+		return models.ReaderAPIResponse{
+			MIMEType: "application/x-lrc; charset=utf-8",
+			Reader:   io.NopCloser(strings.NewReader(lyrics)),
+			Size:     int64(len(lyrics)),
+		}
+	}
+
+	return models.PlainAPIResponse{
+		Text: track.Metadata.Lyrics,
+	}
+}
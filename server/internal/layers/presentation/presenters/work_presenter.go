@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/gungun974/Melodink/server/internal/layers/domain/entities"
+	view_models "github.com/gungun974/Melodink/server/internal/layers/presentation/models"
+	"github.com/gungun974/Melodink/server/internal/models"
+)
+
+func NewWorkPresenter() WorkPresenter {
+	return WorkPresenter{}
+}
+
+type WorkPresenter struct{}
+
+func (p *WorkPresenter) ShowWorks(
+	ctx context.Context,
+	works []entities.Work,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToWorksViewModel(ctx, works),
+	}
+}
+
+func (p *WorkPresenter) ShowWork(
+	ctx context.Context,
+	work entities.Work,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToWorkViewModel(ctx, work),
+	}
+}
+
+func (p *WorkPresenter) ShowWorkTracks(
+	ctx context.Context,
+	work entities.Work,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToTrackViewModels(ctx, work.Tracks),
+	}
+}
+
+func (p *WorkPresenter) ShowComposers(
+	ctx context.Context,
+	composers []entities.Artist,
+) models.APIResponse {
+	return models.JsonAPIResponse{
+		Data: view_models.ConvertToArtistsViewModel(ctx, composers),
+	}
+}
@@ -73,3 +73,5 @@ var ScannerLogger = log.WithField("logger", "ScannerLogger")
 var TranscoderLogger = log.WithField("logger", "TranscoderLogger")
 
 var HTTPLogger = log.WithField("logger", "HTTPLogger")
+
+var ScrobblerLogger = log.WithField("logger", "ScrobblerLogger")
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/gungun974/Melodink/server/internal/logger"
 )
@@ -12,9 +13,24 @@ type ImageAPIResponse struct {
 	Status   int
 	MIMEType string
 	Data     bytes.Buffer
+
+	// ETag is optional, clients sending it back get a 304 without the image
+	ETag string
 }
 
-func (r ImageAPIResponse) WriteResponse(w http.ResponseWriter, _ *http.Request) {
+func (r ImageAPIResponse) WriteResponse(w http.ResponseWriter, req *http.Request) {
+	if r.ETag != "" {
+		etag := `"` + r.ETag + `"`
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "private, no-cache")
+
+		if req != nil && etagMatch(req.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
 	w.Header().Set("Content-Type", r.MIMEType)
 
 	if r.Status > 0 {
@@ -26,3 +42,15 @@ func (r ImageAPIResponse) WriteResponse(w http.ResponseWriter, _ *http.Request)
 		logger.MainLogger.Errorf("Failed to write Image API Response : %v", err)
 	}
 }
+
+func etagMatch(ifNoneMatch string, etag string) bool {
+	for _, value := range strings.Split(ifNoneMatch, ",") {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+
+		if value == etag || value == "*" {
+			return true
+		}
+	}
+
+	return false
+}
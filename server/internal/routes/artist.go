@@ -48,7 +48,7 @@ func ArtistRouter(c internal.Container) http.Handler {
 	router.Get("/{id}/cover", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
-		response, err := c.ArtistController.GetUserArtistCover(r.Context(), id)
+		response, err := c.ArtistController.GetUserArtistCover(r.Context(), id, r.URL.Query())
 		if err != nil {
 			handleHTTPError(err, w)
 			return
@@ -93,6 +93,30 @@ func ArtistRouter(c internal.Container) http.Handler {
 		response.WriteResponse(w, r)
 	})
 
+	router.Put("/{id}/cover", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.ArtistController.ChangeArtistCover(r.Context(), r, id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Delete("/{id}/cover", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.ArtistController.DeleteArtistCover(r.Context(), id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
 	router.Post("/", func(w http.ResponseWriter, r *http.Request) {
 		var bodyData map[string]any
 
@@ -131,6 +155,26 @@ func ArtistRouter(c internal.Container) http.Handler {
 		response.WriteResponse(w, r)
 	})
 
+	router.Post("/{id}/merge", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		var bodyData map[string]any
+
+		err := json.NewDecoder(r.Body).Decode(&bodyData)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response, err := c.ArtistController.MergeArtists(r.Context(), id, bodyData)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
 	router.Delete("/{id}", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
@@ -0,0 +1,86 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gungun974/Melodink/server/internal"
+)
+
+func ListeningStatsRouter(c internal.Container) http.Handler {
+	router := chi.NewRouter()
+
+	router.Get("/top/{category}", func(w http.ResponseWriter, r *http.Request) {
+		category := chi.URLParam(r, "category")
+
+		response, err := c.ListeningStatsController.GetUserTopListened(
+			r.Context(),
+			category,
+			r.URL.Query(),
+		)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/timeline", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.ListeningStatsController.GetUserListeningTimeline(r.Context(), r.URL.Query())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/heatmap", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.ListeningStatsController.GetUserListeningHeatmap(r.Context(), r.URL.Query())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/summary", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.ListeningStatsController.GetUserListeningSummary(r.Context(), r.URL.Query())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/streaks", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.ListeningStatsController.GetUserListeningStreaks(r.Context(), r.URL.Query())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/year/{year}", func(w http.ResponseWriter, r *http.Request) {
+		year := chi.URLParam(r, "year")
+
+		response, err := c.ListeningStatsController.GetUserYearInReview(
+			r.Context(),
+			year,
+			r.URL.Query(),
+		)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	return router
+}
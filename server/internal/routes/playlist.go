@@ -36,7 +36,7 @@ func PlaylistRouter(c internal.Container) http.Handler {
 	router.Get("/{id}/cover", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
-		response, err := c.PlaylistController.GetPlaylistCover(r.Context(), id)
+		response, err := c.PlaylistController.GetPlaylistCover(r.Context(), id, r.URL.Query())
 		if err != nil {
 			handleHTTPError(err, w)
 			return
@@ -158,7 +158,11 @@ func PlaylistRouter(c internal.Container) http.Handler {
 			return
 		}
 
-		response, err := c.PlaylistController.EditPlaylist(r.Context(), id, bodyData)
+		response, err := c.PlaylistController.EditPlaylist(
+			r.Context(),
+			id,
+			bodyData,
+		)
 		if err != nil {
 			handleHTTPError(err, w)
 			return
@@ -178,7 +182,11 @@ func PlaylistRouter(c internal.Container) http.Handler {
 			return
 		}
 
-		response, err := c.PlaylistController.SetPlaylistTracks(r.Context(), id, bodyData)
+		response, err := c.PlaylistController.SetPlaylistTracks(
+			r.Context(),
+			id,
+			bodyData,
+		)
 		if err != nil {
 			handleHTTPError(err, w)
 			return
@@ -199,10 +207,82 @@ func PlaylistRouter(c internal.Container) http.Handler {
 		response.WriteResponse(w, r)
 	})
 
+	router.Get("/deleted", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.PlaylistController.ListDeletedPlaylists(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/deleted/{id}/restore", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.PlaylistController.RestoreDeletedPlaylist(r.Context(), id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/{id}/revisions", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.PlaylistController.ListPlaylistRevisions(r.Context(), id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/{id}/revisions/{revisionId}/restore", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		revisionId := chi.URLParam(r, "revisionId")
+
+		response, err := c.PlaylistController.RestorePlaylistRevision(
+			r.Context(),
+			id,
+			revisionId,
+			r.URL.Query(),
+		)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/{id}/undo", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.PlaylistController.UndoPlaylist(
+			r.Context(),
+			id,
+			r.URL.Query(),
+		)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
 	router.Delete("/{id}", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
-		response, err := c.PlaylistController.DeletePlaylist(r.Context(), id)
+		response, err := c.PlaylistController.DeletePlaylist(
+			r.Context(),
+			id,
+			r.URL.Query(),
+		)
 		if err != nil {
 			handleHTTPError(err, w)
 			return
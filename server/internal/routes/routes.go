@@ -38,7 +38,12 @@ func MainRouter(container internal.Container) http.Handler {
 	router.Mount("/playlist", PlaylistRouter(container))
 	router.Mount("/album", AlbumRouter(container))
 	router.Mount("/artist", ArtistRouter(container))
+	router.Mount("/genre", GenreRouter(container))
+	router.Mount("/work", WorkRouter(container))
+	router.Mount("/composer", ComposerRouter(container))
 	router.Mount("/sharedPlayedTrack", SharedPlayedTrackRouter(container))
+	router.Mount("/stats", ListeningStatsRouter(container))
+	router.Mount("/scrobbler", ScrobblerRouter(container))
 	router.Mount("/sync", SyncRouter(container))
 
 	router.Get("/check", func(w http.ResponseWriter, r *http.Request) {
@@ -32,6 +32,26 @@ func SharedPlayedTrackRouter(c internal.Container) http.Handler {
 		response.WriteResponse(w, r)
 	})
 
+	router.Post("/import", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.SharedPlayedTrackController.ImportListenHistory(r.Context(), r)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/export", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.SharedPlayedTrackController.ExportPlayHistory(r.Context(), r.URL.Query())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
 	router.Get("/from/{id}", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
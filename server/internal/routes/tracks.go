@@ -2,6 +2,7 @@ package routes
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"strings"
 
@@ -35,8 +36,30 @@ func TrackRouter(c internal.Container) http.Handler {
 		response.WriteResponse(w, r)
 	})
 
+	router.Post("/upload/archive", func(w http.ResponseWriter, r *http.Request) {
+		queryParams := r.URL.Query()
+
+		performAdvancedScan := strings.TrimSpace(queryParams.Get("advanced_scan")) == "true"
+		advancedScanOnlyReplaceEmptyFields := !(strings.TrimSpace(
+			queryParams.Get("advanced_scan_only_replace_empty_fields"),
+		) == "false")
+
+		response, err := c.TrackController.UploadAudioArchive(
+			r.Context(),
+			r,
+			performAdvancedScan,
+			advancedScanOnlyReplaceEmptyFields,
+		)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
 	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
-		response, err := c.TrackController.ListUserTracks(r.Context())
+		response, err := c.TrackController.ListUserTracks(r.Context(), r.URL.Query())
 		if err != nil {
 			handleHTTPError(err, w)
 			return
@@ -55,6 +78,65 @@ func TrackRouter(c internal.Container) http.Handler {
 		response.WriteResponse(w, r)
 	})
 
+	router.Post("/bulkEdit", func(w http.ResponseWriter, r *http.Request) {
+		var bodyData map[string]any
+
+		err := json.NewDecoder(r.Body).Decode(&bodyData)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response, err := c.TrackController.BulkEditTracks(r.Context(), bodyData)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/splitRules", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.TrackController.GetTagSplitRules(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Put("/splitRules", func(w http.ResponseWriter, r *http.Request) {
+		var bodyData map[string]any
+
+		err := json.NewDecoder(r.Body).Decode(&bodyData)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response, err := c.TrackController.SetTagSplitRules(r.Context(), bodyData)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/resplit", func(w http.ResponseWriter, r *http.Request) {
+		commit := strings.TrimSpace(r.URL.Query().Get("commit")) == "true"
+		includeEdited := strings.TrimSpace(r.URL.Query().Get("include_edited")) == "true"
+
+		response, err := c.TrackController.ResplitTracksTags(r.Context(), commit, includeEdited)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
 	router.Get("/{id}", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
@@ -70,7 +152,7 @@ func TrackRouter(c internal.Container) http.Handler {
 	router.Get("/{id}/cover", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
-		response, err := c.TrackController.GetCover(r.Context(), id)
+		response, err := c.TrackController.GetCover(r.Context(), id, r.URL.Query())
 		if err != nil {
 			handleHTTPError(err, w)
 			return
@@ -189,6 +271,43 @@ func TrackRouter(c internal.Container) http.Handler {
 		response.WriteResponse(w, r)
 	})
 
+	router.Get("/{id}/waveform", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		rawFileSignature := r.Header.Get("Melodink-Signature")
+
+		fileSignature := &rawFileSignature
+
+		if helpers.IsEmptyOrWhitespace(rawFileSignature) {
+			fileSignature = nil
+		}
+
+		response, err := c.TrackController.GetTrackWaveform(
+			r.Context(),
+			id,
+			r.URL.Query().Get("resolution"),
+			fileSignature,
+		)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/{id}/waveform/signature", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.TrackController.GetTrackWaveformSignature(r.Context(), id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
 	router.Get("/{id}/extension", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
@@ -204,7 +323,43 @@ func TrackRouter(c internal.Container) http.Handler {
 	router.Get("/{id}/lyrics", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
-		response, err := c.TrackController.GetTrackLyrics(r.Context(), id)
+		response, err := c.TrackController.GetTrackLyrics(
+			r.Context(),
+			id,
+			r.URL.Query().Get("format"),
+			r.Header.Get("Accept"),
+		)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Put("/{id}/lyrics", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		var bodyData map[string]any
+
+		// LRC files can be sent as is instead of inside a JSON body
+		if contentType := r.Header.Get("Content-Type"); strings.HasPrefix(contentType, "text/") ||
+			strings.Contains(contentType, "lrc") {
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				handleHTTPError(err, w)
+				return
+			}
+
+			bodyData = map[string]any{
+				"lrc": string(data),
+			}
+		} else if err := json.NewDecoder(r.Body).Decode(&bodyData); err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response, err := c.TrackController.SetTrackLyrics(r.Context(), id, bodyData)
 		if err != nil {
 			handleHTTPError(err, w)
 			return
@@ -237,6 +392,144 @@ func TrackRouter(c internal.Container) http.Handler {
 		response.WriteResponse(w, r)
 	})
 
+	router.Post("/analysis", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.TrackController.AnalyzeTracksAudio(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/quality", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.TrackController.CheckTracksQuality(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/quality/suspicious", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.TrackController.ListSuspiciousTracks(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/fingerprints", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.TrackController.ScanTracksFingerprints(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Get("/duplicates", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.TrackController.FindDuplicateTracks(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/{id}/merge", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		var bodyData map[string]any
+
+		err := json.NewDecoder(r.Body).Decode(&bodyData)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response, err := c.TrackController.MergeTracks(r.Context(), id, bodyData)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/covers/palette", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.TrackController.GenerateTracksCoverPalette(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/lyrics/fetch", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.TrackController.FetchMissingTracksLyrics(r.Context())
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/{id}/lyrics/fetch", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.TrackController.FetchTrackLyrics(r.Context(), id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/{id}/analysis", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.TrackController.AnalyzeTrackAudio(r.Context(), id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/{id}/quality", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.TrackController.CheckTrackQuality(r.Context(), id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
+	router.Post("/{id}/loudness", func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		response, err := c.TrackController.AnalyzeTrackLoudness(r.Context(), id)
+		if err != nil {
+			handleHTTPError(err, w)
+			return
+		}
+
+		response.WriteResponse(w, r)
+	})
+
 	router.Put("/{id}", func(w http.ResponseWriter, r *http.Request) {
 		id := chi.URLParam(r, "id")
 
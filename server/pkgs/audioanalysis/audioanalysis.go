@@ -0,0 +1,265 @@
+package audioanalysis
+
+import (
+	"errors"
+	"math"
+)
+
+// SampleRate is the mono sample rate Analyze expect, it's enough for onsets
+// and chroma while keeping decoded tracks small in memory.
+const SampleRate = 11025
+
+const (
+	onsetFrameSize = 1024
+	onsetHopSize   = 256
+
+	chromaFrameSize = 4096
+	chromaHopSize   = 2048
+
+	minBpm = 60.0
+	maxBpm = 200.0
+
+	// Tempo candidates are weighted around this value to avoid half and
+	// double tempo errors
+	preferredBpm = 120.0
+)
+
+type Result struct {
+	Bpm float64
+
+	Key           Key
+	KeyConfidence float64
+
+	// Energy and Danceability are between 0 and 1
+	Energy       float64
+	Danceability float64
+}
+
+var ErrNotEnoughAudio = errors.New("Not enough audio to analyze")
+
+// Analyze estimate the tempo, key, energy and danceability of mono samples
+// at SampleRate.
+func Analyze(samples []float32) (Result, error) {
+	if len(samples) < SampleRate*5 {
+		return Result{}, ErrNotEnoughAudio
+	}
+
+	envelope := onsetEnvelope(samples)
+	framesPerSecond := float64(SampleRate) / onsetHopSize
+
+	bpm, beatStrength := estimateTempo(envelope, framesPerSecond)
+
+	key, keyConfidence := estimateKey(chromagram(samples))
+
+	energy := estimateEnergy(samples, envelope, framesPerSecond)
+
+	tempoFit := 0.0
+	if bpm > 0 {
+		tempoFit = math.Exp(-0.5 * math.Pow(math.Log2(bpm/preferredBpm)/0.4, 2))
+	}
+
+	return Result{
+		Bpm: math.Round(bpm*10) / 10,
+
+		Key:           key,
+		KeyConfidence: keyConfidence,
+
+		Energy:       energy,
+		Danceability: clamp(0.7*clamp(beatStrength*2, 0, 1)+0.3*tempoFit, 0, 1),
+	}, nil
+}
+
+// onsetEnvelope compute the spectral flux of the log magnitudes, peaks are
+// where notes or drums start.
+func onsetEnvelope(samples []float32) []float64 {
+	envelope := []float64{}
+
+	var previous []float64
+
+	spectrogram(samples, onsetFrameSize, onsetHopSize, func(magnitudes []float64) {
+		flux := 0.0
+
+		if previous == nil {
+			previous = make([]float64, len(magnitudes))
+			for i, magnitude := range magnitudes {
+				previous[i] = math.Log1p(1000 * magnitude)
+			}
+			envelope = append(envelope, 0)
+			return
+		}
+
+		for i, magnitude := range magnitudes {
+			value := math.Log1p(1000 * magnitude)
+
+			if diff := value - previous[i]; diff > 0 {
+				flux += diff
+			}
+
+			previous[i] = value
+		}
+
+		envelope = append(envelope, flux)
+	})
+
+	return envelope
+}
+
+// estimateTempo search the autocorrelation of the onset envelope for the
+// strongest beat period. It also return the normalized strength of this
+// period.
+func estimateTempo(envelope []float64, framesPerSecond float64) (float64, float64) {
+	minLag := int(math.Floor(framesPerSecond * 60 / maxBpm))
+	maxLag := int(math.Ceil(framesPerSecond * 60 / minBpm))
+
+	if len(envelope) <= 2*maxLag+1 {
+		return 0, 0
+	}
+
+	mean := 0.0
+	for _, value := range envelope {
+		mean += value
+	}
+	mean /= float64(len(envelope))
+
+	centered := make([]float64, len(envelope))
+	for i, value := range envelope {
+		centered[i] = value - mean
+	}
+
+	// Beat periods are rarely a whole number of frames, smoothing the onsets
+	// keep their correlation from being split between two lags
+	centered = smoothEnvelope(centered)
+
+	autocorrelation := func(lag int) float64 {
+		sum := 0.0
+		for i := 0; i+lag < len(centered); i++ {
+			sum += centered[i] * centered[i+lag]
+		}
+		return sum / float64(len(centered)-lag)
+	}
+
+	energy := autocorrelation(0)
+	if energy == 0 {
+		return 0, 0
+	}
+
+	correlations := make([]float64, 2*maxLag+2)
+	for lag := range correlations {
+		correlations[lag] = autocorrelation(lag) / energy
+	}
+
+	bestLag := 0
+	bestScore := math.Inf(-1)
+
+	for lag := minLag; lag <= maxLag; lag++ {
+		bpm := 60 * framesPerSecond / float64(lag)
+		prior := math.Exp(-0.5 * math.Pow(math.Log2(bpm/preferredBpm), 2))
+
+		// A real beat period also correlate at twice its length
+		score := (correlations[lag] + 0.5*correlations[2*lag]) * prior
+
+		if score > bestScore {
+			bestLag, bestScore = lag, score
+		}
+	}
+
+	// Parabolic interpolation for a sub frame period
+	lag := float64(bestLag)
+
+	left, center, right := correlations[bestLag-1], correlations[bestLag], correlations[bestLag+1]
+
+	if denominator := left - 2*center + right; denominator != 0 {
+		offset := 0.5 * (left - right) / denominator
+		if math.Abs(offset) < 1 {
+			lag += offset
+		}
+	}
+
+	return 60 * framesPerSecond / lag, clamp(correlations[bestLag], 0, 1)
+}
+
+// smoothEnvelope convolve the envelope with a short Hann window.
+func smoothEnvelope(envelope []float64) []float64 {
+	kernel := []float64{0.25, 0.75, 1, 0.75, 0.25}
+	half := len(kernel) / 2
+
+	smoothed := make([]float64, len(envelope))
+
+	for i := range envelope {
+		for j, weight := range kernel {
+			if k := i + j - half; k >= 0 && k < len(envelope) {
+				smoothed[i] += envelope[k] * weight / 3
+			}
+		}
+	}
+
+	return smoothed
+}
+
+// chromagram sum the magnitudes of every pitch class between A1 and B6.
+func chromagram(samples []float32) [12]float64 {
+	var chroma [12]float64
+
+	binFrequency := float64(SampleRate) / chromaFrameSize
+
+	spectrogram(samples, chromaFrameSize, chromaHopSize, func(magnitudes []float64) {
+		for i, magnitude := range magnitudes {
+			frequency := float64(i) * binFrequency
+
+			if frequency < 55 || frequency > 2000 {
+				continue
+			}
+
+			midi := int(math.Round(12*math.Log2(frequency/440) + 69))
+
+			chroma[midi%12] += magnitude
+		}
+	})
+
+	return chroma
+}
+
+// estimateEnergy mix the loudness of the track with how often onsets happen.
+func estimateEnergy(samples []float32, envelope []float64, framesPerSecond float64) float64 {
+	sum := 0.0
+	for _, sample := range samples {
+		sum += float64(sample) * float64(sample)
+	}
+
+	rms := math.Sqrt(sum / float64(len(samples)))
+	if rms == 0 {
+		return 0
+	}
+
+	loudness := clamp((20*math.Log10(rms)+35)/30, 0, 1)
+
+	mean, deviation := 0.0, 0.0
+
+	for _, value := range envelope {
+		mean += value
+	}
+	mean /= float64(len(envelope))
+
+	for _, value := range envelope {
+		deviation += (value - mean) * (value - mean)
+	}
+	deviation = math.Sqrt(deviation / float64(len(envelope)))
+
+	onsets := 0
+
+	for i := 1; i+1 < len(envelope); i++ {
+		if envelope[i] > mean+deviation &&
+			envelope[i] >= envelope[i-1] &&
+			envelope[i] > envelope[i+1] {
+			onsets++
+		}
+	}
+
+	onsetRate := float64(onsets) / (float64(len(envelope)) / framesPerSecond)
+
+	return clamp(0.7*loudness+0.3*clamp(onsetRate/8, 0, 1), 0, 1)
+}
+
+func clamp(value float64, low float64, high float64) float64 {
+	return math.Max(low, math.Min(high, value))
+}
@@ -0,0 +1,182 @@
+package audioanalysis
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// clickTrack generate short decaying 1kHz bursts on every beat over a quiet
+// tone so the onsets are the only rhythm of the signal.
+func clickTrack(bpm float64, seconds int) []float32 {
+	samples := make([]float32, SampleRate*seconds)
+	beatLength := 60 / bpm * SampleRate
+
+	for i := range samples {
+		samples[i] = float32(0.01 * math.Sin(2*math.Pi*220*float64(i)/SampleRate))
+	}
+
+	for beat := 0.0; int(beat) < len(samples); beat += beatLength {
+		start := int(beat)
+
+		for i := 0; i < SampleRate/50 && start+i < len(samples); i++ {
+			decay := math.Exp(-float64(i) / (SampleRate / 200))
+			samples[start+i] += float32(0.8 * decay * math.Sin(2*math.Pi*1000*float64(i)/SampleRate))
+		}
+	}
+
+	return samples
+}
+
+func TestFFTMatchDFT(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	data := make([]complex128, 64)
+	for i := range data {
+		data[i] = complex(random.Float64()*2-1, random.Float64()*2-1)
+	}
+
+	expected := make([]complex128, len(data))
+	for k := range expected {
+		for n, value := range data {
+			angle := -2 * math.Pi * float64(k*n) / float64(len(data))
+			expected[k] += value * cmplx.Exp(complex(0, angle))
+		}
+	}
+
+	fft(data)
+
+	for k := range data {
+		if cmplx.Abs(data[k]-expected[k]) > 1e-9 {
+			t.Fatalf("bin %d : expected %v, got %v", k, expected[k], data[k])
+		}
+	}
+}
+
+func TestSpectrogramPeakAtSineFrequency(t *testing.T) {
+	const frameSize = 1024
+	const frequency = 1000.0
+
+	samples := make([]float32, frameSize*4)
+	for i := range samples {
+		samples[i] = float32(math.Sin(2 * math.Pi * frequency * float64(i) / SampleRate))
+	}
+
+	frames := 0
+	expectedBin := int(math.Round(frequency * frameSize / SampleRate))
+
+	spectrogram(samples, frameSize, frameSize/2, func(magnitudes []float64) {
+		frames++
+
+		peak := 0
+		for i, magnitude := range magnitudes {
+			if magnitude > magnitudes[peak] {
+				peak = i
+			}
+		}
+
+		if peak != expectedBin {
+			t.Errorf("expected the peak at bin %d, got %d", expectedBin, peak)
+		}
+	})
+
+	if frames != 7 {
+		t.Errorf("expected 7 overlapping frames, got %d", frames)
+	}
+}
+
+func TestEstimateTempo(t *testing.T) {
+	for _, bpm := range []float64{90, 120, 128, 150} {
+		t.Run("", func(t *testing.T) {
+			envelope := onsetEnvelope(clickTrack(bpm, 30))
+
+			estimated, strength := estimateTempo(envelope, float64(SampleRate)/onsetHopSize)
+
+			if math.Abs(estimated-bpm) > 1 {
+				t.Errorf("expected %.0f bpm, got %.2f", bpm, estimated)
+			}
+
+			if strength < 0.3 {
+				t.Errorf("a click track should have a strong beat, got %.2f", strength)
+			}
+		})
+	}
+}
+
+func TestEstimateTempoWithoutRhythm(t *testing.T) {
+	bpm, strength := estimateTempo(make([]float64, 2000), float64(SampleRate)/onsetHopSize)
+
+	if bpm != 0 || strength != 0 {
+		t.Errorf("silence should have no tempo, got %.2f %.2f", bpm, strength)
+	}
+
+	bpm, _ = estimateTempo(make([]float64, 10), float64(SampleRate)/onsetHopSize)
+	if bpm != 0 {
+		t.Errorf("too short envelope should have no tempo, got %.2f", bpm)
+	}
+}
+
+func TestChromagramOfNote(t *testing.T) {
+	samples := make([]float32, SampleRate*2)
+	for i := range samples {
+		samples[i] = float32(math.Sin(2 * math.Pi * 440 * float64(i) / SampleRate))
+	}
+
+	chroma := chromagram(samples)
+
+	strongest := 0
+	for i := range chroma {
+		if chroma[i] > chroma[strongest] {
+			strongest = i
+		}
+	}
+
+	if strongest != 9 {
+		t.Errorf("A4 should be in the pitch class 9, got %d", strongest)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	result, err := Analyze(clickTrack(120, 20))
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	if math.Abs(result.Bpm-120) > 1 {
+		t.Errorf("expected 120 bpm, got %.1f", result.Bpm)
+	}
+
+	for name, value := range map[string]float64{
+		"energy":       result.Energy,
+		"danceability": result.Danceability,
+	} {
+		if value < 0 || value > 1 {
+			t.Errorf("%s should be between 0 and 1, got %.2f", name, value)
+		}
+	}
+
+	if result.Danceability < 0.5 {
+		t.Errorf("a steady 120 bpm beat should be danceable, got %.2f", result.Danceability)
+	}
+}
+
+func TestAnalyzeSilence(t *testing.T) {
+	result, err := Analyze(make([]float32, SampleRate*10))
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	if result.Bpm != 0 || result.Energy != 0 {
+		t.Errorf("silence should have no tempo and no energy, got %+v", result)
+	}
+}
+
+func TestAnalyzeNotEnoughAudio(t *testing.T) {
+	_, err := Analyze(make([]float32, SampleRate))
+
+	if !errors.Is(err, ErrNotEnoughAudio) {
+		t.Fatalf("expected ErrNotEnoughAudio, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package audioanalysis
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// fft compute in place an iterative radix-2 FFT, the length of data must be a
+// power of two.
+func fft(data []complex128) {
+	n := len(data)
+
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+
+		if i < j {
+			data[i], data[j] = data[j], data[i]
+		}
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+
+			for k := 0; k < size/2; k++ {
+				even := data[start+k]
+				odd := data[start+k+size/2] * w
+
+				data[start+k] = even + odd
+				data[start+k+size/2] = even - odd
+
+				w *= step
+			}
+		}
+	}
+}
+
+func hannWindow(size int) []float64 {
+	window := make([]float64, size)
+
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(size-1))
+	}
+
+	return window
+}
+
+// spectrogram call fn with the magnitudes of every frame of the signal.
+func spectrogram(
+	samples []float32,
+	frameSize int,
+	hopSize int,
+	fn func(magnitudes []float64),
+) {
+	window := hannWindow(frameSize)
+
+	buffer := make([]complex128, frameSize)
+	magnitudes := make([]float64, frameSize/2+1)
+
+	for start := 0; start+frameSize <= len(samples); start += hopSize {
+		for i := range buffer {
+			buffer[i] = complex(float64(samples[start+i])*window[i], 0)
+		}
+
+		fft(buffer)
+
+		for i := range magnitudes {
+			magnitudes[i] = cmplx.Abs(buffer[i])
+		}
+
+		fn(magnitudes)
+	}
+}
@@ -0,0 +1,184 @@
+package audioanalysis
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var majorKeyNames = [12]string{"C", "Db", "D", "Eb", "E", "F", "F#", "G", "Ab", "A", "Bb", "B"}
+
+var minorKeyNames = [12]string{"Cm", "C#m", "Dm", "Ebm", "Em", "Fm", "F#m", "Gm", "G#m", "Am", "Bbm", "Bm"}
+
+// Krumhansl-Kessler key profiles starting from the tonic
+var (
+	majorKeyProfile = [12]float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88}
+	minorKeyProfile = [12]float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}
+)
+
+// Key is a musical key where PitchClass 0 is C.
+type Key struct {
+	PitchClass int
+	Minor      bool
+}
+
+func (k Key) Name() string {
+	if k.Minor {
+		return minorKeyNames[k.PitchClass]
+	}
+	return majorKeyNames[k.PitchClass]
+}
+
+// Camelot return the Camelot wheel code of the key like "8A" for A minor.
+func (k Key) Camelot() string {
+	majorPitchClass := k.PitchClass
+
+	if k.Minor {
+		majorPitchClass = (k.PitchClass + 3) % 12
+	}
+
+	number := (majorPitchClass*7%12+7)%12 + 1
+
+	if k.Minor {
+		return strconv.Itoa(number) + "A"
+	}
+	return strconv.Itoa(number) + "B"
+}
+
+// CompatibleCamelots return the codes that mix harmonically with the given
+// one, the same key, its neighbours on the wheel and its relative key.
+func CompatibleCamelots(code string) []string {
+	key, ok := ParseKey(code)
+	if !ok {
+		return []string{}
+	}
+
+	camelot := key.Camelot()
+
+	number, _ := strconv.Atoi(camelot[:len(camelot)-1])
+	letter := camelot[len(camelot)-1:]
+
+	otherLetter := "A"
+	if letter == "A" {
+		otherLetter = "B"
+	}
+
+	return []string{
+		camelot,
+		strconv.Itoa((number+10)%12+1) + letter,
+		strconv.Itoa(number%12+1) + letter,
+		strconv.Itoa(number) + otherLetter,
+	}
+}
+
+var camelotRegex = regexp.MustCompile(`^(1[0-2]|[1-9])([ABab])$`)
+
+// ParseKey read keys written as "Am", "F# minor", "Ebmaj" or Camelot codes
+// like "8A" as found in INITIALKEY and TKEY tags.
+func ParseKey(value string) (Key, bool) {
+	value = strings.TrimSpace(value)
+
+	if match := camelotRegex.FindStringSubmatch(value); match != nil {
+		number, _ := strconv.Atoi(match[1])
+		minor := strings.EqualFold(match[2], "A")
+
+		for pitchClass := range 12 {
+			key := Key{PitchClass: pitchClass}
+
+			if key.Camelot() == strconv.Itoa(number)+"B" {
+				if minor {
+					return Key{PitchClass: (pitchClass + 9) % 12, Minor: true}, true
+				}
+				return key, true
+			}
+		}
+	}
+
+	if value == "" {
+		return Key{}, false
+	}
+
+	notes := map[byte]int{'C': 0, 'D': 2, 'E': 4, 'F': 5, 'G': 7, 'A': 9, 'B': 11}
+
+	pitchClass, ok := notes[strings.ToUpper(value[:1])[0]]
+	if !ok {
+		return Key{}, false
+	}
+
+	rest := value[1:]
+
+	switch {
+	case strings.HasPrefix(rest, "#"):
+		pitchClass++
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "♯"):
+		pitchClass++
+		rest = rest[len("♯"):]
+	case strings.HasPrefix(rest, "b"):
+		pitchClass--
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "♭"):
+		pitchClass--
+		rest = rest[len("♭"):]
+	}
+
+	pitchClass = (pitchClass + 12) % 12
+
+	switch strings.ToLower(strings.TrimSpace(rest)) {
+	case "", "maj", "major":
+		return Key{PitchClass: pitchClass}, true
+	case "m", "min", "minor":
+		return Key{PitchClass: pitchClass, Minor: true}, true
+	}
+
+	return Key{}, false
+}
+
+// estimateKey correlate the chroma with every rotation of the major and
+// minor profiles.
+func estimateKey(chroma [12]float64) (Key, float64) {
+	best := Key{}
+	bestScore := math.Inf(-1)
+
+	for tonic := range 12 {
+		var rotated [12]float64
+
+		for i := range 12 {
+			rotated[i] = chroma[(tonic+i)%12]
+		}
+
+		if score := pearson(rotated, majorKeyProfile); score > bestScore {
+			best, bestScore = Key{PitchClass: tonic}, score
+		}
+
+		if score := pearson(rotated, minorKeyProfile); score > bestScore {
+			best, bestScore = Key{PitchClass: tonic, Minor: true}, score
+		}
+	}
+
+	return best, bestScore
+}
+
+func pearson(a [12]float64, b [12]float64) float64 {
+	meanA, meanB := 0.0, 0.0
+
+	for i := range 12 {
+		meanA += a[i] / 12
+		meanB += b[i] / 12
+	}
+
+	covariance, varianceA, varianceB := 0.0, 0.0, 0.0
+
+	for i := range 12 {
+		covariance += (a[i] - meanA) * (b[i] - meanB)
+		varianceA += (a[i] - meanA) * (a[i] - meanA)
+		varianceB += (b[i] - meanB) * (b[i] - meanB)
+	}
+
+	if varianceA == 0 || varianceB == 0 {
+		return 0
+	}
+
+	return covariance / math.Sqrt(varianceA*varianceB)
+}
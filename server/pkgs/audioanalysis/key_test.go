@@ -0,0 +1,127 @@
+package audioanalysis
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Key
+		ok       bool
+	}{
+		{value: "C", expected: Key{PitchClass: 0}, ok: true},
+		{value: "Am", expected: Key{PitchClass: 9, Minor: true}, ok: true},
+		{value: "F# minor", expected: Key{PitchClass: 6, Minor: true}, ok: true},
+		{value: "Ebmaj", expected: Key{PitchClass: 3}, ok: true},
+		{value: "B♭", expected: Key{PitchClass: 10}, ok: true},
+		{value: "Cb", expected: Key{PitchClass: 11}, ok: true},
+		{value: "8A", expected: Key{PitchClass: 9, Minor: true}, ok: true},
+		{value: "8B", expected: Key{PitchClass: 0}, ok: true},
+		{value: "12b", expected: Key{PitchClass: 4}, ok: true},
+		{value: "", ok: false},
+		{value: "13A", ok: false},
+		{value: "H", ok: false},
+		{value: "C dorian", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			key, ok := ParseKey(test.value)
+
+			if ok != test.ok {
+				t.Fatalf("expected ok %v, got %v", test.ok, ok)
+			}
+
+			if ok && key != test.expected {
+				t.Fatalf("expected %+v, got %+v", test.expected, key)
+			}
+		})
+	}
+}
+
+func TestKeyCamelot(t *testing.T) {
+	tests := []struct {
+		key     Key
+		name    string
+		camelot string
+	}{
+		{key: Key{PitchClass: 0}, name: "C", camelot: "8B"},
+		{key: Key{PitchClass: 9, Minor: true}, name: "Am", camelot: "8A"},
+		{key: Key{PitchClass: 7}, name: "G", camelot: "9B"},
+		{key: Key{PitchClass: 11}, name: "B", camelot: "1B"},
+		{key: Key{PitchClass: 8, Minor: true}, name: "G#m", camelot: "1A"},
+		{key: Key{PitchClass: 1, Minor: true}, name: "C#m", camelot: "12A"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.key.Name() != test.name {
+				t.Errorf("expected name %s, got %s", test.name, test.key.Name())
+			}
+
+			if test.key.Camelot() != test.camelot {
+				t.Errorf("expected camelot %s, got %s", test.camelot, test.key.Camelot())
+			}
+
+			// Every Camelot code go back to the same key
+			key, ok := ParseKey(test.camelot)
+			if !ok || key != test.key {
+				t.Errorf("camelot %s should parse back to %+v, got %+v", test.camelot, test.key, key)
+			}
+		})
+	}
+}
+
+func TestCompatibleCamelots(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected []string
+	}{
+		{code: "8A", expected: []string{"8A", "7A", "9A", "8B"}},
+		{code: "1B", expected: []string{"1B", "12B", "2B", "1A"}},
+		{code: "12A", expected: []string{"12A", "11A", "1A", "12B"}},
+		{code: "invalid", expected: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			codes := CompatibleCamelots(test.code)
+
+			if !slices.Equal(codes, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, codes)
+			}
+		})
+	}
+}
+
+func TestEstimateKey(t *testing.T) {
+	for tonic := range 12 {
+		for _, minor := range []bool{false, true} {
+			expected := Key{PitchClass: tonic, Minor: minor}
+
+			t.Run(expected.Name(), func(t *testing.T) {
+				profile := majorKeyProfile
+				if minor {
+					profile = minorKeyProfile
+				}
+
+				var chroma [12]float64
+				for i := range 12 {
+					chroma[(tonic+i)%12] = profile[i]
+				}
+
+				key, confidence := estimateKey(chroma)
+
+				if key != expected {
+					t.Errorf("expected %s, got %s", expected.Name(), key.Name())
+				}
+
+				if confidence < 0.99 {
+					t.Errorf("the profile itself should match, got %.2f", confidence)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package audioanalysis
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	spectrumFrameSize = 4096
+
+	// Frames quieter than this RMS don't say anything about the spectrum
+	spectrumSilenceRms = 1e-4
+
+	// Content is considered gone below this level under the loudest band
+	spectrumContentRange = 70.0
+
+	// A lossy encoder lowpass drop at least this much in about one kHz
+	brickwallDrop = 25.0
+)
+
+type SpectrumResult struct {
+	// CutoffFrequency is the highest frequency in Hz with audible content
+	CutoffFrequency int
+
+	// Brickwall is true when the content stop sharply at the cutoff like
+	// the lowpass of a lossy encoder
+	Brickwall bool
+}
+
+// SpectrumAnalyzer accumulate the average spectrum of mono samples frame by
+// frame so a long file never has to be kept in memory. Samples are full scale
+// signed 32 bits.
+type SpectrumAnalyzer struct {
+	sampleRate int
+
+	window []float64
+	buffer []complex128
+	power  []float64
+
+	frame []int32
+
+	frames      int
+	sampleCount int
+
+	usedBits uint32
+}
+
+func NewSpectrumAnalyzer(sampleRate int) *SpectrumAnalyzer {
+	return &SpectrumAnalyzer{
+		sampleRate: sampleRate,
+
+		window: hannWindow(spectrumFrameSize),
+		buffer: make([]complex128, spectrumFrameSize),
+		power:  make([]float64, spectrumFrameSize/2+1),
+
+		frame: make([]int32, 0, spectrumFrameSize),
+	}
+}
+
+// Add the next samples, they can be given in chunks of any size
+func (a *SpectrumAnalyzer) Add(samples []int32) {
+	for _, sample := range samples {
+		a.usedBits |= uint32(sample)
+
+		a.frame = append(a.frame, sample)
+
+		if len(a.frame) == spectrumFrameSize {
+			a.addFrame()
+			a.frame = a.frame[:0]
+		}
+	}
+
+	a.sampleCount += len(samples)
+}
+
+func (a *SpectrumAnalyzer) addFrame() {
+	sum := 0.0
+
+	for i := range a.buffer {
+		value := float64(a.frame[i]) / math.MaxInt32
+
+		sum += value * value
+
+		a.buffer[i] = complex(value*a.window[i], 0)
+	}
+
+	if math.Sqrt(sum/spectrumFrameSize) < spectrumSilenceRms {
+		return
+	}
+
+	fft(a.buffer)
+
+	for i := range a.power {
+		a.power[i] += real(a.buffer[i])*real(a.buffer[i]) + imag(a.buffer[i])*imag(a.buffer[i])
+	}
+
+	a.frames++
+}
+
+// EffectiveBitsPerSample is the same as the function of the same name for all
+// the added samples
+func (a *SpectrumAnalyzer) EffectiveBitsPerSample() int {
+	return effectiveBits(a.usedBits)
+}
+
+// AnalyzeSpectrum find where the content of mono samples stop in the
+// average spectrum. Samples are full scale signed 32 bits at sampleRate.
+func AnalyzeSpectrum(samples []int32, sampleRate int) (SpectrumResult, error) {
+	analyzer := NewSpectrumAnalyzer(sampleRate)
+
+	analyzer.Add(samples)
+
+	return analyzer.Result()
+}
+
+// Result find where the content stop in the average spectrum of the added
+// samples
+func (a *SpectrumAnalyzer) Result() (SpectrumResult, error) {
+	sampleRate := a.sampleRate
+
+	if sampleRate <= 0 || a.sampleCount < sampleRate*5 || a.sampleCount < spectrumFrameSize {
+		return SpectrumResult{}, ErrNotEnoughAudio
+	}
+
+	if a.frames == 0 {
+		return SpectrumResult{}, ErrNotEnoughAudio
+	}
+
+	binWidth := float64(sampleRate) / spectrumFrameSize
+
+	levels := smoothLevels(a.power, a.frames, int(math.Ceil(100/binWidth)))
+
+	bin := func(frequency float64) int {
+		return min(len(levels)-1, max(0, int(frequency/binWidth)))
+	}
+
+	reference := math.Inf(-1)
+
+	for i := bin(200); i <= bin(4000); i++ {
+		reference = math.Max(reference, levels[i])
+	}
+
+	cutoff := 0
+
+	for i := len(levels) - 1; i > 0; i-- {
+		if levels[i] > reference-spectrumContentRange {
+			cutoff = i
+			break
+		}
+	}
+
+	below := averageLevel(levels, bin(float64(cutoff)*binWidth-1300), bin(float64(cutoff)*binWidth-300))
+	above := averageLevel(levels, bin(float64(cutoff)*binWidth+300), bin(float64(cutoff)*binWidth+1300))
+
+	nyquist := sampleRate / 2
+
+	return SpectrumResult{
+		CutoffFrequency: min(nyquist, int(math.Round(float64(cutoff)*binWidth))),
+
+		Brickwall: float64(cutoff)*binWidth+300 < float64(nyquist) && below-above >= brickwallDrop,
+	}, nil
+}
+
+// smoothLevels return the average power in dB with a moving average of
+// radius bins.
+func smoothLevels(power []float64, frames int, radius int) []float64 {
+	levels := make([]float64, len(power))
+
+	for i := range power {
+		levels[i] = 10 * math.Log10(power[i]/float64(frames)+1e-20)
+	}
+
+	smoothed := make([]float64, len(levels))
+
+	for i := range levels {
+		smoothed[i] = averageLevel(levels, i-radius, i+radius)
+	}
+
+	return smoothed
+}
+
+func averageLevel(levels []float64, from int, to int) float64 {
+	from = max(0, from)
+	to = min(len(levels)-1, to)
+
+	if to < from {
+		return math.Inf(-1)
+	}
+
+	sum := 0.0
+
+	for i := from; i <= to; i++ {
+		sum += levels[i]
+	}
+
+	return sum / float64(to-from+1)
+}
+
+// EffectiveBitsPerSample return how many of the 32 bits of the samples are
+// really used, a 24 bits file padded from 16 bits only use 16.
+func EffectiveBitsPerSample(samples []int32) int {
+	var used uint32
+
+	for _, sample := range samples {
+		used |= uint32(sample)
+	}
+
+	return effectiveBits(used)
+}
+
+func effectiveBits(used uint32) int {
+	if used == 0 {
+		return 0
+	}
+
+	return 32 - bits.TrailingZeros32(used)
+}
+
+// EstimateLossyBitRate guess in kbps the MP3 bitrate which use the lowpass
+// cutoff in Hz, from the usual encoder presets.
+func EstimateLossyBitRate(cutoffFrequency int) int {
+	switch {
+	case cutoffFrequency <= 11500:
+		return 64
+	case cutoffFrequency <= 15000:
+		return 96
+	case cutoffFrequency <= 17000:
+		return 128
+	case cutoffFrequency <= 17800:
+		return 160
+	case cutoffFrequency <= 19000:
+		return 192
+	case cutoffFrequency <= 19800:
+		return 256
+	default:
+		return 320
+	}
+}
@@ -0,0 +1,222 @@
+package audioanalysis
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// shapedNoise generate noise with the level in dB given by shape for each
+// frequency, every block is one analysis frame so the noise has no leakage.
+func shapedNoise(sampleRate int, seconds int, shape func(frequency float64) float64) []int32 {
+	random := rand.New(rand.NewSource(1))
+
+	samples := make([]int32, 0, sampleRate*seconds)
+	block := make([]complex128, spectrumFrameSize)
+
+	for len(samples)+spectrumFrameSize <= sampleRate*seconds {
+		clear(block)
+
+		for k := 1; k < spectrumFrameSize/2; k++ {
+			level := shape(float64(k*sampleRate) / spectrumFrameSize)
+			if math.IsInf(level, -1) {
+				continue
+			}
+
+			value := cmplx.Rect(math.Pow(10, level/20), random.Float64()*2*math.Pi)
+
+			// The conjugate of the spectrum go back to real samples
+			block[k] = cmplx.Conj(value)
+			block[spectrumFrameSize-k] = value
+		}
+
+		fft(block)
+
+		sum := 0.0
+		for _, value := range block {
+			sum += real(value) * real(value)
+		}
+
+		scale := 0.1 / math.Sqrt(sum/spectrumFrameSize)
+
+		for _, value := range block {
+			samples = append(samples, int32(real(value)*scale*math.MaxInt32))
+		}
+	}
+
+	return samples
+}
+
+func brickwall(cutoff float64) func(float64) float64 {
+	return func(frequency float64) float64 {
+		if frequency > cutoff {
+			return math.Inf(-1)
+		}
+		return 0
+	}
+}
+
+func TestAnalyzeSpectrum(t *testing.T) {
+	tests := []struct {
+		name       string
+		sampleRate int
+		shape      func(float64) float64
+
+		expectedCutoff    int
+		expectedBrickwall bool
+	}{
+		{
+			name:           "full band",
+			sampleRate:     44100,
+			shape:          func(float64) float64 { return 0 },
+			expectedCutoff: 22050,
+		},
+		{
+			name:              "128 kbps lowpass",
+			sampleRate:        44100,
+			shape:             brickwall(16000),
+			expectedCutoff:    16000,
+			expectedBrickwall: true,
+		},
+		{
+			name:       "natural roll off",
+			sampleRate: 44100,
+			// 10 dB less per kHz after 10 kHz reach the content range at 17 kHz
+			shape: func(frequency float64) float64 {
+				return -max(0, frequency-10000) / 100
+			},
+			expectedCutoff: 17000,
+		},
+		{
+			name:              "upsampled CD",
+			sampleRate:        96000,
+			shape:             brickwall(22050),
+			expectedCutoff:    22050,
+			expectedBrickwall: true,
+		},
+		{
+			name:           "hi-res",
+			sampleRate:     96000,
+			shape:          func(float64) float64 { return 0 },
+			expectedCutoff: 48000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := AnalyzeSpectrum(shapedNoise(test.sampleRate, 6, test.shape), test.sampleRate)
+			if err != nil {
+				t.Fatalf("unexpected error : %v", err)
+			}
+
+			// The smoothing of the levels move the cutoff by about 100 Hz
+			if math.Abs(float64(result.CutoffFrequency-test.expectedCutoff)) > 200 {
+				t.Errorf("expected a cutoff at %d Hz, got %d", test.expectedCutoff, result.CutoffFrequency)
+			}
+
+			if result.Brickwall != test.expectedBrickwall {
+				t.Errorf("expected brickwall %v", test.expectedBrickwall)
+			}
+		})
+	}
+}
+
+func TestSpectrumAnalyzerChunks(t *testing.T) {
+	samples := shapedNoise(44100, 6, brickwall(16000))
+
+	expected, err := AnalyzeSpectrum(samples, 44100)
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	analyzer := NewSpectrumAnalyzer(44100)
+
+	// Chunks don't follow the frames
+	for len(samples) > 0 {
+		size := min(len(samples), 1000)
+		analyzer.Add(samples[:size])
+		samples = samples[size:]
+	}
+
+	result, err := analyzer.Result()
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	if result != expected {
+		t.Fatalf("expected %+v, got %+v", expected, result)
+	}
+}
+
+func TestAnalyzeSpectrumNotEnoughAudio(t *testing.T) {
+	tests := []struct {
+		name       string
+		samples    []int32
+		sampleRate int
+	}{
+		{name: "too short", samples: shapedNoise(44100, 4, brickwall(16000)), sampleRate: 44100},
+		{name: "silence", samples: make([]int32, 44100*6), sampleRate: 44100},
+		{name: "unknown sample rate", samples: make([]int32, 44100*6), sampleRate: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := AnalyzeSpectrum(test.samples, test.sampleRate)
+
+			if !errors.Is(err, ErrNotEnoughAudio) {
+				t.Fatalf("expected ErrNotEnoughAudio, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEffectiveBitsPerSample(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []int32
+		expected int
+	}{
+		{name: "silence", samples: []int32{0, 0}, expected: 0},
+		{name: "16 bits", samples: []int32{1 << 16, -3 << 16, 1000 << 16}, expected: 16},
+		{name: "24 bits", samples: []int32{1 << 16, 5 << 8}, expected: 24},
+		{name: "32 bits", samples: []int32{-1}, expected: 32},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := EffectiveBitsPerSample(test.samples); got != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, got)
+			}
+
+			analyzer := NewSpectrumAnalyzer(44100)
+			analyzer.Add(test.samples)
+
+			if got := analyzer.EffectiveBitsPerSample(); got != test.expected {
+				t.Errorf("analyzer expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestEstimateLossyBitRate(t *testing.T) {
+	tests := []struct {
+		cutoff   int
+		expected int
+	}{
+		{cutoff: 11000, expected: 64},
+		{cutoff: 15000, expected: 96},
+		{cutoff: 16000, expected: 128},
+		{cutoff: 17500, expected: 160},
+		{cutoff: 19000, expected: 192},
+		{cutoff: 19500, expected: 256},
+		{cutoff: 20500, expected: 320},
+	}
+
+	for _, test := range tests {
+		if got := EstimateLossyBitRate(test.cutoff); got != test.expected {
+			t.Errorf("%d Hz : expected %d kbps, got %d", test.cutoff, test.expected, got)
+		}
+	}
+}
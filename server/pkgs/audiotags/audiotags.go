@@ -0,0 +1,476 @@
+package audiotags
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf16"
+)
+
+var ErrNoMultiValueTags = errors.New("No multi-value tags found")
+
+// Tags hold every values of a tag with a lowercased Vorbis like key
+// ("artist", "albumartist", "genre", "artists", ...).
+type Tags map[string][]string
+
+func (t Tags) add(key string, values ...string) {
+	key = strings.ToLower(key)
+
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		t[key] = append(t[key], value)
+	}
+}
+
+// Every reader stop after this amount of bytes to not load a whole file when
+// it is broken.
+const maxTagSize = 32 * 1024 * 1024
+
+// ReadTags read raw tags keeping multi-value fields (ID3v2.4 null separated
+// frames and repeated Vorbis comments) that dhowden/tag merge together.
+func ReadTags(path string) (Tags, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	magic, err := reader.Peek(4)
+	if err != nil {
+		return nil, ErrNoMultiValueTags
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, []byte("ID3")):
+		tags, err := readID3v2(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		// FLAC file can be prefixed with an ID3 tag
+		if next, err := reader.Peek(4); err == nil && bytes.Equal(next, []byte("fLaC")) {
+			flacTags, err := readFLAC(reader)
+			if err == nil {
+				for key, values := range flacTags {
+					tags.add(key, values...)
+				}
+			}
+		}
+
+		return tags, nil
+	case bytes.Equal(magic, []byte("fLaC")):
+		return readFLAC(reader)
+	case bytes.Equal(magic, []byte("OggS")):
+		return readOgg(reader)
+	}
+
+	return nil, ErrNoMultiValueTags
+}
+
+type vorbisComment struct {
+	vendor   []byte
+	comments []string
+
+	// Data after the comments like the framing bit of Vorbis
+	trailer []byte
+}
+
+func parseVorbisComment(data []byte) (vorbisComment, error) {
+	comment := vorbisComment{}
+
+	r := bytes.NewReader(data)
+
+	var vendorLength uint32
+	if err := binary.Read(r, binary.LittleEndian, &vendorLength); err != nil {
+		return comment, err
+	}
+
+	if int64(vendorLength) > int64(r.Len()) {
+		return comment, io.ErrUnexpectedEOF
+	}
+
+	comment.vendor = make([]byte, vendorLength)
+	if _, err := io.ReadFull(r, comment.vendor); err != nil {
+		return comment, err
+	}
+
+	var count uint32
+	if err := binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return comment, err
+	}
+
+	for i := uint32(0); i < count; i++ {
+		var length uint32
+		if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+			return comment, err
+		}
+
+		if int64(length) > int64(r.Len()) {
+			return comment, io.ErrUnexpectedEOF
+		}
+
+		value := make([]byte, length)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return comment, err
+		}
+
+		comment.comments = append(comment.comments, string(value))
+	}
+
+	comment.trailer = data[len(data)-r.Len():]
+
+	return comment, nil
+}
+
+func readVorbisComment(data []byte) (Tags, error) {
+	comment, err := parseVorbisComment(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := Tags{}
+
+	for _, value := range comment.comments {
+		key, value, found := strings.Cut(value, "=")
+		if !found {
+			continue
+		}
+
+		tags.add(key, value)
+	}
+
+	return tags, nil
+}
+
+func readFLAC(r io.Reader) (Tags, error) {
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(magic, []byte("fLaC")) {
+		return nil, ErrNoMultiValueTags
+	}
+
+	for {
+		header := make([]byte, 4)
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, err
+		}
+
+		last := header[0]&0x80 != 0
+		blockType := header[0] & 0x7f
+		length := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+
+		if blockType == 4 {
+			data := make([]byte, length)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return nil, err
+			}
+			return readVorbisComment(data)
+		}
+
+		if _, err := io.CopyN(io.Discard, r, length); err != nil {
+			return nil, err
+		}
+
+		if last {
+			return nil, ErrNoMultiValueTags
+		}
+	}
+}
+
+// readOgg read the comment header which is always the second packet of the
+// first logical stream for Vorbis and Opus.
+func readOgg(r io.Reader) (Tags, error) {
+	var serial uint32
+	var packet bytes.Buffer
+
+	packetIndex := 0
+	readBytes := 0
+
+	for readBytes < maxTagSize {
+		header := make([]byte, 27)
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(header[:4], []byte("OggS")) {
+			return nil, ErrNoMultiValueTags
+		}
+
+		pageSerial := binary.LittleEndian.Uint32(header[14:18])
+
+		segments := make([]byte, header[26])
+		if _, err := io.ReadFull(r, segments); err != nil {
+			return nil, err
+		}
+
+		pageSize := 0
+		for _, segment := range segments {
+			pageSize += int(segment)
+		}
+
+		data := make([]byte, pageSize)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+
+		readBytes += 27 + len(segments) + pageSize
+
+		if packetIndex == 0 && packet.Len() == 0 {
+			serial = pageSerial
+		}
+
+		if pageSerial != serial {
+			continue
+		}
+
+		offset := 0
+
+		for _, segment := range segments {
+			packet.Write(data[offset : offset+int(segment)])
+			offset += int(segment)
+
+			if segment == 255 {
+				continue
+			}
+
+			if packetIndex == 1 {
+				return readOggCommentPacket(packet.Bytes())
+			}
+
+			packet.Reset()
+			packetIndex++
+		}
+	}
+
+	return nil, ErrNoMultiValueTags
+}
+
+func readOggCommentPacket(packet []byte) (Tags, error) {
+	switch {
+	case bytes.HasPrefix(packet, []byte("\x03vorbis")):
+		return readVorbisComment(packet[7:])
+	case bytes.HasPrefix(packet, []byte("OpusTags")):
+		return readVorbisComment(packet[8:])
+	}
+
+	return nil, ErrNoMultiValueTags
+}
+
+var id3v2FrameKeys = map[string]string{
+	"TPE1": "artist",
+	"TPE2": "albumartist",
+	"TCON": "genre",
+	"TCOM": "composer",
+	"TPE3": "conductor",
+	"MVNM": "movementname",
+	"MVIN": "movement",
+	"TBPM": "bpm",
+	"TKEY": "initialkey",
+}
+
+func readSyncSafeInt(b []byte) int {
+	return int(b[0])<<21 | int(b[1])<<14 | int(b[2])<<7 | int(b[3])
+}
+
+func removeUnsynchronisation(data []byte) []byte {
+	return bytes.ReplaceAll(data, []byte{0xff, 0x00}, []byte{0xff})
+}
+
+func readID3v2(r io.Reader) (Tags, error) {
+	tags := Tags{}
+
+	err := readID3v2Frames(r, func(frameId string, frame []byte) {
+		if frameId == "TXXX" {
+			values := decodeID3v2Texts(frame[0], frame[1:])
+			if len(values) < 2 {
+				return
+			}
+			tags.add(values[0], values[1:]...)
+			return
+		}
+
+		key, ok := id3v2FrameKeys[frameId]
+		if !ok {
+			return
+		}
+
+		tags.add(key, decodeID3v2Texts(frame[0], frame[1:])...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// readID3v2Frames call fn with the content of every non empty frame of an
+// ID3v2.3 or ID3v2.4 tag.
+func readID3v2Frames(r io.Reader, fn func(frameId string, frame []byte)) error {
+	header := make([]byte, 10)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return err
+	}
+
+	version := header[3]
+	flags := header[5]
+	size := readSyncSafeInt(header[6:10])
+
+	if version != 3 && version != 4 {
+		return ErrNoMultiValueTags
+	}
+
+	if size > maxTagSize {
+		return ErrNoMultiValueTags
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return err
+	}
+
+	if flags&0x80 != 0 && version == 3 {
+		data = removeUnsynchronisation(data)
+	}
+
+	if flags&0x40 != 0 && len(data) >= 4 {
+		if version == 3 {
+			data = data[min(len(data), 4+int(binary.BigEndian.Uint32(data[:4]))):]
+		} else {
+			data = data[min(len(data), readSyncSafeInt(data[:4])):]
+		}
+	}
+
+	for len(data) >= 10 {
+		frameId := string(data[:4])
+
+		if data[0] == 0 {
+			break
+		}
+
+		var frameSize int
+		if version == 4 {
+			frameSize = readSyncSafeInt(data[4:8])
+		} else {
+			frameSize = int(binary.BigEndian.Uint32(data[4:8]))
+		}
+
+		frameFlags := data[9]
+
+		if frameSize < 0 || 10+frameSize > len(data) {
+			break
+		}
+
+		frame, ok := decodeID3v2Frame(version, frameFlags, data[10:10+frameSize])
+		data = data[10+frameSize:]
+
+		if !ok {
+			continue
+		}
+
+		fn(frameId, frame)
+	}
+
+	return nil
+}
+
+// decodeID3v2Frame return the content of a frame without its
+// unsynchronisation and data length indicator, it returns false for empty,
+// compressed or encrypted frames.
+func decodeID3v2Frame(version byte, frameFlags byte, frame []byte) ([]byte, bool) {
+	if version == 4 {
+		if frameFlags&0x0c != 0 {
+			return nil, false
+		}
+		if frameFlags&0x02 != 0 {
+			frame = removeUnsynchronisation(frame)
+		}
+		if frameFlags&0x01 != 0 {
+			if len(frame) < 4 {
+				return nil, false
+			}
+			frame = frame[4:]
+		}
+	} else if frameFlags&0xc0 != 0 {
+		return nil, false
+	}
+
+	if len(frame) < 1 {
+		return nil, false
+	}
+
+	return frame, true
+}
+
+func decodeID3v2Texts(encoding byte, data []byte) []string {
+	values := []string{}
+
+	switch encoding {
+	case 1, 2:
+		var order binary.ByteOrder = binary.BigEndian
+		units := []uint16{}
+
+		for i := 0; i+1 < len(data); i += 2 {
+			units = append(units, binary.BigEndian.Uint16(data[i:i+2]))
+		}
+
+		start := 0
+
+		for i := 0; i <= len(units); i++ {
+			if i < len(units) && units[i] != 0 {
+				continue
+			}
+
+			part := units[start:i]
+			start = i + 1
+
+			if encoding == 1 && len(part) > 0 {
+				switch part[0] {
+				case 0xfeff:
+					order = binary.BigEndian
+					part = part[1:]
+				case 0xfffe:
+					order = binary.LittleEndian
+					part = part[1:]
+				}
+			}
+
+			if order == binary.LittleEndian {
+				swapped := make([]uint16, len(part))
+				for j, unit := range part {
+					swapped[j] = unit>>8 | unit<<8
+				}
+				part = swapped
+			}
+
+			values = append(values, string(utf16.Decode(part)))
+		}
+	case 3:
+		values = strings.Split(string(data), "\x00")
+	default:
+		for _, part := range bytes.Split(data, []byte{0}) {
+			runes := make([]rune, len(part))
+			for i, b := range part {
+				runes[i] = rune(b)
+			}
+			values = append(values, string(runes))
+		}
+	}
+
+	// Frame are often terminated by a trailing null
+	for len(values) > 0 && values[len(values)-1] == "" {
+		values = values[:len(values)-1]
+	}
+
+	return values
+}
@@ -0,0 +1,142 @@
+package audiotags
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadTags(t *testing.T) {
+	tests := []struct {
+		fixture string
+
+		expected Tags
+		err      error
+	}{
+		{
+			fixture: "id3v24_multi.mp3",
+			expected: Tags{
+				"artist":      {"Artist A", "Artist B"},
+				"albumartist": {"Album Artist"},
+				"genre":       {"Rock", "Jazz"},
+				"artists":     {"Artist A", "Artist B"},
+			},
+		},
+		{
+			fixture: "id3v23_utf16.mp3",
+			expected: Tags{
+				"artist":                {"Björk", "Sigur Rós"},
+				"genre":                 {"Electronic"},
+				"musicbrainz artist id": {"id-1", "id-2"},
+			},
+		},
+		{
+			fixture: "vorbis_multi.flac",
+			expected: Tags{
+				"title":       {"Fixture Title"},
+				"artist":      {"Artist A", "Artist B"},
+				"albumartist": {"Album Artist"},
+				"genre":       {"Rock", "Jazz"},
+			},
+		},
+		{
+			fixture: "id3_prefixed.flac",
+			expected: Tags{
+				"genre":  {"Ambient"},
+				"artist": {"Artist A", "Artist B"},
+			},
+		},
+		{
+			fixture: "no_comment.flac",
+			err:     ErrNoMultiValueTags,
+		},
+		{
+			fixture: "opus_multi.opus",
+			expected: Tags{
+				"title":       {"Fixture Title"},
+				"artist":      {"Artist A", "Artist B"},
+				"albumartist": {"Album Artist"},
+				"genre":       {"Rock", "Jazz"},
+			},
+		},
+		{
+			fixture: "no_tags.wav",
+			err:     ErrNoMultiValueTags,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			tags, err := ReadTags(filepath.Join("testdata", test.fixture))
+
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("expected error %v, got %v", test.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error : %v", err)
+			}
+
+			if !reflect.DeepEqual(tags, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, tags)
+			}
+		})
+	}
+}
+
+func TestReadTagsOggCommentSpanningSegments(t *testing.T) {
+	tags, err := ReadTags(filepath.Join("testdata", "vorbis_multi.ogg"))
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	if !reflect.DeepEqual(tags["artist"], []string{"Artist A", "Artist B"}) {
+		t.Errorf("expected both artists, got %v", tags["artist"])
+	}
+
+	if len(tags["comment"]) != 1 || len(tags["comment"][0]) != 600 {
+		t.Errorf("expected the whole 600 bytes comment, got %v", tags["comment"])
+	}
+}
+
+func TestDecodeID3v2Texts(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding byte
+		data     []byte
+		expected []string
+	}{
+		{
+			name:     "latin1",
+			encoding: 0,
+			data:     []byte("Caf\xe9\x00Bar\x00"),
+			expected: []string{"Café", "Bar"},
+		},
+		{
+			name:     "utf16 big endian without bom",
+			encoding: 2,
+			data:     []byte{0, 'A', 0, 0, 0, 'B'},
+			expected: []string{"A", "B"},
+		},
+		{
+			name:     "utf8 with trailing null",
+			encoding: 3,
+			data:     []byte("One\x00Two\x00"),
+			expected: []string{"One", "Two"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values := decodeID3v2Texts(test.encoding, test.data)
+
+			if !reflect.DeepEqual(values, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, values)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package audiotags
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+var ErrUnsupportedID3v2 = errors.New("Only ID3v2.4 tags can be rewritten")
+
+// The largest size a sync safe integer can hold
+const maxID3v2TagSize = 1<<28 - 1
+
+func writeSyncSafeInt(b []byte, value int) {
+	b[0] = byte(value>>21) & 0x7f
+	b[1] = byte(value>>14) & 0x7f
+	b[2] = byte(value>>7) & 0x7f
+	b[3] = byte(value) & 0x7f
+}
+
+// isID3v2TextFrame tell if a tag name is a text frame id like TPE1, every
+// other name is written as the description of a TXXX frame
+func isID3v2TextFrame(name string) bool {
+	if len(name) != 4 || name[0] != 'T' || name == "TXXX" {
+		return false
+	}
+
+	for _, c := range name {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func encodeID3v2Frame(frameId string, payload []byte) []byte {
+	frame := make([]byte, 10, 10+len(payload))
+
+	copy(frame, frameId)
+	writeSyncSafeInt(frame[4:8], len(payload))
+
+	return append(frame, payload...)
+}
+
+// encodeID3v2TextFrame write every values in one UTF-8 frame separated by
+// null characters like ID3v2.4 define multi-value text frames
+func encodeID3v2TextFrame(tag MultiValueTag) []byte {
+	values := []string{}
+
+	for _, value := range tag.Values {
+		if strings.TrimSpace(value) != "" {
+			values = append(values, value)
+		}
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	text := strings.Join(values, "\x00")
+
+	if isID3v2TextFrame(tag.Name) {
+		return encodeID3v2Frame(tag.Name, append([]byte{3}, text...))
+	}
+
+	return encodeID3v2Frame("TXXX", append([]byte{3}, tag.Name+"\x00"+text...))
+}
+
+// WriteID3v2Tags replace the text frames and TXXX frames of the tags in the
+// ID3v2.4 tag of a MP3 file, the tag is created when the file doesn't have
+// one.
+func WriteID3v2Tags(path string, tags []MultiValueTag) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	frames := []byte{}
+
+	if magic, err := reader.Peek(3); err == nil && bytes.Equal(magic, []byte("ID3")) {
+		header := make([]byte, 10)
+		if _, err := io.ReadFull(reader, header); err != nil {
+			return err
+		}
+
+		version := header[3]
+		flags := header[5]
+		size := readSyncSafeInt(header[6:10])
+
+		if version != 4 || size > maxTagSize {
+			return ErrUnsupportedID3v2
+		}
+
+		data := make([]byte, size)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return err
+		}
+
+		if flags&0x10 != 0 {
+			if _, err := reader.Discard(10); err != nil {
+				return err
+			}
+		}
+
+		// The extended header is dropped with the flag
+		if flags&0x40 != 0 && len(data) >= 4 {
+			data = data[min(len(data), readSyncSafeInt(data[:4])):]
+		}
+
+		for len(data) >= 10 && data[0] != 0 {
+			frameId := string(data[:4])
+			frameSize := readSyncSafeInt(data[4:8])
+
+			if 10+frameSize > len(data) {
+				break
+			}
+
+			raw := data[:10+frameSize]
+			data = data[10+frameSize:]
+
+			if isID3v2TextFrame(frameId) && isMultiValueTagName(tags, frameId) {
+				continue
+			}
+
+			if frameId == "TXXX" {
+				frame, ok := decodeID3v2Frame(4, raw[9], raw[10:])
+				if ok {
+					values := decodeID3v2Texts(frame[0], frame[1:])
+
+					if len(values) > 0 && isMultiValueTagName(tags, values[0]) {
+						continue
+					}
+				}
+			}
+
+			frames = append(frames, raw...)
+		}
+	}
+
+	for _, tag := range tags {
+		frames = append(frames, encodeID3v2TextFrame(tag)...)
+	}
+
+	if len(frames) > maxID3v2TagSize {
+		return ErrUnsupportedID3v2
+	}
+
+	return replaceFile(path, func(out io.Writer) error {
+		header := []byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 0}
+		writeSyncSafeInt(header[6:10], len(frames))
+
+		if _, err := out.Write(header); err != nil {
+			return err
+		}
+		if _, err := out.Write(frames); err != nil {
+			return err
+		}
+
+		_, err := io.Copy(out, reader)
+		return err
+	})
+}
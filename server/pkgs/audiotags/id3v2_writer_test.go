@@ -0,0 +1,104 @@
+package audiotags
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestWriteID3v2Tags(t *testing.T) {
+	path := copyFixture(t, "id3v24_multi.mp3")
+
+	tags := []MultiValueTag{
+		{Name: "TPE1", Values: []string{"New A", "New B", "New C"}},
+		{Name: "TCON"},
+		{Name: "MusicBrainz Artist Id", Values: []string{"id-1", "id-2"}},
+		{Name: "ARTISTS"},
+	}
+
+	if err := WriteID3v2Tags(path, tags); err != nil {
+		t.Fatalf("write failed : %v", err)
+	}
+
+	got, err := ReadTags(path)
+	if err != nil {
+		t.Fatalf("read failed : %v", err)
+	}
+
+	expected := Tags{
+		"albumartist":           {"Album Artist"},
+		"artist":                {"New A", "New B", "New C"},
+		"musicbrainz artist id": {"id-1", "id-2"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestWriteID3v2TagsWithoutTag(t *testing.T) {
+	audio := []byte{0xff, 0xfb, 0x90, 0x00, 0x00, 0x00}
+
+	path := filepath.Join(t.TempDir(), "no_tag.mp3")
+
+	if err := os.WriteFile(path, audio, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tags := []MultiValueTag{{Name: "TPE1", Values: []string{"Artist A", "Artist B"}}}
+
+	if err := WriteID3v2Tags(path, tags); err != nil {
+		t.Fatalf("write failed : %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasSuffix(data, audio) {
+		t.Error("audio should be kept after the new tag")
+	}
+
+	got, err := ReadTags(path)
+	if err != nil {
+		t.Fatalf("read failed : %v", err)
+	}
+
+	if !reflect.DeepEqual(got["artist"], []string{"Artist A", "Artist B"}) {
+		t.Errorf("expected both artists, got %v", got["artist"])
+	}
+}
+
+func TestWriteID3v2TagsUnsupportedVersion(t *testing.T) {
+	path := copyFixture(t, "id3v23_utf16.mp3")
+
+	if err := WriteID3v2Tags(path, nil); !errors.Is(err, ErrUnsupportedID3v2) {
+		t.Fatalf("expected ErrUnsupportedID3v2, got %v", err)
+	}
+}
+
+func TestIsID3v2TextFrame(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "TPE1", expected: true},
+		{name: "TCON", expected: true},
+		{name: "TXXX", expected: false},
+		{name: "WOAR", expected: false},
+		{name: "Test", expected: false},
+		{name: "ARTISTS", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if isID3v2TextFrame(test.name) != test.expected {
+				t.Fatalf("expected %v", test.expected)
+			}
+		})
+	}
+}
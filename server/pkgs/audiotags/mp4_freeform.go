@@ -0,0 +1,525 @@
+package audiotags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidMP4 = errors.New("MP4 file is invalid")
+
+const mp4FreeformMean = "com.apple.iTunes"
+
+// Bytes of the full atom header (version and flags) before the children of
+// the containers the writer walks through
+var mp4ContainerAtoms = map[string]int{
+	"moov": 0,
+	"trak": 0,
+	"mdia": 0,
+	"minf": 0,
+	"stbl": 0,
+	"udta": 0,
+	"edts": 0,
+	"meta": 4,
+}
+
+type mp4Atom struct {
+	kind    string
+	payload []byte
+}
+
+func parseMP4Atoms(data []byte) ([]mp4Atom, error) {
+	atoms := []mp4Atom{}
+
+	for len(data) != 0 {
+		if len(data) < 8 {
+			return nil, ErrInvalidMP4
+		}
+
+		size := uint64(binary.BigEndian.Uint32(data))
+		kind := string(data[4:8])
+		headerSize := uint64(8)
+
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return nil, ErrInvalidMP4
+			}
+			size = binary.BigEndian.Uint64(data[8:])
+			headerSize = 16
+		}
+
+		if size < headerSize || size > uint64(len(data)) {
+			return nil, ErrInvalidMP4
+		}
+
+		atoms = append(atoms, mp4Atom{
+			kind:    kind,
+			payload: data[headerSize:size],
+		})
+
+		data = data[size:]
+	}
+
+	return atoms, nil
+}
+
+func encodeMP4Atom(kind string, payloads ...[]byte) []byte {
+	size := 8
+
+	for _, payload := range payloads {
+		size += len(payload)
+	}
+
+	atom := make([]byte, 8, size)
+
+	binary.BigEndian.PutUint32(atom, uint32(size))
+	copy(atom[4:], kind)
+
+	for _, payload := range payloads {
+		atom = append(atom, payload...)
+	}
+
+	return atom
+}
+
+// getMP4ContainerHeaderSize handle the meta atom which is a full atom in MP4
+// files but a simple container in QuickTime files
+func getMP4ContainerHeaderSize(kind string, payload []byte) int {
+	headerSize := mp4ContainerAtoms[kind]
+
+	if kind == "meta" && len(payload) >= 8 && string(payload[4:8]) == "hdlr" {
+		return 0
+	}
+
+	return headerSize
+}
+
+// rewriteMP4Atom rebuild an atom and its children, update returns the new
+// payload of the atoms it changes or nil to keep walking through them
+func rewriteMP4Atom(
+	path string,
+	atom mp4Atom,
+	update func(path string, payload []byte) ([]byte, error),
+) ([]byte, error) {
+	path = path + "/" + atom.kind
+
+	payload, err := update(path, atom.payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload != nil {
+		return encodeMP4Atom(atom.kind, payload), nil
+	}
+
+	if _, ok := mp4ContainerAtoms[atom.kind]; !ok {
+		return encodeMP4Atom(atom.kind, atom.payload), nil
+	}
+
+	headerSize := getMP4ContainerHeaderSize(atom.kind, atom.payload)
+	if len(atom.payload) < headerSize {
+		return nil, ErrInvalidMP4
+	}
+
+	children, err := parseMP4Atoms(atom.payload[headerSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	payloads := [][]byte{atom.payload[:headerSize]}
+
+	for _, child := range children {
+		encoded, err := rewriteMP4Atom(path, child, update)
+		if err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, encoded)
+	}
+
+	return encodeMP4Atom(atom.kind, payloads...), nil
+}
+
+func getMP4FreeformName(payload []byte) (string, string) {
+	children, err := parseMP4Atoms(payload)
+	if err != nil {
+		return "", ""
+	}
+
+	mean, name := "", ""
+
+	for _, child := range children {
+		if len(child.payload) < 4 {
+			continue
+		}
+
+		switch child.kind {
+		case "mean":
+			mean = string(child.payload[4:])
+		case "name":
+			name = string(child.payload[4:])
+		}
+	}
+
+	return mean, name
+}
+
+func encodeMP4FreeformTag(tag MultiValueTag) []byte {
+	payloads := [][]byte{
+		encodeMP4Atom("mean", []byte{0, 0, 0, 0}, []byte(mp4FreeformMean)),
+		encodeMP4Atom("name", []byte{0, 0, 0, 0}, []byte(tag.Name)),
+	}
+
+	for _, value := range tag.Values {
+		// UTF-8 type then the default locale
+		payloads = append(payloads, encodeMP4Atom("data", []byte{0, 0, 0, 1, 0, 0, 0, 0}, []byte(value)))
+	}
+
+	return encodeMP4Atom("----", payloads...)
+}
+
+// The handler of the iTunes metadata, files written by iTunes and FFmpeg
+// use the same one
+var mp4MetadataHandler = encodeMP4Atom(
+	"hdlr",
+	[]byte{0, 0, 0, 0, 0, 0, 0, 0},
+	[]byte("mdirappl"),
+	[]byte{0, 0, 0, 0, 0, 0, 0, 0, 0},
+)
+
+// addMP4MetadataList create the udta, meta and ilst atoms missing in the moov
+// atom and put the list in them.
+func addMP4MetadataList(moov mp4Atom, list []byte) ([]byte, error) {
+	ilst := encodeMP4Atom("ilst", list)
+
+	newMeta := encodeMP4Atom("meta", []byte{0, 0, 0, 0}, mp4MetadataHandler, ilst)
+
+	children, err := parseMP4Atoms(moov.payload)
+	if err != nil {
+		return nil, err
+	}
+
+	payloads := [][]byte{}
+	foundUserData := false
+
+	for _, child := range children {
+		if child.kind != "udta" || foundUserData {
+			payloads = append(payloads, encodeMP4Atom(child.kind, child.payload))
+			continue
+		}
+
+		foundUserData = true
+
+		userData, err := parseMP4Atoms(child.payload)
+		if err != nil {
+			return nil, err
+		}
+
+		userDataPayloads := [][]byte{}
+		foundMeta := false
+
+		for _, userDataChild := range userData {
+			if userDataChild.kind != "meta" || foundMeta {
+				userDataPayloads = append(
+					userDataPayloads,
+					encodeMP4Atom(userDataChild.kind, userDataChild.payload),
+				)
+				continue
+			}
+
+			foundMeta = true
+
+			headerSize := getMP4ContainerHeaderSize("meta", userDataChild.payload)
+			if len(userDataChild.payload) < headerSize {
+				return nil, ErrInvalidMP4
+			}
+
+			metaChildren, err := parseMP4Atoms(userDataChild.payload[headerSize:])
+			if err != nil {
+				return nil, err
+			}
+
+			metaPayloads := [][]byte{userDataChild.payload[:headerSize]}
+			foundHandler := false
+
+			for _, metaChild := range metaChildren {
+				if metaChild.kind == "hdlr" {
+					foundHandler = true
+				}
+				metaPayloads = append(metaPayloads, encodeMP4Atom(metaChild.kind, metaChild.payload))
+			}
+
+			if !foundHandler {
+				metaPayloads = slices.Insert(metaPayloads, 1, mp4MetadataHandler)
+			}
+
+			metaPayloads = append(metaPayloads, ilst)
+
+			userDataPayloads = append(userDataPayloads, encodeMP4Atom("meta", metaPayloads...))
+		}
+
+		if !foundMeta {
+			userDataPayloads = append(userDataPayloads, newMeta)
+		}
+
+		payloads = append(payloads, encodeMP4Atom("udta", userDataPayloads...))
+	}
+
+	if !foundUserData {
+		payloads = append(payloads, encodeMP4Atom("udta", newMeta))
+	}
+
+	return encodeMP4Atom("moov", payloads...), nil
+}
+
+func rewriteMP4MetadataList(payload []byte, tags []MultiValueTag) ([]byte, error) {
+	children, err := parseMP4Atoms(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	replaced := map[string]bool{}
+
+	for _, tag := range tags {
+		replaced[strings.ToLower(tag.Name)] = true
+	}
+
+	list := []byte{}
+
+	for _, child := range children {
+		if child.kind == "----" {
+			mean, name := getMP4FreeformName(child.payload)
+
+			if mean == mp4FreeformMean && replaced[strings.ToLower(name)] {
+				continue
+			}
+		}
+
+		list = append(list, encodeMP4Atom(child.kind, child.payload)...)
+	}
+
+	for _, tag := range tags {
+		values := []string{}
+
+		for _, value := range tag.Values {
+			if strings.TrimSpace(value) != "" {
+				values = append(values, value)
+			}
+		}
+
+		if len(values) == 0 {
+			continue
+		}
+
+		list = append(list, encodeMP4FreeformTag(MultiValueTag{
+			Name:   tag.Name,
+			Values: values,
+		})...)
+	}
+
+	return list, nil
+}
+
+// shiftMP4ChunkOffsets move the chunk offsets pointing after the end of the
+// original moov atom, they change when the moov atom is before the mdat atom
+func shiftMP4ChunkOffsets(kind string, payload []byte, after uint64, delta int64) ([]byte, error) {
+	if len(payload) < 8 {
+		return nil, ErrInvalidMP4
+	}
+
+	entrySize := 4
+	if kind == "co64" {
+		entrySize = 8
+	}
+
+	count := int(binary.BigEndian.Uint32(payload[4:]))
+
+	if len(payload) < 8+count*entrySize {
+		return nil, ErrInvalidMP4
+	}
+
+	shifted := bytes.Clone(payload)
+
+	for i := range count {
+		entry := shifted[8+i*entrySize:]
+
+		if entrySize == 4 {
+			offset := uint64(binary.BigEndian.Uint32(entry))
+			if offset >= after {
+				offset = uint64(int64(offset) + delta)
+				if offset > 0xFFFFFFFF {
+					return nil, ErrInvalidMP4
+				}
+				binary.BigEndian.PutUint32(entry, uint32(offset))
+			}
+			continue
+		}
+
+		offset := binary.BigEndian.Uint64(entry)
+		if offset >= after {
+			binary.BigEndian.PutUint64(entry, uint64(int64(offset)+delta))
+		}
+	}
+
+	return shifted, nil
+}
+
+type mp4TopLevelAtom struct {
+	kind   string
+	offset int64
+	size   int64
+}
+
+func readMP4TopLevelAtoms(file *os.File) ([]mp4TopLevelAtom, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	atoms := []mp4TopLevelAtom{}
+
+	header := make([]byte, 16)
+
+	for offset := int64(0); offset < info.Size(); {
+		if _, err := file.ReadAt(header[:8], offset); err != nil {
+			return nil, ErrInvalidMP4
+		}
+
+		size := int64(binary.BigEndian.Uint32(header))
+
+		switch size {
+		case 0:
+			size = info.Size() - offset
+		case 1:
+			if _, err := file.ReadAt(header[8:16], offset+8); err != nil {
+				return nil, ErrInvalidMP4
+			}
+			size = int64(binary.BigEndian.Uint64(header[8:]))
+		}
+
+		if size < 8 || offset+size > info.Size() {
+			return nil, ErrInvalidMP4
+		}
+
+		atoms = append(atoms, mp4TopLevelAtom{
+			kind:   string(header[4:8]),
+			offset: offset,
+			size:   size,
+		})
+
+		offset += size
+	}
+
+	return atoms, nil
+}
+
+// WriteMP4FreeformTags replace the freeform tags of the iTunes metadata list
+// of a MP4 file, the list is created when the file doesn't have one.
+func WriteMP4FreeformTags(path string, tags []MultiValueTag) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	atoms, err := readMP4TopLevelAtoms(file)
+	if err != nil {
+		return err
+	}
+
+	var moov *mp4TopLevelAtom
+
+	for i := range atoms {
+		if atoms[i].kind == "moov" {
+			moov = &atoms[i]
+			break
+		}
+	}
+
+	if moov == nil {
+		return ErrInvalidMP4
+	}
+
+	data := make([]byte, moov.size)
+
+	if _, err := file.ReadAt(data, moov.offset); err != nil {
+		return err
+	}
+
+	moovAtoms, err := parseMP4Atoms(data)
+	if err != nil || len(moovAtoms) != 1 {
+		return ErrInvalidMP4
+	}
+
+	foundMetadataList := false
+
+	newMoov, err := rewriteMP4Atom("", moovAtoms[0], func(path string, payload []byte) ([]byte, error) {
+		if path != "/moov/udta/meta/ilst" {
+			return nil, nil
+		}
+
+		foundMetadataList = true
+
+		return rewriteMP4MetadataList(payload, tags)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !foundMetadataList {
+		list, err := rewriteMP4MetadataList(nil, tags)
+		if err != nil {
+			return err
+		}
+
+		// Nothing to remove and nothing to add
+		if len(list) == 0 {
+			return nil
+		}
+
+		newMoov, err = addMP4MetadataList(moovAtoms[0], list)
+		if err != nil {
+			return err
+		}
+	}
+
+	delta := int64(len(newMoov)) - moov.size
+	moovEnd := uint64(moov.offset + moov.size)
+
+	if delta != 0 {
+		newMoovAtoms, err := parseMP4Atoms(newMoov)
+		if err != nil {
+			return err
+		}
+
+		newMoov, err = rewriteMP4Atom("", newMoovAtoms[0], func(path string, payload []byte) ([]byte, error) {
+			if !strings.HasSuffix(path, "/stbl/stco") && !strings.HasSuffix(path, "/stbl/co64") {
+				return nil, nil
+			}
+
+			return shiftMP4ChunkOffsets(path[len(path)-4:], payload, moovEnd, delta)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return replaceFile(path, func(out io.Writer) error {
+		_, err := io.Copy(out, io.NewSectionReader(file, 0, moov.offset))
+		if err != nil {
+			return err
+		}
+
+		if _, err := out.Write(newMoov); err != nil {
+			return err
+		}
+
+		_, err = io.Copy(out, io.NewSectionReader(file, int64(moovEnd), 1<<62))
+		return err
+	})
+}
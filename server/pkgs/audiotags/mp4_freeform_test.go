@@ -0,0 +1,234 @@
+package audiotags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// copyFixture copy a testdata file in a temporary directory since the writer
+// replace the file it's given
+func copyFixture(t *testing.T, name string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), name)
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func findMP4Atom(t *testing.T, atoms []mp4Atom, path ...string) *mp4Atom {
+	t.Helper()
+
+	for _, atom := range atoms {
+		if atom.kind != path[0] {
+			continue
+		}
+
+		if len(path) == 1 {
+			return &atom
+		}
+
+		payload := atom.payload[getMP4ContainerHeaderSize(atom.kind, atom.payload):]
+
+		children, err := parseMP4Atoms(payload)
+		if err != nil {
+			t.Fatalf("can't parse %s : %v", atom.kind, err)
+		}
+
+		return findMP4Atom(t, children, path[1:]...)
+	}
+
+	return nil
+}
+
+func readMP4FreeformTags(t *testing.T, data []byte) map[string][]string {
+	t.Helper()
+
+	atoms, err := parseMP4Atoms(data)
+	if err != nil {
+		t.Fatalf("can't parse file : %v", err)
+	}
+
+	list := findMP4Atom(t, atoms, "moov", "udta", "meta", "ilst")
+	if list == nil {
+		t.Fatal("file has no metadata list")
+	}
+
+	children, err := parseMP4Atoms(list.payload)
+	if err != nil {
+		t.Fatalf("can't parse ilst : %v", err)
+	}
+
+	tags := map[string][]string{}
+
+	for _, child := range children {
+		if child.kind != "----" {
+			tags[child.kind] = nil
+			continue
+		}
+
+		mean, name := getMP4FreeformName(child.payload)
+		if mean != mp4FreeformMean {
+			continue
+		}
+
+		entries, err := parseMP4Atoms(child.payload)
+		if err != nil {
+			t.Fatalf("can't parse freeform tag : %v", err)
+		}
+
+		values := []string{}
+
+		for _, entry := range entries {
+			if entry.kind == "data" {
+				values = append(values, string(entry.payload[8:]))
+			}
+		}
+
+		tags[name] = values
+	}
+
+	return tags
+}
+
+// readMP4ChunkOffsets return the offsets of the stco or co64 atom
+func readMP4ChunkOffsets(t *testing.T, data []byte) []uint64 {
+	t.Helper()
+
+	atoms, err := parseMP4Atoms(data)
+	if err != nil {
+		t.Fatalf("can't parse file : %v", err)
+	}
+
+	for _, kind := range []string{"stco", "co64"} {
+		atom := findMP4Atom(t, atoms, "moov", "trak", "mdia", "minf", "stbl", kind)
+		if atom == nil {
+			continue
+		}
+
+		count := int(binary.BigEndian.Uint32(atom.payload[4:]))
+		offsets := make([]uint64, count)
+
+		for i := range count {
+			if kind == "stco" {
+				offsets[i] = uint64(binary.BigEndian.Uint32(atom.payload[8+i*4:]))
+			} else {
+				offsets[i] = binary.BigEndian.Uint64(atom.payload[8+i*8:])
+			}
+		}
+
+		return offsets
+	}
+
+	t.Fatal("file has no chunk offsets")
+	return nil
+}
+
+func TestWriteMP4FreeformTags(t *testing.T) {
+	tags := []MultiValueTag{
+		{Name: "MusicBrainz Track Id", Values: []string{"new-recording-id"}},
+		{Name: "MusicBrainz Artist Id", Values: []string{"artist-id-1", "artist-id-2"}},
+		{Name: "Custom"},
+	}
+
+	tests := []struct {
+		fixture string
+
+		// Files without a metadata list only get the new tags
+		hasTitle bool
+	}{
+		{fixture: "stco_faststart.m4a", hasTitle: true},
+		{fixture: "co64_faststart.m4a", hasTitle: true},
+		{fixture: "stco_moov_last.m4a", hasTitle: true},
+		{fixture: "no_udta.m4a"},
+		{fixture: "no_ilst.m4a"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			path := copyFixture(t, test.fixture)
+
+			if err := WriteMP4FreeformTags(path, tags); err != nil {
+				t.Fatalf("write failed : %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := readMP4FreeformTags(t, data)
+
+			if !slices.Equal(got["MusicBrainz Track Id"], []string{"new-recording-id"}) {
+				t.Errorf("recording id should be replaced, got %v", got["MusicBrainz Track Id"])
+			}
+
+			if !slices.Equal(got["MusicBrainz Artist Id"], []string{"artist-id-1", "artist-id-2"}) {
+				t.Errorf("artist ids should have one value each, got %v", got["MusicBrainz Artist Id"])
+			}
+
+			if _, ok := got["Custom"]; ok {
+				t.Error("tag without values should be removed")
+			}
+
+			if _, ok := got["\xa9nam"]; ok != test.hasTitle {
+				t.Errorf("title atom presence should be %v", test.hasTitle)
+			}
+
+			for i, offset := range readMP4ChunkOffsets(t, data) {
+				marker := []byte{'C', 'H', 'U', 'N', 'K', byte('0' + i)}
+
+				if offset+6 > uint64(len(data)) || !bytes.Equal(data[offset:offset+6], marker) {
+					t.Errorf("chunk %d offset %d doesn't point to its data anymore", i, offset)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteMP4FreeformTagsWithoutChanges(t *testing.T) {
+	path := copyFixture(t, "no_udta.m4a")
+
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteMP4FreeformTags(path, []MultiValueTag{{Name: "Custom"}}); err != nil {
+		t.Fatalf("write failed : %v", err)
+	}
+
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(before, after) {
+		t.Error("removing a missing tag shouldn't change the file")
+	}
+}
+
+func TestWriteMP4FreeformTagsInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "invalid.m4a")
+
+	if err := os.WriteFile(path, []byte("\x00\x00\x00\x10ftypM4A "), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteMP4FreeformTags(path, nil); !errors.Is(err, ErrInvalidMP4) {
+		t.Fatalf("expected ErrInvalidMP4, got %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package audiotags
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"time"
+)
+
+var ErrNoSyncedLyrics = errors.New("No synced lyrics found")
+
+type SyncedLyricsLine struct {
+	Time time.Duration
+	Text string
+}
+
+// ReadSyncedLyrics read the lyrics of the first ID3v2 SYLT frame using
+// millisecond timestamps.
+func ReadSyncedLyrics(path string) ([]SyncedLyricsLine, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	magic, err := reader.Peek(3)
+	if err != nil || !bytes.Equal(magic, []byte("ID3")) {
+		return nil, ErrNoSyncedLyrics
+	}
+
+	var lines []SyncedLyricsLine
+
+	err = readID3v2Frames(reader, func(frameId string, frame []byte) {
+		if frameId != "SYLT" || lines != nil {
+			return
+		}
+
+		lines = decodeSYLT(frame)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 {
+		return nil, ErrNoSyncedLyrics
+	}
+
+	return lines, nil
+}
+
+func decodeSYLT(frame []byte) []SyncedLyricsLine {
+	// Encoding, language, timestamp format and content type
+	if len(frame) < 6 {
+		return nil
+	}
+
+	encoding := frame[0]
+	timestampFormat := frame[4]
+
+	// MPEG frames timestamps need the audio stream to be converted
+	if timestampFormat != 2 {
+		return nil
+	}
+
+	data := frame[6:]
+
+	// Skip the content descriptor
+	_, data = splitID3v2Text(encoding, data)
+
+	lines := []SyncedLyricsLine{}
+
+	for len(data) > 0 {
+		var text []byte
+		text, data = splitID3v2Text(encoding, data)
+
+		if len(data) < 4 {
+			break
+		}
+
+		timestamp := binary.BigEndian.Uint32(data[:4])
+		data = data[4:]
+
+		values := decodeID3v2Texts(encoding, text)
+
+		line := ""
+		if len(values) != 0 {
+			line = values[0]
+		}
+
+		lines = append(lines, SyncedLyricsLine{
+			Time: time.Duration(timestamp) * time.Millisecond,
+			Text: line,
+		})
+	}
+
+	return lines
+}
+
+// splitID3v2Text cut data after the first null terminated string of the
+// given encoding.
+func splitID3v2Text(encoding byte, data []byte) ([]byte, []byte) {
+	if encoding == 1 || encoding == 2 {
+		for i := 0; i+1 < len(data); i += 2 {
+			if data[i] == 0 && data[i+1] == 0 {
+				return data[:i], data[i+2:]
+			}
+		}
+		return data, nil
+	}
+
+	if index := bytes.IndexByte(data, 0); index != -1 {
+		return data[:index], data[index+1:]
+	}
+
+	return data, nil
+}
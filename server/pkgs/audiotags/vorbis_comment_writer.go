@@ -0,0 +1,420 @@
+package audiotags
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	ErrInvalidFLAC = errors.New("FLAC file is invalid")
+	ErrInvalidOgg  = errors.New("Ogg file is invalid")
+)
+
+func encodeVorbisComment(comment vorbisComment) []byte {
+	var data bytes.Buffer
+
+	_ = binary.Write(&data, binary.LittleEndian, uint32(len(comment.vendor)))
+	data.Write(comment.vendor)
+
+	_ = binary.Write(&data, binary.LittleEndian, uint32(len(comment.comments)))
+
+	for _, value := range comment.comments {
+		_ = binary.Write(&data, binary.LittleEndian, uint32(len(value)))
+		data.WriteString(value)
+	}
+
+	data.Write(comment.trailer)
+
+	return data.Bytes()
+}
+
+// rewriteVorbisComment remove every comment of the tags and add one comment
+// per value, the other comments keep their order.
+func rewriteVorbisComment(comment vorbisComment, tags []MultiValueTag) vorbisComment {
+	comments := []string{}
+
+	for _, value := range comment.comments {
+		key, _, _ := strings.Cut(value, "=")
+
+		if isMultiValueTagName(tags, key) {
+			continue
+		}
+
+		comments = append(comments, value)
+	}
+
+	for _, tag := range tags {
+		for _, value := range tag.Values {
+			if strings.TrimSpace(value) == "" {
+				continue
+			}
+			comments = append(comments, tag.Name+"="+value)
+		}
+	}
+
+	comment.comments = comments
+
+	return comment
+}
+
+// WriteVorbisComments replace the Vorbis comments of a FLAC, Ogg Vorbis or
+// Opus file.
+func WriteVorbisComments(path string, tags []MultiValueTag) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	magic, err := reader.Peek(4)
+	if err != nil {
+		return ErrInvalidFLAC
+	}
+
+	return replaceFile(path, func(out io.Writer) error {
+		if bytes.Equal(magic, []byte("OggS")) {
+			return writeOggVorbisComment(reader, out, tags)
+		}
+
+		return writeFLACVorbisComment(reader, out, tags)
+	})
+}
+
+type flacBlock struct {
+	kind byte
+	data []byte
+}
+
+func writeFLACVorbisComment(r *bufio.Reader, out io.Writer, tags []MultiValueTag) error {
+	// FLAC file can be prefixed with an ID3 tag, it is kept as is
+	if magic, err := r.Peek(3); err == nil && bytes.Equal(magic, []byte("ID3")) {
+		header, err := r.Peek(10)
+		if err != nil {
+			return ErrInvalidFLAC
+		}
+
+		size := int64(10 + readSyncSafeInt(header[6:10]))
+		if header[5]&0x10 != 0 {
+			size += 10
+		}
+
+		if _, err := io.CopyN(out, r, size); err != nil {
+			return err
+		}
+	}
+
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, []byte("fLaC")) {
+		return ErrInvalidFLAC
+	}
+
+	blocks := []flacBlock{}
+	foundComment := false
+
+	for {
+		header := make([]byte, 4)
+		if _, err := io.ReadFull(r, header); err != nil {
+			return ErrInvalidFLAC
+		}
+
+		block := flacBlock{
+			kind: header[0] & 0x7f,
+			data: make([]byte, int(header[1])<<16|int(header[2])<<8|int(header[3])),
+		}
+
+		if _, err := io.ReadFull(r, block.data); err != nil {
+			return ErrInvalidFLAC
+		}
+
+		if block.kind == 4 {
+			comment, err := parseVorbisComment(block.data)
+			if err != nil {
+				return ErrInvalidFLAC
+			}
+
+			block.data = encodeVorbisComment(rewriteVorbisComment(comment, tags))
+			foundComment = true
+		}
+
+		blocks = append(blocks, block)
+
+		if header[0]&0x80 != 0 {
+			break
+		}
+	}
+
+	// STREAMINFO is always the first block
+	if !foundComment {
+		comment := rewriteVorbisComment(vorbisComment{vendor: []byte("Melodink")}, tags)
+
+		blocks = append(blocks[:1], append([]flacBlock{{
+			kind: 4,
+			data: encodeVorbisComment(comment),
+		}}, blocks[1:]...)...)
+	}
+
+	if _, err := out.Write(magic); err != nil {
+		return err
+	}
+
+	for i, block := range blocks {
+		if len(block.data) > 0xffffff {
+			return ErrInvalidFLAC
+		}
+
+		header := []byte{
+			block.kind,
+			byte(len(block.data) >> 16),
+			byte(len(block.data) >> 8),
+			byte(len(block.data)),
+		}
+
+		if i == len(blocks)-1 {
+			header[0] |= 0x80
+		}
+
+		if _, err := out.Write(header); err != nil {
+			return err
+		}
+		if _, err := out.Write(block.data); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.Copy(out, r)
+	return err
+}
+
+var oggCRCTable = func() [256]uint32 {
+	var table [256]uint32
+
+	for i := range table {
+		crc := uint32(i) << 24
+
+		for range 8 {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04c11db7
+			} else {
+				crc <<= 1
+			}
+		}
+
+		table[i] = crc
+	}
+
+	return table
+}()
+
+type oggPage struct {
+	headerType byte
+	granule    uint64
+	serial     uint32
+	sequence   uint32
+	segments   []byte
+	data       []byte
+}
+
+func readOggPage(r io.Reader) (oggPage, error) {
+	page := oggPage{}
+
+	header := make([]byte, 27)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return page, err
+	}
+
+	if !bytes.Equal(header[:4], []byte("OggS")) || header[4] != 0 {
+		return page, ErrInvalidOgg
+	}
+
+	page.headerType = header[5]
+	page.granule = binary.LittleEndian.Uint64(header[6:14])
+	page.serial = binary.LittleEndian.Uint32(header[14:18])
+	page.sequence = binary.LittleEndian.Uint32(header[18:22])
+
+	page.segments = make([]byte, header[26])
+	if _, err := io.ReadFull(r, page.segments); err != nil {
+		return page, ErrInvalidOgg
+	}
+
+	size := 0
+	for _, segment := range page.segments {
+		size += int(segment)
+	}
+
+	page.data = make([]byte, size)
+	if _, err := io.ReadFull(r, page.data); err != nil {
+		return page, ErrInvalidOgg
+	}
+
+	return page, nil
+}
+
+func (p oggPage) encode() []byte {
+	page := make([]byte, 27, 27+len(p.segments)+len(p.data))
+
+	copy(page, "OggS")
+	page[5] = p.headerType
+	binary.LittleEndian.PutUint64(page[6:], p.granule)
+	binary.LittleEndian.PutUint32(page[14:], p.serial)
+	binary.LittleEndian.PutUint32(page[18:], p.sequence)
+	page[26] = byte(len(p.segments))
+
+	page = append(page, p.segments...)
+	page = append(page, p.data...)
+
+	crc := uint32(0)
+	for _, b := range page {
+		crc = crc<<8 ^ oggCRCTable[byte(crc>>24)^b]
+	}
+
+	binary.LittleEndian.PutUint32(page[22:], crc)
+
+	return page
+}
+
+// paginateOggPackets put header packets in pages of at most 255 segments,
+// the granule position of header pages is always 0.
+func paginateOggPackets(packets [][]byte, serial uint32, sequence uint32) []oggPage {
+	pages := []oggPage{}
+	page := oggPage{serial: serial, sequence: sequence}
+
+	for _, packet := range packets {
+		continued := false
+
+		for {
+			if len(page.segments) == 255 {
+				pages = append(pages, page)
+
+				sequence++
+				page = oggPage{serial: serial, sequence: sequence}
+
+				if continued {
+					page.headerType = 0x01
+				}
+			}
+
+			segment := min(len(packet), 255)
+
+			page.segments = append(page.segments, byte(segment))
+			page.data = append(page.data, packet[:segment]...)
+
+			packet = packet[segment:]
+			continued = true
+
+			if segment < 255 {
+				break
+			}
+		}
+	}
+
+	return append(pages, page)
+}
+
+// writeOggVorbisComment rewrite the header pages of the first logical stream
+// and renumber its next pages since the comment can take more or less pages.
+func writeOggVorbisComment(r io.Reader, out io.Writer, tags []MultiValueTag) error {
+	first, err := readOggPage(r)
+	if err != nil || first.headerType&0x02 == 0 {
+		return ErrInvalidOgg
+	}
+
+	serial := first.serial
+
+	var headerCount int
+	var commentPrefix []byte
+
+	switch {
+	case bytes.HasPrefix(first.data, []byte("\x01vorbis")):
+		headerCount = 3
+		commentPrefix = []byte("\x03vorbis")
+	case bytes.HasPrefix(first.data, []byte("OpusHead")):
+		headerCount = 2
+		commentPrefix = []byte("OpusTags")
+	default:
+		return ErrInvalidOgg
+	}
+
+	packets := [][]byte{}
+	packet := []byte{}
+	lastSequence := first.sequence
+
+	// The identification header is alone in the first page
+	for len(packets) < headerCount-1 {
+		page, err := readOggPage(r)
+		if err != nil || page.serial != serial {
+			return ErrInvalidOgg
+		}
+
+		lastSequence = page.sequence
+		offset := 0
+
+		for _, segment := range page.segments {
+			// Audio packets always start on a new page
+			if len(packets) == headerCount-1 {
+				return ErrInvalidOgg
+			}
+
+			packet = append(packet, page.data[offset:offset+int(segment)]...)
+			offset += int(segment)
+
+			if segment == 255 {
+				continue
+			}
+
+			packets = append(packets, packet)
+			packet = []byte{}
+		}
+	}
+
+	if !bytes.HasPrefix(packets[0], commentPrefix) {
+		return ErrInvalidOgg
+	}
+
+	comment, err := parseVorbisComment(packets[0][len(commentPrefix):])
+	if err != nil {
+		return ErrInvalidOgg
+	}
+
+	packets[0] = append(
+		bytes.Clone(commentPrefix),
+		encodeVorbisComment(rewriteVorbisComment(comment, tags))...,
+	)
+
+	pages := append(
+		[]oggPage{first},
+		paginateOggPackets(packets, serial, first.sequence+1)...,
+	)
+
+	for _, page := range pages {
+		if _, err := out.Write(page.encode()); err != nil {
+			return err
+		}
+	}
+
+	delta := pages[len(pages)-1].sequence - lastSequence
+
+	for {
+		page, err := readOggPage(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return ErrInvalidOgg
+		}
+
+		if page.serial == serial {
+			page.sequence += delta
+		}
+
+		if _, err := out.Write(page.encode()); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package audiotags
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteVorbisComments(t *testing.T) {
+	tags := []MultiValueTag{
+		{Name: "ARTIST", Values: []string{"New A", "New B", "New C"}},
+		{Name: "GENRE"},
+		{Name: "MUSICBRAINZ_ARTISTID", Values: []string{"id-1", " ", "id-2"}},
+	}
+
+	tests := []struct {
+		fixture string
+
+		expected Tags
+	}{
+		{
+			fixture: "vorbis_multi.flac",
+			expected: Tags{
+				"title":                {"Fixture Title"},
+				"albumartist":          {"Album Artist"},
+				"artist":               {"New A", "New B", "New C"},
+				"musicbrainz_artistid": {"id-1", "id-2"},
+			},
+		},
+		{
+			fixture: "no_comment.flac",
+			expected: Tags{
+				"artist":               {"New A", "New B", "New C"},
+				"musicbrainz_artistid": {"id-1", "id-2"},
+			},
+		},
+		{
+			fixture: "id3_prefixed.flac",
+			expected: Tags{
+				"genre":                {"Ambient"},
+				"artist":               {"New A", "New B", "New C"},
+				"musicbrainz_artistid": {"id-1", "id-2"},
+			},
+		},
+		{
+			fixture: "opus_multi.opus",
+			expected: Tags{
+				"title":                {"Fixture Title"},
+				"albumartist":          {"Album Artist"},
+				"artist":               {"New A", "New B", "New C"},
+				"musicbrainz_artistid": {"id-1", "id-2"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			path := copyFixture(t, test.fixture)
+
+			if err := WriteVorbisComments(path, tags); err != nil {
+				t.Fatalf("write failed : %v", err)
+			}
+
+			got, err := ReadTags(path)
+			if err != nil {
+				t.Fatalf("read failed : %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestWriteVorbisCommentsKeepFLACAudio(t *testing.T) {
+	path := copyFixture(t, "vorbis_multi.flac")
+
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteVorbisComments(path, []MultiValueTag{{Name: "ARTIST", Values: []string{"A"}}}); err != nil {
+		t.Fatalf("write failed : %v", err)
+	}
+
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The fixture end with a fake audio frame starting with a FLAC sync code
+	audio := before[bytes.LastIndex(before, []byte{0xff, 0xf8}):]
+
+	if !bytes.HasSuffix(after, audio) {
+		t.Error("audio frames should be kept after the metadata blocks")
+	}
+}
+
+// readOggPages check the CRC of every page while reading them
+func readOggPages(t *testing.T, data []byte) []oggPage {
+	t.Helper()
+
+	pages := []oggPage{}
+	r := bytes.NewReader(data)
+
+	for {
+		offset := len(data) - r.Len()
+
+		page, err := readOggPage(r)
+		if err == io.EOF {
+			return pages
+		}
+		if err != nil {
+			t.Fatalf("can't read page at %d : %v", offset, err)
+		}
+
+		raw := data[offset : len(data)-r.Len()]
+		if !bytes.Equal(page.encode(), raw) {
+			t.Fatalf("page %d has an invalid CRC", page.sequence)
+		}
+
+		pages = append(pages, page)
+	}
+}
+
+func TestWriteVorbisCommentsRepaginateOgg(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+
+		expectedPages int
+	}{
+		{name: "smaller comment", value: "A", expectedPages: 4},
+		// The comment packet doesn't fit in the 255 segments of one page
+		{name: "bigger comment", value: strings.Repeat("A", 70000), expectedPages: 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := copyFixture(t, "vorbis_multi.ogg")
+
+			before, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tags := []MultiValueTag{
+				{Name: "ARTIST", Values: []string{"New A", "New B"}},
+				{Name: "COMMENT", Values: []string{test.value}},
+			}
+
+			if err := WriteVorbisComments(path, tags); err != nil {
+				t.Fatalf("write failed : %v", err)
+			}
+
+			after, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			oldPages := readOggPages(t, before)
+			pages := readOggPages(t, after)
+
+			if len(pages) != test.expectedPages {
+				t.Fatalf("expected %d pages, got %d", test.expectedPages, len(pages))
+			}
+
+			for i, page := range pages {
+				if page.sequence != uint32(i) {
+					t.Errorf("page %d has the sequence number %d", i, page.sequence)
+				}
+			}
+
+			// The last audio page keep its data, granule and end of stream flag
+			oldLast := oldPages[len(oldPages)-1]
+			last := pages[len(pages)-1]
+
+			if !bytes.Equal(last.data, oldLast.data) ||
+				last.granule != oldLast.granule ||
+				last.headerType != oldLast.headerType {
+				t.Error("audio pages should be kept")
+			}
+
+			got, err := ReadTags(path)
+			if err != nil {
+				t.Fatalf("read failed : %v", err)
+			}
+
+			if !reflect.DeepEqual(got["artist"], []string{"New A", "New B"}) {
+				t.Errorf("expected the new artists, got %v", got["artist"])
+			}
+
+			if !reflect.DeepEqual(got["comment"], []string{test.value}) {
+				t.Error("comment should be replaced")
+			}
+		})
+	}
+}
+
+func TestWriteVorbisCommentsInvalidFile(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{name: "not flac", data: []byte("RIFF\x00\x00\x00\x00WAVE"), err: ErrInvalidFLAC},
+		{name: "truncated flac", data: []byte("fLaC\x00\x00\x00\x22"), err: ErrInvalidFLAC},
+		{name: "truncated ogg", data: readFixturePrefix(t, "vorbis_multi.ogg", 28), err: ErrInvalidOgg},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "invalid")
+
+			if err := os.WriteFile(path, test.data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := WriteVorbisComments(path, nil); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v, got %v", test.err, err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(data, test.data) {
+				t.Error("file should be kept when writing fails")
+			}
+		})
+	}
+}
+
+func readFixturePrefix(t *testing.T, name string, size int) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data[:size]
+}
@@ -0,0 +1,51 @@
+package audiotags
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MultiValueTag is written with one frame, comment or atom per value. A tag
+// without values is removed from the file.
+type MultiValueTag struct {
+	Name   string
+	Values []string
+}
+
+func isMultiValueTagName(tags []MultiValueTag, name string) bool {
+	for _, tag := range tags {
+		if strings.EqualFold(tag.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// replaceFile write the new content of a file next to it and only replace it
+// when everything succeed so a failure never leave a truncated audio file.
+func replaceFile(path string, write func(out io.Writer) error) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(path), ".melodink-tags-*"+filepath.Ext(path))
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+
+	err = write(tempFile)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+
+	if err := os.Rename(tempPath, path); err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+
+	return nil
+}
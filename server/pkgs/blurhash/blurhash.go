@@ -0,0 +1,159 @@
+package blurhash
+
+import (
+	"errors"
+	"image"
+	"math"
+	"strings"
+)
+
+const base83Characters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+var ErrInvalidComponents = errors.New("Blurhash components should be between 1 and 9")
+
+var ErrEmptyImage = errors.New("Image is empty")
+
+// Encode compute the blurhash of an image with xComponents by yComponents
+// cosine factors, small images are enough and a lot faster.
+func Encode(xComponents int, yComponents int, img image.Image) (string, error) {
+	if xComponents < 1 || xComponents > 9 || yComponents < 1 || yComponents > 9 {
+		return "", ErrInvalidComponents
+	}
+
+	bounds := img.Bounds()
+
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return "", ErrEmptyImage
+	}
+
+	pixels := make([][3]float64, width*height)
+
+	for y := range height {
+		for x := range width {
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+
+			pixels[y*width+x] = [3]float64{
+				sRGBToLinear(r >> 8),
+				sRGBToLinear(g >> 8),
+				sRGBToLinear(b >> 8),
+			}
+		}
+	}
+
+	factors := make([][3]float64, 0, xComponents*yComponents)
+
+	for j := range yComponents {
+		for i := range xComponents {
+			factors = append(factors, multiplyBasisFunction(pixels, width, height, i, j))
+		}
+	}
+
+	var hash strings.Builder
+
+	hash.WriteString(encodeBase83((xComponents-1)+(yComponents-1)*9, 1))
+
+	maximumValue := 1.0
+
+	if len(factors) > 1 {
+		actualMaximumValue := 0.0
+
+		for _, factor := range factors[1:] {
+			for _, value := range factor {
+				actualMaximumValue = math.Max(actualMaximumValue, math.Abs(value))
+			}
+		}
+
+		quantisedMaximumValue := int(math.Max(0, math.Min(82, math.Floor(actualMaximumValue*166-0.5))))
+
+		maximumValue = float64(quantisedMaximumValue+1) / 166
+
+		hash.WriteString(encodeBase83(quantisedMaximumValue, 1))
+	} else {
+		hash.WriteString(encodeBase83(0, 1))
+	}
+
+	hash.WriteString(encodeBase83(encodeDC(factors[0]), 4))
+
+	for _, factor := range factors[1:] {
+		hash.WriteString(encodeBase83(encodeAC(factor, maximumValue), 2))
+	}
+
+	return hash.String(), nil
+}
+
+func multiplyBasisFunction(
+	pixels [][3]float64,
+	width int,
+	height int,
+	xComponent int,
+	yComponent int,
+) [3]float64 {
+	var result [3]float64
+
+	normalisation := 2.0
+	if xComponent == 0 && yComponent == 0 {
+		normalisation = 1
+	}
+
+	for y := range height {
+		basisY := math.Cos(math.Pi * float64(yComponent) * float64(y) / float64(height))
+
+		for x := range width {
+			basis := math.Cos(math.Pi*float64(xComponent)*float64(x)/float64(width)) * basisY
+
+			pixel := pixels[y*width+x]
+
+			result[0] += basis * pixel[0]
+			result[1] += basis * pixel[1]
+			result[2] += basis * pixel[2]
+		}
+	}
+
+	scale := normalisation / float64(width*height)
+
+	return [3]float64{result[0] * scale, result[1] * scale, result[2] * scale}
+}
+
+func encodeDC(value [3]float64) int {
+	return linearToSRGB(value[0])<<16 + linearToSRGB(value[1])<<8 + linearToSRGB(value[2])
+}
+
+func encodeAC(value [3]float64, maximumValue float64) int {
+	quantise := func(component float64) int {
+		return int(math.Max(0, math.Min(18, math.Floor(signPow(component/maximumValue, 0.5)*9+9.5))))
+	}
+
+	return quantise(value[0])*19*19 + quantise(value[1])*19 + quantise(value[2])
+}
+
+func encodeBase83(value int, length int) string {
+	result := make([]byte, length)
+
+	for i := range length {
+		digit := (value / int(math.Pow(83, float64(length-i-1)))) % 83
+		result[i] = base83Characters[digit]
+	}
+
+	return string(result)
+}
+
+func sRGBToLinear(value uint32) float64 {
+	v := float64(value) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(value float64) int {
+	v := math.Max(0, math.Min(1, value))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(value float64, exponent float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(value), exponent), value)
+}
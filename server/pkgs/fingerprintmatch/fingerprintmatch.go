@@ -0,0 +1,80 @@
+package fingerprintmatch
+
+import "math/bits"
+
+// A chromaprint item cover about 0.124s of audio, so 80 items are about 10s.
+const DefaultMaxOffset = 80
+
+// Under this many overlapping items a match isn't meaningful.
+const minOverlap = 40
+
+// Similarity compare two raw chromaprint fingerprints and return a score
+// between 0 and 1, the share of identical bits at the best alignment. Shifts
+// up to maxOffset items are tried so leading silence doesn't matter.
+func Similarity(a []int32, b []int32, maxOffset int) float64 {
+	best := 0.0
+
+	for offset := -maxOffset; offset <= maxOffset; offset++ {
+		score, ok := similarityAt(a, b, offset)
+		if ok && score > best {
+			best = score
+		}
+	}
+
+	return best
+}
+
+func similarityAt(a []int32, b []int32, offset int) (float64, bool) {
+	startA, startB := 0, 0
+
+	if offset > 0 {
+		startA = offset
+	} else {
+		startB = -offset
+	}
+
+	length := min(len(a)-startA, len(b)-startB)
+	if length < minOverlap {
+		return 0, false
+	}
+
+	errors := 0
+
+	for i := range length {
+		errors += bits.OnesCount32(uint32(a[startA+i] ^ b[startB+i]))
+	}
+
+	return 1 - float64(errors)/float64(length*32), true
+}
+
+// Encode store a raw fingerprint as little endian bytes.
+func Encode(fingerprint []int32) []byte {
+	data := make([]byte, len(fingerprint)*4)
+
+	for i, item := range fingerprint {
+		value := uint32(item)
+
+		data[i*4] = byte(value)
+		data[i*4+1] = byte(value >> 8)
+		data[i*4+2] = byte(value >> 16)
+		data[i*4+3] = byte(value >> 24)
+	}
+
+	return data
+}
+
+// Decode read a raw fingerprint written by Encode.
+func Decode(data []byte) []int32 {
+	fingerprint := make([]int32, len(data)/4)
+
+	for i := range fingerprint {
+		fingerprint[i] = int32(
+			uint32(data[i*4]) |
+				uint32(data[i*4+1])<<8 |
+				uint32(data[i*4+2])<<16 |
+				uint32(data[i*4+3])<<24,
+		)
+	}
+
+	return fingerprint
+}
@@ -0,0 +1,152 @@
+package imagecolors
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// Palette colors are hexadecimal strings like #1a2b3c.
+type Palette struct {
+	Dominant string
+	Vibrant  string
+	Muted    string
+}
+
+type colorBucket struct {
+	count int
+
+	red   int
+	green int
+	blue  int
+}
+
+func (b colorBucket) average() (float64, float64, float64) {
+	return float64(b.red) / float64(b.count),
+		float64(b.green) / float64(b.count),
+		float64(b.blue) / float64(b.count)
+}
+
+// Extract group the pixels in 4096 color buckets and pick the most present
+// one as dominant. Vibrant and muted are the buckets closest to a saturated
+// and a desaturated mid lightness color, weighted by their population.
+func Extract(img image.Image) Palette {
+	buckets := make(map[int]*colorBucket)
+
+	bounds := img.Bounds()
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+
+			// Mostly transparent pixels aren't part of the artwork
+			if a < 0x8000 {
+				continue
+			}
+
+			red, green, blue := int(r>>8), int(g>>8), int(b>>8)
+
+			key := (red>>4)<<8 | (green>>4)<<4 | blue>>4
+
+			bucket, ok := buckets[key]
+			if !ok {
+				bucket = &colorBucket{}
+				buckets[key] = bucket
+			}
+
+			bucket.count++
+			bucket.red += red
+			bucket.green += green
+			bucket.blue += blue
+		}
+	}
+
+	if len(buckets) == 0 {
+		return Palette{}
+	}
+
+	var dominant *colorBucket
+
+	for _, bucket := range buckets {
+		if dominant == nil || bucket.count > dominant.count {
+			dominant = bucket
+		}
+	}
+
+	vibrant := pickBucket(buckets, dominant.count, 1, 0.5)
+	muted := pickBucket(buckets, dominant.count, 0.3, 0.5)
+
+	return Palette{
+		Dominant: formatHex(dominant.average()),
+		Vibrant:  formatHex(vibrant.average()),
+		Muted:    formatHex(muted.average()),
+	}
+}
+
+func pickBucket(
+	buckets map[int]*colorBucket,
+	maxCount int,
+	targetSaturation float64,
+	targetLightness float64,
+) *colorBucket {
+	var best *colorBucket
+	bestScore := math.Inf(-1)
+
+	for _, bucket := range buckets {
+		_, saturation, lightness := rgbToHsl(bucket.average())
+
+		score := 3*(1-math.Abs(saturation-targetSaturation)) +
+			6.5*(1-math.Abs(lightness-targetLightness)) +
+			0.5*float64(bucket.count)/float64(maxCount)
+
+		// Tiny specks of color shouldn't win over the artwork
+		if bucket.count*100 < maxCount {
+			score -= 10
+		}
+
+		if score > bestScore {
+			best, bestScore = bucket, score
+		}
+	}
+
+	return best
+}
+
+func rgbToHsl(red float64, green float64, blue float64) (float64, float64, float64) {
+	r, g, b := red/255, green/255, blue/255
+
+	high := math.Max(r, math.Max(g, b))
+	low := math.Min(r, math.Min(g, b))
+
+	lightness := (high + low) / 2
+
+	if high == low {
+		return 0, 0, lightness
+	}
+
+	delta := high - low
+
+	saturation := delta / (1 - math.Abs(2*lightness-1))
+
+	var hue float64
+
+	switch high {
+	case r:
+		hue = math.Mod((g-b)/delta, 6)
+	case g:
+		hue = (b-r)/delta + 2
+	default:
+		hue = (r-g)/delta + 4
+	}
+
+	return math.Mod(hue*60+360, 360), math.Min(1, saturation), lightness
+}
+
+func formatHex(red float64, green float64, blue float64) string {
+	return fmt.Sprintf(
+		"#%02x%02x%02x",
+		int(math.Round(red)),
+		int(math.Round(green)),
+		int(math.Round(blue)),
+	)
+}
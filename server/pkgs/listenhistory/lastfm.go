@@ -0,0 +1,145 @@
+package listenhistory
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type lastFmTrack struct {
+	Name   string          `json:"name"`
+	Mbid   string          `json:"mbid"`
+	Artist json.RawMessage `json:"artist"`
+	Album  json.RawMessage `json:"album"`
+	Date   json.RawMessage `json:"date"`
+
+	Attr struct {
+		NowPlaying string `json:"nowplaying"`
+	} `json:"@attr"`
+}
+
+// ParseLastFm read the recent tracks exported from the Last.fm API, the file
+// can be a single response, an array of response pages or an array of tracks.
+func ParseLastFm(r io.Reader) ([]Listen, error) {
+	var document any
+
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&document); err != nil {
+		return nil, errors.Join(ErrInvalidHistory, err)
+	}
+
+	listens := []Listen{}
+
+	collectLastFmTracks(document, &listens)
+
+	return listens, nil
+}
+
+func collectLastFmTracks(value any, listens *[]Listen) {
+	switch value := value.(type) {
+	case []any:
+		for _, item := range value {
+			collectLastFmTracks(item, listens)
+		}
+	case map[string]any:
+		if _, ok := value["artist"]; ok {
+			if _, ok := value["name"].(string); ok {
+				if listen, ok := parseLastFmTrack(value); ok {
+					*listens = append(*listens, listen)
+				}
+				return
+			}
+		}
+
+		for _, item := range value {
+			collectLastFmTracks(item, listens)
+		}
+	}
+}
+
+func parseLastFmTrack(value map[string]any) (Listen, bool) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return Listen{}, false
+	}
+
+	track := lastFmTrack{}
+
+	if err := json.Unmarshal(raw, &track); err != nil {
+		return Listen{}, false
+	}
+
+	// The track currently playing has no date and will be in the next export
+	if track.Attr.NowPlaying == "true" {
+		return Listen{}, false
+	}
+
+	listenedAt, ok := parseLastFmDate(track.Date)
+	if !ok {
+		return Listen{}, false
+	}
+
+	return Listen{
+		ListenedAt: listenedAt,
+
+		Artist: parseLastFmName(track.Artist),
+		Title:  strings.TrimSpace(track.Name),
+		Album:  parseLastFmName(track.Album),
+
+		RecordingMbid: strings.TrimSpace(track.Mbid),
+	}, true
+}
+
+// parseLastFmName read an artist or an album which is either a plain string
+// or an object with its name in "#text" or "name"
+func parseLastFmName(raw json.RawMessage) string {
+	var name string
+
+	if json.Unmarshal(raw, &name) == nil {
+		return strings.TrimSpace(name)
+	}
+
+	object := struct {
+		Text string `json:"#text"`
+		Name string `json:"name"`
+	}{}
+
+	if json.Unmarshal(raw, &object) != nil {
+		return ""
+	}
+
+	if object.Text != "" {
+		return strings.TrimSpace(object.Text)
+	}
+
+	return strings.TrimSpace(object.Name)
+}
+
+func parseLastFmDate(raw json.RawMessage) (time.Time, bool) {
+	object := struct {
+		Uts json.RawMessage `json:"uts"`
+	}{}
+
+	if json.Unmarshal(raw, &object) == nil && object.Uts != nil {
+		raw = object.Uts
+	}
+
+	return parseUnixTimestamp(raw)
+}
+
+// parseUnixTimestamp accept a number or a string of seconds since epoch
+func parseUnixTimestamp(raw json.RawMessage) (time.Time, bool) {
+	value := strings.Trim(strings.TrimSpace(string(raw)), `"`)
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(seconds, 0).UTC(), true
+}
@@ -0,0 +1,54 @@
+package listenhistory
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLastFm(t *testing.T) {
+	const pages = `[
+  {"recenttracks": {"track": [
+    {
+      "name": "Now Playing", "artist": {"#text": "Artist"},
+      "@attr": {"nowplaying": "true"}
+    },
+    {
+      "name": " Title ", "mbid": "recording-id",
+      "artist": {"#text": "Artist", "mbid": ""},
+      "album": {"#text": "Album"},
+      "date": {"uts": "1700000000", "#text": "14 Nov 2023, 22:13"}
+    }
+  ]}},
+  {"recenttracks": {"track": [
+    {"name": "Second", "artist": {"name": "Other"}, "album": "Plain Album", "date": 1700000100},
+    {"name": "No Date", "artist": "Other"}
+  ]}}
+]`
+
+	listens, err := ParseLastFm(strings.NewReader(pages))
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	expected := []Listen{
+		{
+			ListenedAt:    time.Unix(1700000000, 0).UTC(),
+			Artist:        "Artist",
+			Title:         "Title",
+			Album:         "Album",
+			RecordingMbid: "recording-id",
+		},
+		{
+			ListenedAt: time.Unix(1700000100, 0).UTC(),
+			Artist:     "Other",
+			Title:      "Second",
+			Album:      "Plain Album",
+		},
+	}
+
+	if !reflect.DeepEqual(listens, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, listens)
+	}
+}
@@ -0,0 +1,125 @@
+package listenhistory
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+type listenBrainzListen struct {
+	ListenedAt json.RawMessage `json:"listened_at"`
+
+	TrackMetadata struct {
+		ArtistName  string `json:"artist_name"`
+		TrackName   string `json:"track_name"`
+		ReleaseName string `json:"release_name"`
+
+		AdditionalInfo struct {
+			RecordingMbid string `json:"recording_mbid"`
+			DurationMs    int    `json:"duration_ms"`
+		} `json:"additional_info"`
+
+		MbidMapping struct {
+			RecordingMbid string `json:"recording_mbid"`
+		} `json:"mbid_mapping"`
+	} `json:"track_metadata"`
+}
+
+// ParseListenBrainz read the listens exported from ListenBrainz, the file can
+// be the JSON array of the old exports, the JSONL of the new ones or a
+// response of the listens API.
+func ParseListenBrainz(r io.Reader) ([]Listen, error) {
+	decoder := json.NewDecoder(r)
+
+	listens := []Listen{}
+
+	for {
+		var raw json.RawMessage
+
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Join(ErrInvalidHistory, err)
+		}
+
+		if err := collectListenBrainzListens(raw, &listens); err != nil {
+			return nil, errors.Join(ErrInvalidHistory, err)
+		}
+	}
+
+	return listens, nil
+}
+
+func collectListenBrainzListens(raw json.RawMessage, listens *[]Listen) error {
+	raw = bytes.TrimSpace(raw)
+
+	if len(raw) != 0 && raw[0] == '[' {
+		items := []json.RawMessage{}
+
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := collectListenBrainzListens(item, listens); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	response := struct {
+		Payload *struct {
+			Listens []json.RawMessage `json:"listens"`
+		} `json:"payload"`
+	}{}
+
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return err
+	}
+
+	if response.Payload != nil {
+		for _, item := range response.Payload.Listens {
+			if err := collectListenBrainzListens(item, listens); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	listen := listenBrainzListen{}
+
+	if err := json.Unmarshal(raw, &listen); err != nil {
+		return err
+	}
+
+	listenedAt, ok := parseUnixTimestamp(listen.ListenedAt)
+	if !ok {
+		return nil
+	}
+
+	metadata := listen.TrackMetadata
+
+	recordingMbid := metadata.AdditionalInfo.RecordingMbid
+	if recordingMbid == "" {
+		recordingMbid = metadata.MbidMapping.RecordingMbid
+	}
+
+	*listens = append(*listens, Listen{
+		ListenedAt: listenedAt,
+
+		Artist: strings.TrimSpace(metadata.ArtistName),
+		Title:  strings.TrimSpace(metadata.TrackName),
+		Album:  strings.TrimSpace(metadata.ReleaseName),
+
+		RecordingMbid: strings.TrimSpace(recordingMbid),
+	})
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package listenhistory
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseListenBrainz(t *testing.T) {
+	track := func(listenedAt string, title string) string {
+		return `{"listened_at": ` + listenedAt + `, "track_metadata": {
+      "artist_name": "Artist", "track_name": "` + title + `", "release_name": "Album",
+      "additional_info": {"recording_mbid": ""},
+      "mbid_mapping": {"recording_mbid": "mapped-id"}
+    }}`
+	}
+
+	expected := []Listen{
+		{
+			ListenedAt:    time.Unix(1700000000, 0).UTC(),
+			Artist:        "Artist",
+			Title:         "First",
+			Album:         "Album",
+			RecordingMbid: "mapped-id",
+		},
+		{
+			ListenedAt:    time.Unix(1700000100, 0).UTC(),
+			Artist:        "Artist",
+			Title:         "Second",
+			Album:         "Album",
+			RecordingMbid: "mapped-id",
+		},
+	}
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "jsonl",
+			data: track("1700000000", "First") + "\n" + track("1700000100", "Second") + "\n",
+		},
+		{
+			name: "json array",
+			data: "[" + track("1700000000", "First") + "," + track(`"1700000100"`, "Second") + "]",
+		},
+		{
+			name: "api response",
+			data: `{"payload": {"count": 3, "listens": [` +
+				track("1700000000", "First") + "," +
+				track("null", "Playing Now") + "," +
+				track("1700000100", "Second") + `]}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			listens, err := ParseListenBrainz(strings.NewReader(test.data))
+			if err != nil {
+				t.Fatalf("unexpected error : %v", err)
+			}
+
+			if !reflect.DeepEqual(listens, expected) {
+				t.Fatalf("expected %+v, got %+v", expected, listens)
+			}
+		})
+	}
+}
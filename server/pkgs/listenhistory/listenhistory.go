@@ -0,0 +1,130 @@
+package listenhistory
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+type Source string
+
+const (
+	LastFmSource       Source = "lastfm"
+	ListenBrainzSource Source = "listenbrainz"
+	SpotifySource      Source = "spotify"
+)
+
+// Listen is one entry of an exported listening history
+type Listen struct {
+	ListenedAt time.Time
+
+	Artist string
+	Title  string
+	Album  string
+
+	RecordingMbid string
+
+	// PlayedTime is in milliseconds and is 0 when the export doesn't know it
+	PlayedTime int
+
+	Shuffle bool
+	Ended   bool
+}
+
+var (
+	ErrUnknownSource = errors.New("Unknown listening history source")
+
+	ErrInvalidHistory = errors.New("Listening history export is invalid")
+)
+
+var zipSignature = []byte("PK\x03\x04")
+
+// Parse read a listening history export, it can either be one of the exported
+// file or the whole zip archive given by the service.
+func Parse(source Source, file io.ReaderAt, size int64) ([]Listen, error) {
+	signature := make([]byte, len(zipSignature))
+
+	if _, err := file.ReadAt(signature, 0); err == nil && bytes.Equal(signature, zipSignature) {
+		return parseArchive(source, file, size)
+	}
+
+	return parseFile(source, io.NewSectionReader(file, 0, size))
+}
+
+func parseFile(source Source, r io.Reader) ([]Listen, error) {
+	switch source {
+	case LastFmSource:
+		return ParseLastFm(r)
+	case ListenBrainzSource:
+		return ParseListenBrainz(r)
+	case SpotifySource:
+		return ParseSpotify(r)
+	default:
+		return nil, ErrUnknownSource
+	}
+}
+
+// isHistoryArchiveFile keep only the files with listens, exports archives
+// also contain the user profile, feedbacks or video streams
+func isHistoryArchiveFile(source Source, name string) bool {
+	base := strings.ToLower(path.Base(name))
+
+	if strings.HasPrefix(base, ".") {
+		return false
+	}
+
+	switch source {
+	case LastFmSource:
+		return strings.HasSuffix(base, ".json")
+	case ListenBrainzSource:
+		if strings.HasPrefix(base, "listens") {
+			return strings.HasSuffix(base, ".json") || strings.HasSuffix(base, ".jsonl")
+		}
+		return strings.Contains(strings.ToLower(name), "listens/") &&
+			strings.HasSuffix(base, ".jsonl")
+	case SpotifySource:
+		return strings.HasPrefix(base, "streaming_history_audio") &&
+			strings.HasSuffix(base, ".json")
+	default:
+		return false
+	}
+}
+
+func parseArchive(source Source, file io.ReaderAt, size int64) ([]Listen, error) {
+	reader, err := zip.NewReader(file, size)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidHistory, err)
+	}
+
+	listens := []Listen{}
+
+	for _, entry := range reader.File {
+		if entry.FileInfo().IsDir() || !isHistoryArchiveFile(source, entry.Name) {
+			continue
+		}
+
+		entryListens, err := parseArchiveFile(source, entry)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, entry.Name)
+		}
+
+		listens = append(listens, entryListens...)
+	}
+
+	return listens, nil
+}
+
+func parseArchiveFile(source Source, entry *zip.File) ([]Listen, error) {
+	r, err := entry.Open()
+	if err != nil {
+		return nil, errors.Join(ErrInvalidHistory, err)
+	}
+	defer r.Close()
+
+	return parseFile(source, r)
+}
@@ -0,0 +1,98 @@
+package listenhistory
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseArchive(t *testing.T) {
+	var archive bytes.Buffer
+
+	writer := zip.NewWriter(&archive)
+
+	files := map[string]string{
+		"Spotify Extended Streaming History/Streaming_History_Audio_2023.json": `[{
+      "ts": "2023-11-14T22:16:20Z", "ms_played": 200000,
+      "master_metadata_track_name": "Title",
+      "master_metadata_album_artist_name": "Artist"
+    }]`,
+		"Spotify Extended Streaming History/Streaming_History_Video_2023.json": `not json`,
+		"Spotify Extended Streaming History/ReadMeFirst.pdf":                   `not json`,
+	}
+
+	for name, content := range files {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	listens, err := Parse(SpotifySource, bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	if len(listens) != 1 || listens[0].Title != "Title" {
+		t.Fatalf("expected only the audio history, got %+v", listens)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Source
+		data   string
+
+		expected error
+	}{
+		{name: "unknown source", source: "deezer", data: `[]`, expected: ErrUnknownSource},
+		{name: "invalid lastfm", source: LastFmSource, data: `{"recenttracks":`, expected: ErrInvalidHistory},
+		{name: "invalid listenbrainz", source: ListenBrainzSource, data: `{"listened_at": 1} oops`, expected: ErrInvalidHistory},
+		{name: "invalid spotify", source: SpotifySource, data: `{"ts": "2023"}`, expected: ErrInvalidHistory},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.source, strings.NewReader(test.data), int64(len(test.data)))
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestIsHistoryArchiveFile(t *testing.T) {
+	tests := []struct {
+		source   Source
+		name     string
+		expected bool
+	}{
+		{source: ListenBrainzSource, name: "listens.jsonl", expected: true},
+		{source: ListenBrainzSource, name: "listens/2023/11.jsonl", expected: true},
+		{source: ListenBrainzSource, name: "feedback.jsonl", expected: false},
+		{source: ListenBrainzSource, name: "user.json", expected: false},
+		{source: SpotifySource, name: "Streaming_History_Audio_2023_1.json", expected: true},
+		{source: SpotifySource, name: "Streaming_History_Video_2023.json", expected: false},
+		{source: LastFmSource, name: "scrobbles-1.json", expected: true},
+		{source: LastFmSource, name: "__MACOSX/._scrobbles-1.json", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.source)+" "+test.name, func(t *testing.T) {
+			if isHistoryArchiveFile(test.source, test.name) != test.expected {
+				t.Fatalf("expected %v", test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package listenhistory
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Decorations services add to the names which are rarely in the tags, like
+// "(Remastered 2011)", "- 2009 Remaster" or "[feat. Someone]"
+var nameDecorationRegex = regexp.MustCompile(
+	`(?i)\s*(\([^)]*(remaster|feat\.|ft\.|featuring)[^)]*\)|\[[^\]]*(remaster|feat\.|ft\.|featuring)[^\]]*\]|\s-\s[^-]*remaster.*$)`,
+)
+
+// NormalizeName reduce a title, an artist or an album to a key which ignore
+// the case, the punctuation and the usual decorations of streaming services.
+func NormalizeName(name string) string {
+	name = nameDecorationRegex.ReplaceAllString(name, "")
+
+	name = strings.ReplaceAll(strings.ToLower(name), "&", " and ")
+
+	var builder strings.Builder
+
+	space := false
+
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if space && builder.Len() != 0 {
+				builder.WriteByte(' ')
+			}
+			builder.WriteRune(r)
+			space = false
+			continue
+		}
+
+		space = true
+	}
+
+	return builder.String()
+}
+
+var artistSeparatorRegex = regexp.MustCompile(
+	`(?i)\s*(,|;|/|\s&\s|\sx\s|\sfeat\.?\s|\sft\.?\s|\sfeaturing\s|\swith\s)\s*`,
+)
+
+// MainArtistName return the first artist of a credit like "A feat. B" or "A, B"
+func MainArtistName(artist string) string {
+	return strings.TrimSpace(artistSeparatorRegex.Split(artist, 2)[0])
+}
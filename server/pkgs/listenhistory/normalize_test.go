@@ -0,0 +1,52 @@
+package listenhistory
+
+import "testing"
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Hello, World!", expected: "hello world"},
+		{name: "  Don't   Stop  ", expected: "don t stop"},
+		{name: "Simon & Garfunkel", expected: "simon and garfunkel"},
+		{name: "Come Together (Remastered 2009)", expected: "come together"},
+		{name: "Come Together - 2009 Remaster", expected: "come together"},
+		{name: "Song [feat. Someone]", expected: "song"},
+		{name: "Song (ft. Someone)", expected: "song"},
+		{name: "Song (Live)", expected: "song live"},
+		{name: "Beyoncé", expected: "beyoncé"},
+		{name: "東京", expected: "東京"},
+		{name: "...", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := NormalizeName(test.name); got != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestMainArtistName(t *testing.T) {
+	tests := []struct {
+		artist   string
+		expected string
+	}{
+		{artist: "Artist", expected: "Artist"},
+		{artist: "Artist A feat. Artist B", expected: "Artist A"},
+		{artist: "Artist A ft Artist B", expected: "Artist A"},
+		{artist: "Artist A, Artist B", expected: "Artist A"},
+		{artist: "Artist A & Artist B", expected: "Artist A"},
+		{artist: "Artist A x Artist B", expected: "Artist A"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.artist, func(t *testing.T) {
+			if got := MainArtistName(test.artist); got != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
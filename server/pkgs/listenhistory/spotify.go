@@ -0,0 +1,66 @@
+package listenhistory
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+type spotifyStream struct {
+	Ts       string `json:"ts"`
+	MsPlayed int    `json:"ms_played"`
+
+	TrackName  *string `json:"master_metadata_track_name"`
+	ArtistName *string `json:"master_metadata_album_artist_name"`
+	AlbumName  *string `json:"master_metadata_album_album_name"`
+
+	ReasonEnd string `json:"reason_end"`
+
+	Shuffle bool `json:"shuffle"`
+}
+
+// ParseSpotify read a Streaming_History_Audio file of the Spotify extended
+// streaming history, podcasts and audiobooks entries are ignored.
+func ParseSpotify(r io.Reader) ([]Listen, error) {
+	streams := []spotifyStream{}
+
+	if err := json.NewDecoder(r).Decode(&streams); err != nil {
+		return nil, errors.Join(ErrInvalidHistory, err)
+	}
+
+	listens := []Listen{}
+
+	for _, stream := range streams {
+		if stream.TrackName == nil || stream.ArtistName == nil || stream.MsPlayed <= 0 {
+			continue
+		}
+
+		// ts is the moment the stream stopped
+		endedAt, err := time.Parse(time.RFC3339, stream.Ts)
+		if err != nil {
+			continue
+		}
+
+		album := ""
+		if stream.AlbumName != nil {
+			album = strings.TrimSpace(*stream.AlbumName)
+		}
+
+		listens = append(listens, Listen{
+			ListenedAt: endedAt.Add(-time.Duration(stream.MsPlayed) * time.Millisecond).UTC(),
+
+			Artist: strings.TrimSpace(*stream.ArtistName),
+			Title:  strings.TrimSpace(*stream.TrackName),
+			Album:  album,
+
+			PlayedTime: stream.MsPlayed,
+
+			Shuffle: stream.Shuffle,
+			Ended:   stream.ReasonEnd == "trackdone",
+		})
+	}
+
+	return listens, nil
+}
@@ -0,0 +1,65 @@
+package listenhistory
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSpotify(t *testing.T) {
+	const history = `[
+  {
+    "ts": "2023-11-14T22:16:20Z", "ms_played": 200000,
+    "master_metadata_track_name": "Title",
+    "master_metadata_album_artist_name": "Artist",
+    "master_metadata_album_album_name": "Album",
+    "reason_end": "trackdone", "shuffle": true
+  },
+  {
+    "ts": "2023-11-14T22:20:00Z", "ms_played": 15000,
+    "master_metadata_track_name": "Skipped",
+    "master_metadata_album_artist_name": "Artist",
+    "master_metadata_album_album_name": null,
+    "reason_end": "fwdbtn", "shuffle": false
+  },
+  {
+    "ts": "2023-11-14T22:30:00Z", "ms_played": 600000,
+    "master_metadata_track_name": null,
+    "episode_name": "A Podcast"
+  },
+  {
+    "ts": "2023-11-14T22:40:00Z", "ms_played": 0,
+    "master_metadata_track_name": "Never Played",
+    "master_metadata_album_artist_name": "Artist"
+  }
+]`
+
+	listens, err := ParseSpotify(strings.NewReader(history))
+	if err != nil {
+		t.Fatalf("unexpected error : %v", err)
+	}
+
+	expected := []Listen{
+		{
+			// ts is the end of the stream
+			ListenedAt: time.Date(2023, 11, 14, 22, 13, 0, 0, time.UTC),
+			Artist:     "Artist",
+			Title:      "Title",
+			Album:      "Album",
+			PlayedTime: 200000,
+			Shuffle:    true,
+			Ended:      true,
+		},
+		{
+			ListenedAt: time.Date(2023, 11, 14, 22, 19, 45, 0, time.UTC),
+			Artist:     "Artist",
+			Title:      "Skipped",
+			PlayedTime: 15000,
+		},
+	}
+
+	if !reflect.DeepEqual(listens, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, listens)
+	}
+}
@@ -0,0 +1,135 @@
+package lrc
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Line struct {
+	Time time.Duration
+	Text string
+}
+
+var (
+	timeTagRegex     = regexp.MustCompile(`^\[(\d+):(\d{1,2})(?:[.:](\d{1,3}))?\]`)
+	metadataTagRegex = regexp.MustCompile(`^\[([a-zA-Z#]+):(.*)\]$`)
+
+	// Enhanced LRC word timings like <00:12.34>
+	wordTimeTagRegex = regexp.MustCompile(`<\d+:\d{1,2}(?:[.:]\d{1,3})?>`)
+)
+
+func parseTimeTag(match []string) time.Duration {
+	minutes, _ := strconv.Atoi(match[1])
+	seconds, _ := strconv.Atoi(match[2])
+
+	fraction := time.Duration(0)
+
+	if match[3] != "" {
+		value, _ := strconv.Atoi(match[3])
+
+		switch len(match[3]) {
+		case 1:
+			fraction = time.Duration(value) * 100 * time.Millisecond
+		case 2:
+			fraction = time.Duration(value) * 10 * time.Millisecond
+		default:
+			fraction = time.Duration(value) * time.Millisecond
+		}
+	}
+
+	return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second + fraction
+}
+
+// Parse read LRC lyrics, a line can have multiple time tags and the offset
+// tag is applied. It return false when no timed line is found.
+func Parse(content string) ([]Line, bool) {
+	lines := []Line{}
+
+	offset := time.Duration(0)
+
+	for _, rawLine := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		rawLine = strings.TrimSpace(rawLine)
+
+		times := []time.Duration{}
+
+		for {
+			match := timeTagRegex.FindStringSubmatch(rawLine)
+			if match == nil {
+				break
+			}
+
+			times = append(times, parseTimeTag(match))
+			rawLine = rawLine[len(match[0]):]
+		}
+
+		if len(times) == 0 {
+			if match := metadataTagRegex.FindStringSubmatch(rawLine); match != nil &&
+				strings.EqualFold(match[1], "offset") {
+				if value, err := strconv.Atoi(strings.TrimSpace(match[2])); err == nil {
+					// A positive offset make lyrics appear sooner
+					offset = -time.Duration(value) * time.Millisecond
+				}
+			}
+			continue
+		}
+
+		text := strings.TrimSpace(wordTimeTagRegex.ReplaceAllString(rawLine, ""))
+
+		for _, lineTime := range times {
+			lines = append(lines, Line{
+				Time: lineTime,
+				Text: text,
+			})
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil, false
+	}
+
+	for i := range lines {
+		lines[i].Time = max(0, lines[i].Time+offset)
+	}
+
+	slices.SortStableFunc(lines, func(a Line, b Line) int {
+		return cmp.Compare(a.Time, b.Time)
+	})
+
+	return lines, true
+}
+
+// Format write lines as LRC with centisecond time tags.
+func Format(lines []Line) string {
+	var builder strings.Builder
+
+	for _, line := range lines {
+		centiseconds := line.Time.Milliseconds() / 10
+
+		fmt.Fprintf(
+			&builder,
+			"[%02d:%02d.%02d]%s\n",
+			centiseconds/6000,
+			centiseconds/100%60,
+			centiseconds%100,
+			line.Text,
+		)
+	}
+
+	return builder.String()
+}
+
+// PlainText return the lyrics without timings.
+func PlainText(lines []Line) string {
+	texts := make([]string, len(lines))
+
+	for i, line := range lines {
+		texts[i] = line.Text
+	}
+
+	return strings.Join(texts, "\n")
+}